@@ -125,6 +125,20 @@ type ApplicationRepository interface {
 	Read(name string) (app models.Application, apiResponse net.ApiResponse)
 	Update(appGuid string, params models.AppParams) (updatedApp models.Application, apiResponse net.ApiResponse)
 	Delete(appGuid string) (apiResponse net.ApiResponse)
+	ReadEnv(appGuid string) (env models.AppEnvironment, apiResponse net.ApiResponse)
+}
+
+type ApplicationEnvResource struct {
+	Environment map[string]string      `json:"environment_json"`
+	System      map[string]interface{} `json:"system_env_json"`
+	Application map[string]interface{} `json:"application_env_json"`
+}
+
+func (resource ApplicationEnvResource) ToModel() (env models.AppEnvironment) {
+	env.Environment = resource.Environment
+	env.System = resource.System
+	env.Application = resource.Application
+	return
 }
 
 type CloudControllerApplicationRepository struct {
@@ -203,3 +217,15 @@ func (repo CloudControllerApplicationRepository) Delete(appGuid string) (apiResp
 	path := fmt.Sprintf("%s/v2/apps/%s?recursive=true", repo.config.ApiEndpoint(), appGuid)
 	return repo.gateway.DeleteResource(path, repo.config.AccessToken())
 }
+
+func (repo CloudControllerApplicationRepository) ReadEnv(appGuid string) (env models.AppEnvironment, apiResponse net.ApiResponse) {
+	path := fmt.Sprintf("%s/v2/apps/%s/env", repo.config.ApiEndpoint(), appGuid)
+	resource := new(ApplicationEnvResource)
+	apiResponse = repo.gateway.GetResource(path, repo.config.AccessToken(), resource)
+	if apiResponse.IsNotSuccessful() {
+		return
+	}
+
+	env = resource.ToModel()
+	return
+}
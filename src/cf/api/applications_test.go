@@ -158,6 +158,37 @@ var _ = Describe("Testing with ginkgo", func() {
 		Expect(handler.AllRequestsCalled()).To(BeTrue())
 		Expect(apiResponse.IsNotSuccessful()).To(BeFalse())
 	})
+
+	It("reads the environment the platform gives an app", func() {
+		request := testapi.NewCloudControllerTestRequest(testnet.TestRequest{
+			Method: "GET",
+			Path:   "/v2/apps/my-app-guid/env",
+			Response: testnet.TestResponse{Status: http.StatusOK, Body: `{
+				"environment_json": {"foo": "bar"},
+				"system_env_json": {
+					"VCAP_SERVICES": {
+						"user-provided": [{"name": "my-db", "credentials": {"password": "secret"}}]
+					}
+				},
+				"application_env_json": {
+					"VCAP_APPLICATION": {"application_name": "my-app", "limits": {"mem": 256}}
+				}
+			}`},
+		})
+
+		ts, handler, repo := createAppRepo([]testnet.TestRequest{request})
+		defer ts.Close()
+
+		env, apiResponse := repo.ReadEnv("my-app-guid")
+		Expect(handler.AllRequestsCalled()).To(BeTrue())
+		Expect(apiResponse.IsNotSuccessful()).To(BeFalse())
+		Expect(env.Environment).To(Equal(map[string]string{"foo": "bar"}))
+		Expect(env.System).To(HaveKey("VCAP_SERVICES"))
+		Expect(env.Application["VCAP_APPLICATION"]).To(Equal(map[string]interface{}{
+			"application_name": "my-app",
+			"limits":           map[string]interface{}{"mem": float64(256)},
+		}))
+	})
 })
 
 var singleAppResponse = testnet.TestResponse{
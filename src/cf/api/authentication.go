@@ -6,14 +6,37 @@ import (
 	"cf/terminal"
 	"encoding/base64"
 	"fmt"
+	"net/http"
 	"net/url"
 	"os"
+	"regexp"
 	"strings"
+	"sync"
+	"time"
 )
 
+const (
+	CF_CLIENT_ID     = "CF_CLIENT_ID"
+	CF_CLIENT_SECRET = "CF_CLIENT_SECRET"
+)
+
+// tokens are refreshed this long before they expire, so that a request
+// started with a valid token does not need to be replayed
+const AccessTokenExpiryMargin = 1 * time.Minute
+
+var passcodeURLPattern = regexp.MustCompile(`https?://[^\s)]+`)
+
+// serializes token refreshes so that concurrent requests do not each
+// refresh the token and overwrite one another in the config file
+var tokenRefreshMutex sync.Mutex
+
 type AuthenticationRepository interface {
 	Authenticate(email string, password string) (apiResponse net.ApiResponse)
+	AuthenticateClient(clientId string, clientSecret string) (apiResponse net.ApiResponse)
+	AuthenticateWithPasscode(passcode string) (apiResponse net.ApiResponse)
+	GetPasscodeURL() (passcodeURL string, apiResponse net.ApiResponse)
 	RefreshAuthToken() (updatedToken string, apiResponse net.ApiResponse)
+	RefreshAuthTokenIfExpiring(accessToken string) (updatedToken string, apiResponse net.ApiResponse)
 }
 
 type UAAAuthenticationRepository struct {
@@ -38,29 +61,164 @@ func (uaa UAAAuthenticationRepository) Authenticate(email string, password strin
 	apiResponse = uaa.getAuthToken(data)
 	if apiResponse.IsNotSuccessful() && apiResponse.StatusCode == 401 {
 		apiResponse.Message = "Password is incorrect, please try again."
+		return
+	}
+
+	if apiResponse.IsSuccessful() {
+		uaa.config.SetClientCredentials("", "")
 	}
 	return
 }
 
-func (uaa UAAAuthenticationRepository) RefreshAuthToken() (updatedToken string, apiResponse net.ApiResponse) {
+func (uaa UAAAuthenticationRepository) AuthenticateWithPasscode(passcode string) (apiResponse net.ApiResponse) {
 	data := url.Values{
-		"refresh_token": {uaa.config.RefreshToken()},
-		"grant_type":    {"refresh_token"},
-		"scope":         {""},
+		"passcode":   {passcode},
+		"grant_type": {"password"},
+		"scope":      {""},
 	}
 
 	apiResponse = uaa.getAuthToken(data)
+	if apiResponse.IsNotSuccessful() && apiResponse.StatusCode == 401 {
+		apiResponse.Message = "Passcode is incorrect, please try again."
+		return
+	}
+
+	if apiResponse.IsSuccessful() {
+		uaa.config.SetClientCredentials("", "")
+	}
+	return
+}
+
+// GetPasscodeURL returns the page where SSO users get a one-time passcode, as
+// advertised in the passcode prompt of the UAA login info endpoint.
+func (uaa UAAAuthenticationRepository) GetPasscodeURL() (passcodeURL string, apiResponse net.ApiResponse) {
+	type loginInfoResponse struct {
+		Prompts map[string][]string `json:"prompts"`
+	}
+
+	path := fmt.Sprintf("%s/login", uaa.config.AuthorizationEndpoint())
+	request, apiResponse := uaa.gateway.NewRequest("GET", path, "", nil)
+	if apiResponse.IsNotSuccessful() {
+		return
+	}
+
+	response := new(loginInfoResponse)
+	_, apiResponse = uaa.gateway.PerformRequestForJSONResponse(request, response)
+	if apiResponse.IsNotSuccessful() {
+		return
+	}
+
+	prompt := response.Prompts["passcode"]
+	if len(prompt) < 2 {
+		apiResponse = net.NewApiResponseWithMessage("The authentication server does not support one-time passcodes.")
+		return
+	}
+
+	passcodeURL = passcodeURLPattern.FindString(prompt[1])
+	if passcodeURL == "" {
+		passcodeURL = fmt.Sprintf("%s/passcode", uaa.config.AuthorizationEndpoint())
+	}
+	return
+}
+
+// AuthenticateClient logs in with the client_credentials grant. UAA does not
+// issue refresh tokens for this grant, so the credentials are kept in the
+// config and used again when the token has to be refreshed.
+func (uaa UAAAuthenticationRepository) AuthenticateClient(clientId string, clientSecret string) (apiResponse net.ApiResponse) {
+	apiResponse = uaa.authenticateClient(clientId, clientSecret)
+	if apiResponse.IsNotSuccessful() && apiResponse.StatusCode == 401 {
+		apiResponse.Message = "Client credentials are incorrect, please try again."
+		return
+	}
+
+	if apiResponse.IsSuccessful() {
+		uaa.config.SetClientCredentials(clientId, clientSecret)
+	}
+	return
+}
+
+func (uaa UAAAuthenticationRepository) authenticateClient(clientId string, clientSecret string) (apiResponse net.ApiResponse) {
+	data := url.Values{
+		"grant_type": {"client_credentials"},
+	}
+
+	return uaa.getAuthTokenForClient(clientId, clientSecret, data)
+}
+
+func (uaa UAAAuthenticationRepository) RefreshAuthToken() (updatedToken string, apiResponse net.ApiResponse) {
+	tokenRefreshMutex.Lock()
+	defer tokenRefreshMutex.Unlock()
+
+	return uaa.refreshAuthToken()
+}
+
+func (uaa UAAAuthenticationRepository) RefreshAuthTokenIfExpiring(accessToken string) (updatedToken string, apiResponse net.ApiResponse) {
+	updatedToken = accessToken
+	if !tokenIsExpiring(accessToken) {
+		return
+	}
+
+	tokenRefreshMutex.Lock()
+	defer tokenRefreshMutex.Unlock()
+
+	// another request may have refreshed the token while we were waiting
 	updatedToken = uaa.config.AccessToken()
+	if updatedToken != accessToken && !tokenIsExpiring(updatedToken) {
+		return
+	}
+
+	return uaa.refreshAuthToken()
+}
+
+func (uaa UAAAuthenticationRepository) refreshAuthToken() (updatedToken string, apiResponse net.ApiResponse) {
+	clientId, clientSecret := uaa.clientCredentials()
 
+	if uaa.config.RefreshToken() == "" && clientId != "" {
+		apiResponse = uaa.authenticateClient(clientId, clientSecret)
+	} else {
+		data := url.Values{
+			"refresh_token": {uaa.config.RefreshToken()},
+			"grant_type":    {"refresh_token"},
+			"scope":         {""},
+		}
+		apiResponse = uaa.getAuthToken(data)
+	}
+
+	updatedToken = uaa.config.AccessToken()
+
+	// the session is over, which commands report like any other failure so
+	// that cf shell keeps running
 	if apiResponse.IsError() {
-		fmt.Printf("%s\n\n", terminal.NotLoggedInText())
-		os.Exit(1)
+		apiResponse = net.NewApiResponse(terminal.NotLoggedInText(), apiResponse.ErrorCode, http.StatusUnauthorized)
 	}
 
 	return
 }
 
+func (uaa UAAAuthenticationRepository) clientCredentials() (clientId string, clientSecret string) {
+	clientId = uaa.config.ClientId()
+	clientSecret = uaa.config.ClientSecret()
+	if clientId == "" {
+		clientId = os.Getenv(CF_CLIENT_ID)
+		clientSecret = os.Getenv(CF_CLIENT_SECRET)
+	}
+	return
+}
+
+func tokenIsExpiring(accessToken string) bool {
+	expiresAt := configuration.NewTokenInfo(accessToken).ExpiresAt()
+	if expiresAt.IsZero() {
+		return false
+	}
+
+	return time.Now().Add(AccessTokenExpiryMargin).After(expiresAt)
+}
+
 func (uaa UAAAuthenticationRepository) getAuthToken(data url.Values) (apiResponse net.ApiResponse) {
+	return uaa.getAuthTokenForClient("cf", "", data)
+}
+
+func (uaa UAAAuthenticationRepository) getAuthTokenForClient(clientId string, clientSecret string, data url.Values) (apiResponse net.ApiResponse) {
 	type uaaErrorResponse struct {
 		Code        string `json:"error"`
 		Description string `json:"error_description"`
@@ -74,7 +232,7 @@ func (uaa UAAAuthenticationRepository) getAuthToken(data url.Values) (apiRespons
 	}
 
 	path := fmt.Sprintf("%s/oauth/token", uaa.config.AuthorizationEndpoint())
-	request, apiResponse := uaa.gateway.NewRequest("POST", path, "Basic "+base64.StdEncoding.EncodeToString([]byte("cf:")), strings.NewReader(data.Encode()))
+	request, apiResponse := uaa.gateway.NewRequest("POST", path, "Basic "+base64.StdEncoding.EncodeToString([]byte(clientId+":"+clientSecret)), strings.NewReader(data.Encode()))
 	if apiResponse.IsNotSuccessful() {
 		return
 	}
@@ -10,8 +10,10 @@ import (
 	. "github.com/onsi/gomega"
 	"net/http"
 	"net/http/httptest"
+	"os"
 	testconfig "testhelpers/configuration"
 	testnet "testhelpers/net"
+	"time"
 )
 
 var _ = Describe("AuthenticationRepository", func() {
@@ -67,8 +69,180 @@ var _ = Describe("AuthenticationRepository", func() {
 		Expect(apiResponse.Message).To(Equal("Authentication Server error: I/O error: uaa.10.244.0.22.xip.io; nested exception is java.net.UnknownHostException: uaa.10.244.0.22.xip.io"))
 		Expect(deps.config.AccessToken()).To(BeEmpty())
 	})
+
+	It("logs in with the client_credentials grant", func() {
+		deps := setupAuthDependencies(successfulClientCredentialsRequest)
+		defer teardownAuthDependencies(deps)
+
+		auth := NewUAAAuthenticationRepository(deps.gateway, deps.config)
+		apiResponse := auth.AuthenticateClient("my-client", "my-secret")
+
+		Expect(deps.handler.AllRequestsCalled()).To(BeTrue())
+		Expect(apiResponse.IsSuccessful()).To(BeTrue())
+		Expect(deps.config.AccessToken()).To(Equal("BEARER my_client_access_token"))
+		Expect(deps.config.RefreshToken()).To(BeEmpty())
+		Expect(deps.config.ClientId()).To(Equal("my-client"))
+		Expect(deps.config.ClientSecret()).To(Equal("my-secret"))
+	})
+
+	It("reports incorrect client credentials", func() {
+		deps := setupAuthDependencies(unsuccessfulLoginRequest)
+		defer teardownAuthDependencies(deps)
+
+		auth := NewUAAAuthenticationRepository(deps.gateway, deps.config)
+		apiResponse := auth.AuthenticateClient("my-client", "wrong-secret")
+
+		Expect(apiResponse.IsNotSuccessful()).To(BeTrue())
+		Expect(apiResponse.Message).To(Equal("Client credentials are incorrect, please try again."))
+		Expect(deps.config.ClientId()).To(BeEmpty())
+	})
+
+	It("forgets client credentials after logging in with a password", func() {
+		deps := setupAuthDependencies(successfulLoginRequest)
+		defer teardownAuthDependencies(deps)
+
+		deps.config.SetClientCredentials("my-client", "my-secret")
+
+		auth := NewUAAAuthenticationRepository(deps.gateway, deps.config)
+		apiResponse := auth.Authenticate("foo@example.com", "bar")
+
+		Expect(apiResponse.IsSuccessful()).To(BeTrue())
+		Expect(deps.config.ClientId()).To(BeEmpty())
+		Expect(deps.config.ClientSecret()).To(BeEmpty())
+	})
+
+	It("logs in again with the stored client credentials instead of refreshing", func() {
+		deps := setupAuthDependencies(successfulClientCredentialsRequest)
+		defer teardownAuthDependencies(deps)
+
+		deps.config.SetAccessToken("BEARER my_old_access_token")
+		deps.config.SetClientCredentials("my-client", "my-secret")
+
+		auth := NewUAAAuthenticationRepository(deps.gateway, deps.config)
+		updatedToken, apiResponse := auth.RefreshAuthToken()
+
+		Expect(deps.handler.AllRequestsCalled()).To(BeTrue())
+		Expect(apiResponse.IsSuccessful()).To(BeTrue())
+		Expect(updatedToken).To(Equal("BEARER my_client_access_token"))
+	})
+
+	It("logs in again with client credentials from the environment instead of refreshing", func() {
+		deps := setupAuthDependencies(successfulClientCredentialsRequest)
+		defer teardownAuthDependencies(deps)
+
+		os.Setenv(CF_CLIENT_ID, "my-client")
+		os.Setenv(CF_CLIENT_SECRET, "my-secret")
+		defer os.Setenv(CF_CLIENT_ID, "")
+		defer os.Setenv(CF_CLIENT_SECRET, "")
+
+		deps.config.SetAccessToken("BEARER my_old_access_token")
+
+		auth := NewUAAAuthenticationRepository(deps.gateway, deps.config)
+		updatedToken, apiResponse := auth.RefreshAuthToken()
+
+		Expect(deps.handler.AllRequestsCalled()).To(BeTrue())
+		Expect(apiResponse.IsSuccessful()).To(BeTrue())
+		Expect(updatedToken).To(Equal("BEARER my_client_access_token"))
+	})
+
+	It("reports a failed refresh as not logged in", func() {
+		deps := setupAuthDependencies(unsuccessfulLoginRequest)
+		defer teardownAuthDependencies(deps)
+
+		deps.config.SetAccessToken("BEARER my_old_access_token")
+		deps.config.SetRefreshToken("my_old_refresh_token")
+
+		auth := NewUAAAuthenticationRepository(deps.gateway, deps.config)
+		_, apiResponse := auth.RefreshAuthToken()
+
+		Expect(apiResponse.IsNotSuccessful()).To(BeTrue())
+		Expect(apiResponse.StatusCode).To(Equal(http.StatusUnauthorized))
+		Expect(apiResponse.Message).To(ContainSubstring("Not logged in"))
+	})
+
+	It("finds the passcode URL in the UAA login info", func() {
+		deps := setupAuthDependencies(loginInfoRequest)
+		defer teardownAuthDependencies(deps)
+
+		auth := NewUAAAuthenticationRepository(deps.gateway, deps.config)
+		passcodeURL, apiResponse := auth.GetPasscodeURL()
+
+		Expect(deps.handler.AllRequestsCalled()).To(BeTrue())
+		Expect(apiResponse.IsSuccessful()).To(BeTrue())
+		Expect(passcodeURL).To(Equal("https://login.example.com/passcode"))
+	})
+
+	It("logs in with a one-time passcode", func() {
+		deps := setupAuthDependencies(successfulPasscodeRequest)
+		defer teardownAuthDependencies(deps)
+
+		auth := NewUAAAuthenticationRepository(deps.gateway, deps.config)
+		apiResponse := auth.AuthenticateWithPasscode("my-passcode")
+
+		Expect(deps.handler.AllRequestsCalled()).To(BeTrue())
+		Expect(apiResponse.IsSuccessful()).To(BeTrue())
+		Expect(deps.config.AccessToken()).To(Equal("BEARER my_access_token"))
+		Expect(deps.config.RefreshToken()).To(Equal("my_refresh_token"))
+	})
+
+	It("TestRefreshingATokenThatIsAboutToExpire", func() {
+		deps := setupAuthDependencies(successfulRefreshRequest)
+		defer teardownAuthDependencies(deps)
+
+		expiringToken := tokenExpiringIn(10 * time.Second)
+		deps.config.SetAccessToken(expiringToken)
+		deps.config.SetRefreshToken("my_old_refresh_token")
+
+		auth := NewUAAAuthenticationRepository(deps.gateway, deps.config)
+		updatedToken, apiResponse := auth.RefreshAuthTokenIfExpiring(expiringToken)
+
+		Expect(deps.handler.AllRequestsCalled()).To(BeTrue())
+		Expect(apiResponse.IsSuccessful()).To(BeTrue())
+		Expect(updatedToken).To(Equal("BEARER my_access_token"))
+		Expect(deps.config.AccessToken()).To(Equal("BEARER my_access_token"))
+		Expect(deps.config.RefreshToken()).To(Equal("my_refresh_token"))
+	})
+
+	It("TestNotRefreshingATokenThatIsStillValid", func() {
+		deps := setupAuthDependencies(successfulRefreshRequest)
+		defer teardownAuthDependencies(deps)
+
+		validToken := tokenExpiringIn(1 * time.Hour)
+		deps.config.SetAccessToken(validToken)
+
+		auth := NewUAAAuthenticationRepository(deps.gateway, deps.config)
+		updatedToken, apiResponse := auth.RefreshAuthTokenIfExpiring(validToken)
+
+		Expect(deps.handler.AllRequestsCalled()).To(BeFalse())
+		Expect(apiResponse.IsSuccessful()).To(BeTrue())
+		Expect(updatedToken).To(Equal(validToken))
+	})
+
+	It("TestNotRefreshingWhenAnotherRequestAlreadyRefreshedTheToken", func() {
+		deps := setupAuthDependencies(successfulRefreshRequest)
+		defer teardownAuthDependencies(deps)
+
+		refreshedToken := tokenExpiringIn(1 * time.Hour)
+		deps.config.SetAccessToken(refreshedToken)
+
+		auth := NewUAAAuthenticationRepository(deps.gateway, deps.config)
+		updatedToken, apiResponse := auth.RefreshAuthTokenIfExpiring(tokenExpiringIn(10 * time.Second))
+
+		Expect(deps.handler.AllRequestsCalled()).To(BeFalse())
+		Expect(apiResponse.IsSuccessful()).To(BeTrue())
+		Expect(updatedToken).To(Equal(refreshedToken))
+	})
 })
 
+func tokenExpiringIn(duration time.Duration) string {
+	token, err := testconfig.EncodeAccessToken(configuration.TokenInfo{
+		Username: "my-user",
+		Expiry:   time.Now().Add(duration).Unix(),
+	})
+	Expect(err).NotTo(HaveOccurred())
+	return token
+}
+
 var authHeaders = http.Header{
 	"accept":        {"application/json"},
 	"content-type":  {"application/x-www-form-urlencoded"},
@@ -105,6 +279,102 @@ var successfulLoginMatcher = func(request *http.Request) {
 	Expect(request.Form.Get("scope")).To(Equal(""), "Scope did not mathc.")
 }
 
+var successfulRefreshRequest = testnet.TestRequest{
+	Method: "POST",
+	Path:   "/oauth/token",
+	Header: authHeaders,
+	Matcher: func(request *http.Request) {
+		err := request.ParseForm()
+		if err != nil {
+			Fail(fmt.Sprintf("Failed to parse form: %s", err))
+			return
+		}
+
+		Expect(request.Form.Get("grant_type")).To(Equal("refresh_token"))
+		Expect(request.Form.Get("refresh_token")).To(Equal("my_old_refresh_token"))
+	},
+	Response: testnet.TestResponse{
+		Status: http.StatusOK,
+		Body: `
+{
+  "access_token": "my_access_token",
+  "token_type": "BEARER",
+  "refresh_token": "my_refresh_token",
+  "scope": "openid",
+  "expires_in": 98765
+} `},
+}
+
+var successfulClientCredentialsRequest = testnet.TestRequest{
+	Method: "POST",
+	Path:   "/oauth/token",
+	Header: http.Header{
+		"accept":        {"application/json"},
+		"content-type":  {"application/x-www-form-urlencoded"},
+		"authorization": {"Basic " + base64.StdEncoding.EncodeToString([]byte("my-client:my-secret"))},
+	},
+	Matcher: func(request *http.Request) {
+		err := request.ParseForm()
+		if err != nil {
+			Fail(fmt.Sprintf("Failed to parse form: %s", err))
+			return
+		}
+
+		Expect(request.Form.Get("grant_type")).To(Equal("client_credentials"))
+	},
+	Response: testnet.TestResponse{
+		Status: http.StatusOK,
+		Body: `
+{
+  "access_token": "my_client_access_token",
+  "token_type": "BEARER",
+  "scope": "cloud_controller.read",
+  "expires_in": 43199
+} `},
+}
+
+var loginInfoRequest = testnet.TestRequest{
+	Method: "GET",
+	Path:   "/login",
+	Response: testnet.TestResponse{
+		Status: http.StatusOK,
+		Body: `
+{
+  "prompts": {
+    "username": ["text", "Email"],
+    "password": ["password", "Password"],
+    "passcode": ["password", "One Time Code (Get one at https://login.example.com/passcode)"]
+  }
+}`},
+}
+
+var successfulPasscodeRequest = testnet.TestRequest{
+	Method: "POST",
+	Path:   "/oauth/token",
+	Header: authHeaders,
+	Matcher: func(request *http.Request) {
+		err := request.ParseForm()
+		if err != nil {
+			Fail(fmt.Sprintf("Failed to parse form: %s", err))
+			return
+		}
+
+		Expect(request.Form.Get("grant_type")).To(Equal("password"))
+		Expect(request.Form.Get("passcode")).To(Equal("my-passcode"))
+		Expect(request.Form.Get("username")).To(BeEmpty())
+	},
+	Response: testnet.TestResponse{
+		Status: http.StatusOK,
+		Body: `
+{
+  "access_token": "my_access_token",
+  "token_type": "BEARER",
+  "refresh_token": "my_refresh_token",
+  "scope": "openid",
+  "expires_in": 98765
+} `},
+}
+
 var unsuccessfulLoginRequest = testnet.TestRequest{
 	Method: "POST",
 	Path:   "/oauth/token",
@@ -22,11 +22,13 @@ type LogsRepository interface {
 type LoggregatorLogsRepository struct {
 	config       configuration.Reader
 	endpointRepo EndpointRepository
+	authRepo     AuthenticationRepository
 }
 
-func NewLoggregatorLogsRepository(config configuration.Reader, endpointRepo EndpointRepository) (repo LoggregatorLogsRepository) {
+func NewLoggregatorLogsRepository(config configuration.Reader, endpointRepo EndpointRepository, authRepo AuthenticationRepository) (repo LoggregatorLogsRepository) {
 	repo.config = config
 	repo.endpointRepo = endpointRepo
+	repo.authRepo = authRepo
 	return
 }
 
@@ -64,7 +66,13 @@ func (repo LoggregatorLogsRepository) connectToWebsocket(location string, onConn
 		return
 	}
 
-	wsConfig.Header.Add("Authorization", repo.config.AccessToken())
+	accessToken, apiResponse := repo.authRepo.RefreshAuthTokenIfExpiring(repo.config.AccessToken())
+	if apiResponse.IsNotSuccessful() {
+		err = errors.New(apiResponse.Message)
+		return
+	}
+
+	wsConfig.Header.Add("Authorization", accessToken)
 	wsConfig.TlsConfig = &tls.Config{InsecureSkipVerify: true}
 
 	ws, err := websocket.DialConfig(wsConfig)
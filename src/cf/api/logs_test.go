@@ -121,7 +121,7 @@ func setupTestServerAndLogsRepo(messages ...[]byte) (testServer *httptest.Server
 	endpointRepo := &testapi.FakeEndpointRepo{}
 	endpointRepo.LoggregatorEndpointReturns.Endpoint = strings.Replace(testServer.URL, "https", "wss", 1)
 
-	repo := NewLoggregatorLogsRepository(configRepo, endpointRepo)
+	repo := NewLoggregatorLogsRepository(configRepo, endpointRepo, &testapi.FakeAuthenticationRepository{})
 	logsRepo = &repo
 	return
 }
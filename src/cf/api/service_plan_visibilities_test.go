@@ -0,0 +1,101 @@
+package api_test
+
+import (
+	. "cf/api"
+	"cf/models"
+	"cf/net"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"net/http"
+	"net/http/httptest"
+	testapi "testhelpers/api"
+	testconfig "testhelpers/configuration"
+	testnet "testhelpers/net"
+)
+
+var _ = Describe("Service Plan Visibilities Repo", func() {
+	It("lists the visibilities of all pages", func() {
+		firstRequest := testapi.NewCloudControllerTestRequest(testnet.TestRequest{
+			Method: "GET",
+			Path:   "/v2/service_plan_visibilities",
+			Response: testnet.TestResponse{
+				Status: http.StatusOK,
+				Body: `{
+				  "next_url": "/v2/service_plan_visibilities?page=2",
+				  "resources": [
+					{
+					  "metadata": {"guid": "visibility-1-guid"},
+					  "entity": {"service_plan_guid": "plan-1-guid", "organization_guid": "org-1-guid"}
+					}
+				  ]
+				}`,
+			},
+		})
+
+		secondRequest := testapi.NewCloudControllerTestRequest(testnet.TestRequest{
+			Method: "GET",
+			Path:   "/v2/service_plan_visibilities?page=2",
+			Response: testnet.TestResponse{
+				Status: http.StatusOK,
+				Body: `{
+				  "resources": [
+					{
+					  "metadata": {"guid": "visibility-2-guid"},
+					  "entity": {"service_plan_guid": "plan-2-guid", "organization_guid": "org-2-guid"}
+					}
+				  ]
+				}`,
+			},
+		})
+
+		ts, handler, repo := createServicePlanVisibilityRepo(firstRequest, secondRequest)
+		defer ts.Close()
+
+		visibilities, apiResponse := repo.List()
+
+		Expect(handler.AllRequestsCalled()).To(BeTrue())
+		Expect(apiResponse.IsSuccessful()).To(BeTrue())
+		Expect(visibilities).To(Equal([]models.ServicePlanVisibilityFields{
+			{Guid: "visibility-1-guid", ServicePlanGuid: "plan-1-guid", OrganizationGuid: "org-1-guid"},
+			{Guid: "visibility-2-guid", ServicePlanGuid: "plan-2-guid", OrganizationGuid: "org-2-guid"},
+		}))
+	})
+
+	It("makes a plan visible to an org", func() {
+		ts, handler, repo := createServicePlanVisibilityRepo(testapi.NewCloudControllerTestRequest(testnet.TestRequest{
+			Method:   "POST",
+			Path:     "/v2/service_plan_visibilities",
+			Matcher:  testnet.RequestBodyMatcher(`{"service_plan_guid":"plan-guid","organization_guid":"org-guid"}`),
+			Response: testnet.TestResponse{Status: http.StatusCreated},
+		}))
+		defer ts.Close()
+
+		apiResponse := repo.Create("plan-guid", "org-guid")
+
+		Expect(handler.AllRequestsCalled()).To(BeTrue())
+		Expect(apiResponse.IsSuccessful()).To(BeTrue())
+	})
+
+	It("deletes a visibility", func() {
+		ts, handler, repo := createServicePlanVisibilityRepo(testapi.NewCloudControllerTestRequest(testnet.TestRequest{
+			Method:   "DELETE",
+			Path:     "/v2/service_plan_visibilities/visibility-guid",
+			Response: testnet.TestResponse{Status: http.StatusNoContent},
+		}))
+		defer ts.Close()
+
+		apiResponse := repo.Delete("visibility-guid")
+
+		Expect(handler.AllRequestsCalled()).To(BeTrue())
+		Expect(apiResponse.IsSuccessful()).To(BeTrue())
+	})
+})
+
+func createServicePlanVisibilityRepo(requests ...testnet.TestRequest) (ts *httptest.Server, handler *testnet.TestHandler, repo ServicePlanVisibilityRepository) {
+	ts, handler = testnet.NewTLSServer(requests)
+	configRepo := testconfig.NewRepositoryWithDefaults()
+	configRepo.SetApiEndpoint(ts.URL)
+	gateway := net.NewCloudControllerGateway()
+	repo = NewCloudControllerServicePlanVisibilityRepository(configRepo, gateway)
+	return
+}
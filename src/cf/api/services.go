@@ -26,16 +26,14 @@ func (resource ServiceOfferingResource) ToFields() (fields models.ServiceOfferin
 	fields.Description = resource.Entity.Description
 	fields.Guid = resource.Metadata.Guid
 	fields.DocumentationUrl = resource.Entity.DocumentationUrl
+	fields.Tags = resource.Entity.Tags
 	return
 }
 
 func (resource ServiceOfferingResource) ToModel() (offering models.ServiceOffering) {
 	offering.ServiceOfferingFields = resource.ToFields()
 	for _, p := range resource.Entity.ServicePlans {
-		servicePlan := models.ServicePlanFields{}
-		servicePlan.Name = p.Entity.Name
-		servicePlan.Guid = p.Metadata.Guid
-		offering.Plans = append(offering.Plans, servicePlan)
+		offering.Plans = append(offering.Plans, p.ToFields())
 	}
 	return offering
 }
@@ -46,6 +44,7 @@ type ServiceOfferingEntity struct {
 	Description      string
 	DocumentationUrl string `json:"documentation_url"`
 	Provider         string
+	Tags             []string
 	ServicePlans     []ServicePlanResource `json:"service_plans"`
 }
 
@@ -57,11 +56,21 @@ type ServicePlanResource struct {
 func (resource ServicePlanResource) ToFields() (fields models.ServicePlanFields) {
 	fields.Guid = resource.Metadata.Guid
 	fields.Name = resource.Entity.Name
+	fields.Description = resource.Entity.Description
+	fields.Extra = resource.Entity.Extra
+	fields.Public = resource.Entity.Public
+
+	// plans are free unless the Cloud Controller says otherwise
+	fields.Free = resource.Entity.Free == nil || *resource.Entity.Free
 	return
 }
 
 type ServicePlanEntity struct {
 	Name            string
+	Description     string
+	Free            *bool
+	Public          bool
+	Extra           string
 	ServiceOffering ServiceOfferingResource `json:"service"`
 }
 
@@ -77,6 +86,11 @@ type ServiceInstanceResource struct {
 func (resource ServiceInstanceResource) ToFields() (fields models.ServiceInstanceFields) {
 	fields.Guid = resource.Metadata.Guid
 	fields.Name = resource.Entity.Name
+	fields.LastOperation = models.LastOperationFields{
+		Type:        resource.Entity.LastOperation.Type,
+		State:       resource.Entity.LastOperation.State,
+		Description: resource.Entity.LastOperation.Description,
+	}
 	return
 }
 
@@ -96,6 +110,13 @@ type ServiceInstanceEntity struct {
 	Name            string
 	ServiceBindings []ServiceBindingResource `json:"service_bindings"`
 	ServicePlan     ServicePlanResource      `json:"service_plan"`
+	LastOperation   LastOperationEntity      `json:"last_operation"`
+}
+
+type LastOperationEntity struct {
+	Type        string
+	State       string
+	Description string
 }
 
 type ServiceBindingResource struct {
@@ -118,9 +139,12 @@ type ServiceRepository interface {
 	PurgeServiceOffering(offering models.ServiceOffering) net.ApiResponse
 	FindServiceOfferingByLabelAndProvider(name, provider string) (offering models.ServiceOffering, apiResponse net.ApiResponse)
 	GetServiceOfferings() (offerings models.ServiceOfferings, apiResponse net.ApiResponse)
+	GetServiceOfferingsForBroker(brokerGuid string) (offerings models.ServiceOfferings, apiResponse net.ApiResponse)
 	FindInstanceByName(name string) (instance models.ServiceInstance, apiResponse net.ApiResponse)
 	CreateServiceInstance(name, planGuid string) (identicalAlreadyExists bool, apiResponse net.ApiResponse)
 	RenameService(instance models.ServiceInstance, newName string) (apiResponse net.ApiResponse)
+	UpdateServicePlan(instance models.ServiceInstance, planGuid string) (apiResponse net.ApiResponse)
+	SetServicePlanPublic(planGuid string, public bool) (apiResponse net.ApiResponse)
 	DeleteService(instance models.ServiceInstance) (apiResponse net.ApiResponse)
 }
 
@@ -143,6 +167,15 @@ func (repo CloudControllerServiceRepository) GetServiceOfferings() (offerings mo
 		path = fmt.Sprintf("%s/v2/spaces/%s/services?inline-relations-depth=1", repo.config.ApiEndpoint(), spaceGuid)
 	}
 
+	return repo.getServiceOfferings(path)
+}
+
+func (repo CloudControllerServiceRepository) GetServiceOfferingsForBroker(brokerGuid string) (offerings models.ServiceOfferings, apiResponse net.ApiResponse) {
+	path := fmt.Sprintf("%s/v2/services?q=%s&inline-relations-depth=1", repo.config.ApiEndpoint(), url.QueryEscape("service_broker_guid:"+brokerGuid))
+	return repo.getServiceOfferings(path)
+}
+
+func (repo CloudControllerServiceRepository) getServiceOfferings(path string) (offerings models.ServiceOfferings, apiResponse net.ApiResponse) {
 	resources := new(PaginatedServiceOfferingResources)
 	apiResponse = repo.gateway.GetResource(path, repo.config.AccessToken(), resources)
 	if apiResponse.IsNotSuccessful() {
@@ -208,6 +241,18 @@ func (repo CloudControllerServiceRepository) RenameService(instance models.Servi
 	return repo.gateway.UpdateResource(path, repo.config.AccessToken(), strings.NewReader(body))
 }
 
+func (repo CloudControllerServiceRepository) UpdateServicePlan(instance models.ServiceInstance, planGuid string) (apiResponse net.ApiResponse) {
+	body := fmt.Sprintf(`{"service_plan_guid":"%s"}`, planGuid)
+	path := fmt.Sprintf("%s/v2/service_instances/%s", repo.config.ApiEndpoint(), instance.Guid)
+	return repo.gateway.UpdateResource(path, repo.config.AccessToken(), strings.NewReader(body))
+}
+
+func (repo CloudControllerServiceRepository) SetServicePlanPublic(planGuid string, public bool) (apiResponse net.ApiResponse) {
+	body := fmt.Sprintf(`{"public":%t}`, public)
+	path := fmt.Sprintf("%s/v2/service_plans/%s", repo.config.ApiEndpoint(), planGuid)
+	return repo.gateway.UpdateResource(path, repo.config.AccessToken(), strings.NewReader(body))
+}
+
 func (repo CloudControllerServiceRepository) DeleteService(instance models.ServiceInstance) (apiResponse net.ApiResponse) {
 	if len(instance.ServiceBindings) > 0 {
 		return net.NewApiResponseWithMessage("Cannot delete service instance, apps are still bound to it")
@@ -29,10 +29,17 @@ var multipleOfferingsResponse = testnet.TestResponse{Status: http.StatusOK, Body
         "provider": "Offering 1 provider",
         "description": "Offering 1 description",
         "version" : "1.0",
+        "tags": ["mysql", "relational"],
         "service_plans": [
         	{
         		"metadata": {"guid": "offering-1-plan-1-guid"},
-        		"entity": {"name": "Offering 1 Plan 1"}
+        		"entity": {
+        			"name": "Offering 1 Plan 1",
+        			"description": "Offering 1 Plan 1 description",
+        			"free": false,
+        			"public": true,
+        			"extra": "{\"cost\":10}"
+        		}
         	},
         	{
         		"metadata": {"guid": "offering-1-plan-2-guid"},
@@ -77,11 +84,18 @@ func testGetServiceOfferings(req testnet.TestRequest, config configuration.ReadW
 	Expect(firstOffering.Description).To(Equal("Offering 1 description"))
 	Expect(firstOffering.Provider).To(Equal("Offering 1 provider"))
 	Expect(firstOffering.Guid).To(Equal("offering-1-guid"))
+	Expect(firstOffering.Tags).To(Equal([]string{"mysql", "relational"}))
 	Expect(len(firstOffering.Plans)).To(Equal(2))
 
 	plan := firstOffering.Plans[0]
 	Expect(plan.Name).To(Equal("Offering 1 Plan 1"))
 	Expect(plan.Guid).To(Equal("offering-1-plan-1-guid"))
+	Expect(plan.Description).To(Equal("Offering 1 Plan 1 description"))
+	Expect(plan.Free).To(BeFalse())
+	Expect(plan.Public).To(BeTrue())
+	Expect(plan.Extra).To(Equal(`{"cost":10}`))
+
+	Expect(firstOffering.Plans[1].Free).To(BeTrue())
 
 	secondOffering := offerings[1]
 	Expect(secondOffering.Label).To(Equal("Offering 2"))
@@ -99,6 +113,11 @@ var findServiceInstanceReq = testapi.NewCloudControllerTestRequest(testnet.TestR
 		  },
 		  "entity": {
 			"name": "my-service",
+			"last_operation": {
+			  "type": "create",
+			  "state": "in progress",
+			  "description": "Provisioning the database"
+			},
 			"service_bindings": [
 			  {
 				"metadata": {
@@ -282,6 +301,11 @@ var _ = Describe("Testing with ginkgo", func() {
 		Expect(instance.ServiceOffering.DocumentationUrl).To(Equal("http://info.example.com"))
 		Expect(instance.ServiceOffering.Description).To(Equal("MySQL database"))
 		Expect(instance.ServicePlan.Name).To(Equal("plan-name"))
+		Expect(instance.LastOperation).To(Equal(models.LastOperationFields{
+			Type:        "create",
+			State:       models.ServiceOperationInProgress,
+			Description: "Provisioning the database",
+		}))
 		Expect(len(instance.ServiceBindings)).To(Equal(2))
 
 		binding := instance.ServiceBindings[0]
@@ -361,6 +385,58 @@ var _ = Describe("Testing with ginkgo", func() {
 		testRenameService(path, serviceInstance)
 	})
 
+	It("updates the plan of a service instance", func() {
+		req := testapi.NewCloudControllerTestRequest(testnet.TestRequest{
+			Method:   "PUT",
+			Path:     "/v2/service_instances/my-service-instance-guid",
+			Matcher:  testnet.RequestBodyMatcher(`{"service_plan_guid":"new-plan-guid"}`),
+			Response: testnet.TestResponse{Status: http.StatusCreated},
+		})
+
+		ts, handler, repo := createServiceRepo([]testnet.TestRequest{req})
+		defer ts.Close()
+
+		serviceInstance := models.ServiceInstance{}
+		serviceInstance.Guid = "my-service-instance-guid"
+
+		apiResponse := repo.UpdateServicePlan(serviceInstance, "new-plan-guid")
+		Expect(handler.AllRequestsCalled()).To(BeTrue())
+		Expect(apiResponse.IsNotSuccessful()).To(BeFalse())
+	})
+
+	It("lists the service offerings of a broker", func() {
+		req := testapi.NewCloudControllerTestRequest(testnet.TestRequest{
+			Method:   "GET",
+			Path:     "/v2/services?q=service_broker_guid%3Amy-broker-guid&inline-relations-depth=1",
+			Response: multipleOfferingsResponse,
+		})
+
+		ts, handler, repo := createServiceRepo([]testnet.TestRequest{req})
+		defer ts.Close()
+
+		offerings, apiResponse := repo.GetServiceOfferingsForBroker("my-broker-guid")
+		Expect(handler.AllRequestsCalled()).To(BeTrue())
+		Expect(apiResponse.IsNotSuccessful()).To(BeFalse())
+		Expect(len(offerings)).To(Equal(2))
+		Expect(offerings[0].Plans[0].Name).To(Equal("Offering 1 Plan 1"))
+	})
+
+	It("makes a service plan public", func() {
+		req := testapi.NewCloudControllerTestRequest(testnet.TestRequest{
+			Method:   "PUT",
+			Path:     "/v2/service_plans/my-plan-guid",
+			Matcher:  testnet.RequestBodyMatcher(`{"public":true}`),
+			Response: testnet.TestResponse{Status: http.StatusCreated},
+		})
+
+		ts, handler, repo := createServiceRepo([]testnet.TestRequest{req})
+		defer ts.Close()
+
+		apiResponse := repo.SetServicePlanPublic("my-plan-guid", true)
+		Expect(handler.AllRequestsCalled()).To(BeTrue())
+		Expect(apiResponse.IsNotSuccessful()).To(BeFalse())
+	})
+
 	It("finds service offerings by label and provider", func() {
 		_, _, repo := createServiceRepo([]testnet.TestRequest{{
 			Method: "GET",
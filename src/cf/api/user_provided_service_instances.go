@@ -10,8 +10,18 @@ import (
 )
 
 type UserProvidedServiceInstanceRepository interface {
-	Create(name, drainUrl string, params map[string]string) (apiResponse net.ApiResponse)
+	Create(name, drainUrl string, params map[string]interface{}) (apiResponse net.ApiResponse)
 	Update(serviceInstanceFields models.ServiceInstanceFields) (apiResponse net.ApiResponse)
+	GetCredentials(guid string) (credentials map[string]interface{}, apiResponse net.ApiResponse)
+}
+
+type UserProvidedServiceInstanceResource struct {
+	Metadata Metadata
+	Entity   UserProvidedServiceInstanceEntity
+}
+
+type UserProvidedServiceInstanceEntity struct {
+	Credentials map[string]interface{}
 }
 
 type CCUserProvidedServiceInstanceRepository struct {
@@ -25,14 +35,14 @@ func NewCCUserProvidedServiceInstanceRepository(config configuration.Reader, gat
 	return
 }
 
-func (repo CCUserProvidedServiceInstanceRepository) Create(name, drainUrl string, params map[string]string) (apiResponse net.ApiResponse) {
+func (repo CCUserProvidedServiceInstanceRepository) Create(name, drainUrl string, params map[string]interface{}) (apiResponse net.ApiResponse) {
 	path := fmt.Sprintf("%s/v2/user_provided_service_instances", repo.config.ApiEndpoint())
 
 	type RequestBody struct {
-		Name           string            `json:"name"`
-		Credentials    map[string]string `json:"credentials"`
-		SpaceGuid      string            `json:"space_guid"`
-		SysLogDrainUrl string            `json:"syslog_drain_url"`
+		Name           string                 `json:"name"`
+		Credentials    map[string]interface{} `json:"credentials"`
+		SpaceGuid      string                 `json:"space_guid"`
+		SysLogDrainUrl string                 `json:"syslog_drain_url"`
 	}
 
 	jsonBytes, err := json.Marshal(RequestBody{
@@ -54,8 +64,8 @@ func (repo CCUserProvidedServiceInstanceRepository) Update(serviceInstanceFields
 	path := fmt.Sprintf("%s/v2/user_provided_service_instances/%s", repo.config.ApiEndpoint(), serviceInstanceFields.Guid)
 
 	type RequestBody struct {
-		Credentials    map[string]string `json:"credentials,omitempty"`
-		SysLogDrainUrl string            `json:"syslog_drain_url,omitempty"`
+		Credentials    map[string]interface{} `json:"credentials,omitempty"`
+		SysLogDrainUrl string                 `json:"syslog_drain_url,omitempty"`
 	}
 
 	reqBody := RequestBody{serviceInstanceFields.Params, serviceInstanceFields.SysLogDrainUrl}
@@ -67,3 +77,18 @@ func (repo CCUserProvidedServiceInstanceRepository) Update(serviceInstanceFields
 
 	return repo.gateway.UpdateResource(path, repo.config.AccessToken(), bytes.NewReader(jsonBytes))
 }
+
+// GetCredentials loads the credentials of a user-provided service instance,
+// which the service instance models leave out so that they aren't shown
+func (repo CCUserProvidedServiceInstanceRepository) GetCredentials(guid string) (credentials map[string]interface{}, apiResponse net.ApiResponse) {
+	path := fmt.Sprintf("%s/v2/user_provided_service_instances/%s", repo.config.ApiEndpoint(), guid)
+
+	resource := new(UserProvidedServiceInstanceResource)
+	apiResponse = repo.gateway.GetResource(path, repo.config.AccessToken(), resource)
+	if apiResponse.IsNotSuccessful() {
+		return
+	}
+
+	credentials = resource.Entity.Credentials
+	return
+}
@@ -25,7 +25,7 @@ var _ = Describe("Testing with ginkgo", func() {
 		ts, handler, repo := createUserProvidedServiceInstanceRepo(req)
 		defer ts.Close()
 
-		apiResponse := repo.Create("my-custom-service", "", map[string]string{
+		apiResponse := repo.Create("my-custom-service", "", map[string]interface{}{
 			"host":     "example.com",
 			"user":     "me",
 			"password": "secret",
@@ -33,6 +33,24 @@ var _ = Describe("Testing with ginkgo", func() {
 		Expect(handler.AllRequestsCalled()).To(BeTrue())
 		Expect(apiResponse.IsNotSuccessful()).To(BeFalse())
 	})
+	It("creates user-provided service instances with nested and non-string credentials", func() {
+		req := testapi.NewCloudControllerTestRequest(testnet.TestRequest{
+			Method:   "POST",
+			Path:     "/v2/user_provided_service_instances",
+			Matcher:  testnet.RequestBodyMatcher(`{"name":"my-custom-service","credentials":{"hosts":["a.example.com","b.example.com"],"pool":{"max":10,"ssl":true}},"space_guid":"my-space-guid","syslog_drain_url":""}`),
+			Response: testnet.TestResponse{Status: http.StatusCreated},
+		})
+
+		ts, handler, repo := createUserProvidedServiceInstanceRepo(req)
+		defer ts.Close()
+
+		apiResponse := repo.Create("my-custom-service", "", map[string]interface{}{
+			"hosts": []interface{}{"a.example.com", "b.example.com"},
+			"pool":  map[string]interface{}{"max": 10, "ssl": true},
+		})
+		Expect(handler.AllRequestsCalled()).To(BeTrue())
+		Expect(apiResponse.IsNotSuccessful()).To(BeFalse())
+	})
 	It("TestCreateUserProvidedServiceInstanceWithSyslogDrain", func() {
 
 		req := testapi.NewCloudControllerTestRequest(testnet.TestRequest{
@@ -45,7 +63,7 @@ var _ = Describe("Testing with ginkgo", func() {
 		ts, handler, repo := createUserProvidedServiceInstanceRepo(req)
 		defer ts.Close()
 
-		apiResponse := repo.Create("my-custom-service", "syslog://example.com", map[string]string{
+		apiResponse := repo.Create("my-custom-service", "syslog://example.com", map[string]interface{}{
 			"host":     "example.com",
 			"user":     "me",
 			"password": "secret",
@@ -65,7 +83,7 @@ var _ = Describe("Testing with ginkgo", func() {
 		ts, handler, repo := createUserProvidedServiceInstanceRepo(req)
 		defer ts.Close()
 
-		params := map[string]string{
+		params := map[string]interface{}{
 			"host":     "example.com",
 			"user":     "me",
 			"password": "secret",
@@ -91,7 +109,7 @@ var _ = Describe("Testing with ginkgo", func() {
 		ts, handler, repo := createUserProvidedServiceInstanceRepo(req)
 		defer ts.Close()
 
-		params := map[string]string{
+		params := map[string]interface{}{
 			"host":     "example.com",
 			"user":     "me",
 			"password": "secret",
@@ -121,6 +139,31 @@ var _ = Describe("Testing with ginkgo", func() {
 		Expect(handler.AllRequestsCalled()).To(BeTrue())
 		Expect(apiResponse.IsNotSuccessful()).To(BeFalse())
 	})
+
+	It("gets the credentials of a user-provided service instance", func() {
+		req := testapi.NewCloudControllerTestRequest(testnet.TestRequest{
+			Method: "GET",
+			Path:   "/v2/user_provided_service_instances/my-instance-guid",
+			Response: testnet.TestResponse{Status: http.StatusOK, Body: `{
+				"metadata": {"guid": "my-instance-guid"},
+				"entity": {
+					"name": "my-custom-service",
+					"credentials": {"password": "secret", "pool": {"max": 10}}
+				}
+			}`},
+		})
+
+		ts, handler, repo := createUserProvidedServiceInstanceRepo(req)
+		defer ts.Close()
+
+		credentials, apiResponse := repo.GetCredentials("my-instance-guid")
+		Expect(handler.AllRequestsCalled()).To(BeTrue())
+		Expect(apiResponse.IsNotSuccessful()).To(BeFalse())
+		Expect(credentials).To(Equal(map[string]interface{}{
+			"password": "secret",
+			"pool":     map[string]interface{}{"max": float64(10)},
+		}))
+	})
 })
 
 func createUserProvidedServiceInstanceRepo(req testnet.TestRequest) (ts *httptest.Server, handler *testnet.TestHandler, repo UserProvidedServiceInstanceRepository) {
@@ -0,0 +1,55 @@
+package app
+
+import (
+	"cf/commands"
+	"github.com/codegangsta/cli"
+	"strings"
+)
+
+// ExpandAliases replaces the user-defined alias in the command position of
+// args, which are laid out like os.Args, by its expansion. Built-in commands
+// always win over aliases, and expansions are not expanded again.
+func ExpandAliases(app *cli.App, args []string, aliases map[string]string) (expanded []string, err error) {
+	expanded = args
+
+	position := commandPosition(app, args)
+	if position >= len(args) {
+		return
+	}
+
+	name := args[position]
+	expansion, found := aliases[name]
+	if !found || app.Command(name) != nil {
+		return
+	}
+
+	aliasArgs, err := commands.ExpandAlias(name, expansion, args[position+1:])
+	if err != nil {
+		return
+	}
+
+	expanded = append([]string{}, args[:position]...)
+	expanded = append(expanded, aliasArgs...)
+	return
+}
+
+// commandPosition skips the global flags and their values
+func commandPosition(app *cli.App, args []string) (position int) {
+	boolFlags := map[string]bool{"help": true, "h": true, "version": true, "v": true}
+	for _, flag := range app.Flags {
+		if boolFlag, ok := flag.(cli.BoolFlag); ok {
+			boolFlags[boolFlag.Name] = true
+		}
+	}
+
+	position = 1
+	for position < len(args) && strings.HasPrefix(args[position], "-") {
+		name := strings.TrimLeft(args[position], "-")
+		if strings.Contains(name, "=") || boolFlags[name] {
+			position++
+		} else {
+			position += 2
+		}
+	}
+	return
+}
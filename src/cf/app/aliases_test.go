@@ -0,0 +1,41 @@
+package app_test
+
+import (
+	. "cf/app"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("resolving aliases", func() {
+	aliases := map[string]string{
+		"lp":   "logs --recent",
+		"push": "push -f manifest-prod.yml",
+	}
+
+	expand := func(args ...string) []string {
+		app, err := NewApp(&FakeRunner{})
+		Expect(err).NotTo(HaveOccurred())
+
+		expanded, err := ExpandAliases(app, args, aliases)
+		Expect(err).NotTo(HaveOccurred())
+		return expanded
+	}
+
+	It("expands aliases in the command position", func() {
+		Expect(expand("cf", "lp", "my-app")).To(Equal([]string{"cf", "logs", "--recent", "my-app"}))
+	})
+
+	It("skips global flags before the command", func() {
+		Expect(expand("cf", "--non-interactive", "--output", "json", "lp", "my-app")).To(
+			Equal([]string{"cf", "--non-interactive", "--output", "json", "logs", "--recent", "my-app"}))
+	})
+
+	It("never lets aliases shadow built-in commands", func() {
+		Expect(expand("cf", "push", "my-app")).To(Equal([]string{"cf", "push", "my-app"}))
+	})
+
+	It("leaves other commands alone", func() {
+		Expect(expand("cf", "apps", "lp")).To(Equal([]string{"cf", "apps", "lp"}))
+		Expect(expand("cf")).To(Equal([]string{"cf"}))
+	})
+})
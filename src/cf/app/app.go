@@ -3,6 +3,8 @@ package app
 import (
 	"cf"
 	"cf/commands"
+	"cf/configuration"
+	"cf/errors"
 	"cf/terminal"
 	"fmt"
 	"github.com/codegangsta/cli"
@@ -29,9 +31,49 @@ func NewApp(cmdRunner commands.Runner) (app *cli.App, err error) {
 	app = cli.NewApp()
 	app.Usage = cf.Usage
 	app.Version = cf.Version
-	app.Action = helpCommand.Action
+	app.Action = func(c *cli.Context) {
+		args := c.Args()
+		if len(args) > 0 {
+			// the runner explains what the unknown command might have been
+			cmdRunner.RunCmdByName(args[0], c)
+		} else {
+			showAppHelp(appHelpTemplate, c.App)
+		}
+	}
+	app.Flags = []cli.Flag{
+		NewStringFlag("output", "Output format for list and show commands: text, json or yaml. Keys are the --format template fields in snake_case, e.g. {{.InstanceCount}} is instance_count"),
+		cli.BoolFlag{Name: "non-interactive", Usage: "Fail instead of prompting for input"},
+	}
 	app.Commands = []cli.Command{
 		helpCommand,
+		{
+			Name:        "alias",
+			Description: "Create or delete an alias for a command",
+			Usage: fmt.Sprintf("%s alias NAME \"EXPANSION\"\n", cf.Name()) +
+				fmt.Sprintf("   %s alias -d NAME\n\n", cf.Name()) +
+				"The expansion can refer to the arguments given to the alias as $1, $2... or $@ for\n" +
+				"   all of them. Arguments it does not refer to are added at its end. Aliases cannot\n" +
+				"   shadow built-in commands.\n\n" +
+				"EXAMPLE:\n" +
+				fmt.Sprintf("   %s alias lp \"logs --recent\"\n", cf.Name()) +
+				fmt.Sprintf("   %s alias prod-push \"push -f manifest-prod.yml\"\n", cf.Name()) +
+				fmt.Sprintf("   %s alias rs \"restart $1\"", cf.Name()),
+			Flags: []cli.Flag{
+				cli.BoolFlag{Name: "d", Usage: "Delete the alias"},
+			},
+			Action: func(c *cli.Context) {
+				cmdRunner.RunCmdByName("alias", c)
+			},
+		},
+		{
+			Name:        "aliases",
+			Description: "List all aliases",
+			Usage:       fmt.Sprintf("%s aliases", cf.Name()),
+			Flags:       NewTableFlags(),
+			Action: func(c *cli.Context) {
+				cmdRunner.RunCmdByName("aliases", c)
+			},
+		},
 		{
 			Name:        "api",
 			Description: "Set or view target api url",
@@ -44,6 +86,9 @@ func NewApp(cmdRunner commands.Runner) (app *cli.App, err error) {
 			Name:        "app",
 			Description: "Display health and status for app",
 			Usage:       fmt.Sprintf("%s app APP", cf.Name()),
+			Flags: []cli.Flag{
+				NewFormatFlag(),
+			},
 			Action: func(c *cli.Context) {
 				cmdRunner.RunCmdByName("app", c)
 			},
@@ -53,6 +98,7 @@ func NewApp(cmdRunner commands.Runner) (app *cli.App, err error) {
 			ShortName:   "a",
 			Description: "List all apps in the target space",
 			Usage:       fmt.Sprintf("%s apps", cf.Name()),
+			Flags:       NewTableFlags(),
 			Action: func(c *cli.Context) {
 				cmdRunner.RunCmdByName("apps", c)
 			},
@@ -60,11 +106,16 @@ func NewApp(cmdRunner commands.Runner) (app *cli.App, err error) {
 		{
 			Name:        "auth",
 			Description: "Authenticate user non-interactively",
-			Usage: fmt.Sprintf("%s auth USERNAME PASSWORD\n\n", cf.Name()) +
+			Usage: fmt.Sprintf("%s auth USERNAME PASSWORD\n", cf.Name()) +
+				fmt.Sprintf("   %s auth --client-credentials CLIENT_ID CLIENT_SECRET\n\n", cf.Name()) +
 				terminal.WarningColor("WARNING:\n   Providing your password as a command line option is highly discouraged\n   Your password may be visible to others and may be recorded in your shell history\n\n") +
 				"EXAMPLE:\n" +
 				fmt.Sprintf("   %s auth name@example.com \"my password\" (use quotes for passwords with a space)\n", cf.Name()) +
-				fmt.Sprintf("   %s auth name@example.com \"\\\"password\\\"\" (escape quotes if used in password)", cf.Name()),
+				fmt.Sprintf("   %s auth name@example.com \"\\\"password\\\"\" (escape quotes if used in password)\n", cf.Name()) +
+				fmt.Sprintf("   %s auth --client-credentials my-ci-client my-client-secret", cf.Name()),
+			Flags: []cli.Flag{
+				cli.BoolFlag{Name: "client-credentials", Usage: "Authenticate as a UAA client with the client_credentials grant"},
+			},
 			Action: func(c *cli.Context) {
 				cmdRunner.RunCmdByName("auth", c)
 			},
@@ -82,6 +133,7 @@ func NewApp(cmdRunner commands.Runner) (app *cli.App, err error) {
 			Name:        "buildpacks",
 			Description: "List all buildpacks",
 			Usage:       fmt.Sprintf("%s buildpacks", cf.Name()),
+			Flags:       NewTableFlags(),
 			Action: func(c *cli.Context) {
 				cmdRunner.RunCmdByName("buildpacks", c)
 			},
@@ -132,11 +184,16 @@ func NewApp(cmdRunner commands.Runner) (app *cli.App, err error) {
 			Name:        "create-service",
 			ShortName:   "cs",
 			Description: "Create a service instance",
-			Usage: fmt.Sprintf("%s create-service SERVICE PLAN SERVICE_INSTANCE\n\n", cf.Name()) +
+			Usage: fmt.Sprintf("%s create-service SERVICE PLAN SERVICE_INSTANCE [--wait] [-t TIMEOUT]\n\n", cf.Name()) +
 				"EXAMPLE:\n" +
-				fmt.Sprintf("   %s create-service cleardb spark clear-db-mine\n\n", cf.Name()) +
+				fmt.Sprintf("   %s create-service cleardb spark clear-db-mine\n", cf.Name()) +
+				fmt.Sprintf("   %s create-service cleardb spark clear-db-mine --wait -t 300\n\n", cf.Name()) +
 				"TIP:\n" +
 				"   Use '" + cf.Name() + " create-user-provided-service' to make user-provided services available to cf apps",
+			Flags: []cli.Flag{
+				cli.BoolFlag{Name: "wait", Usage: "Wait until the service is ready, for brokers that provision asynchronously"},
+				NewIntFlag("t", "Maximum time to wait, in seconds (Default: 600)"),
+			},
 			Action: func(c *cli.Context) {
 				cmdRunner.RunCmdByName("create-service", c)
 			},
@@ -185,18 +242,33 @@ func NewApp(cmdRunner commands.Runner) (app *cli.App, err error) {
 				fmt.Sprintf("   %s create-user-provided-service SERVICE_INSTANCE -p \"comma, separated, parameter, names\"\n", cf.Name()) +
 				"\n   Pass parameters as JSON to create a service non-interactively:\n" +
 				fmt.Sprintf("   %s create-user-provided-service SERVICE_INSTANCE -p '{\"name\":\"value\",\"name\":\"value\"}'\n", cf.Name()) +
+				"\n   Pass the path of a JSON file after an @ to keep credentials off the command line:\n" +
+				fmt.Sprintf("   %s create-user-provided-service SERVICE_INSTANCE -p @credentials.json\n", cf.Name()) +
 				"\nEXAMPLE:\n" +
 				fmt.Sprintf("   %s create-user-provided-service oracle-db-mine -p \"host, port, dbname, username, password\"\n", cf.Name()) +
 				fmt.Sprintf("   %s create-user-provided-service oracle-db-mine -p '{\"username\":\"admin\",\"password\":\"pa55woRD\"}'\n", cf.Name()) +
+				fmt.Sprintf("   %s create-user-provided-service oracle-db-mine -p @oracle-credentials.json\n", cf.Name()) +
 				fmt.Sprintf("   %s create-user-provided-service my-drain-service -l syslog://example.com\n", cf.Name()),
 			Flags: []cli.Flag{
-				NewStringFlag("p", "Parameters"),
+				NewStringFlag("p", "Parameters, as comma separated names to prompt for, JSON, or @FILE"),
 				NewStringFlag("l", "Syslog Drain Url"),
 			},
 			Action: func(c *cli.Context) {
 				cmdRunner.RunCmdByName("create-user-provided-service", c)
 			},
 		},
+		{
+			Name:        "completion",
+			Description: "Print a shell completion script for bash, zsh or fish",
+			Usage: fmt.Sprintf("%s completion SHELL\n\n", cf.Name()) +
+				"EXAMPLE:\n" +
+				fmt.Sprintf("   source <(%s completion bash)\n", cf.Name()) +
+				fmt.Sprintf("   source <(%s completion zsh)\n", cf.Name()) +
+				fmt.Sprintf("   %s completion fish | source", cf.Name()),
+			Action: func(c *cli.Context) {
+				cmdRunner.RunCmdByName("completion", c)
+			},
+		},
 		{
 			Name:        "curl",
 			Description: "Executes a raw request, content-type set to application/json by default",
@@ -336,19 +408,66 @@ func NewApp(cmdRunner commands.Runner) (app *cli.App, err error) {
 				cmdRunner.RunCmdByName("delete-user", c)
 			},
 		},
+		{
+			Name:        "disable-service-access",
+			Description: "Disable access to a service or service plan for one or all orgs",
+			Usage: fmt.Sprintf("%s disable-service-access SERVICE [-p PLAN] [-o ORG]\n\n", cf.Name()) +
+				"Without -o, the plans are made private and taken away from the orgs they were\n" +
+				"   enabled for. With -o, only the named org loses access.",
+			Flags: []cli.Flag{
+				NewStringFlag("p", "Disable access to a single plan"),
+				NewStringFlag("o", "Disable access for a single org"),
+			},
+			Action: func(c *cli.Context) {
+				cmdRunner.RunCmdByName("disable-service-access", c)
+			},
+		},
 		{
 			Name:        "domains",
 			Description: "List domains in the target org",
 			Usage:       fmt.Sprintf("%s domains", cf.Name()),
+			Flags:       NewTableFlags(),
 			Action: func(c *cli.Context) {
 				cmdRunner.RunCmdByName("domains", c)
 			},
 		},
+		{
+			Name:        "enable-service-access",
+			Description: "Enable access to a service or service plan for one or all orgs",
+			Usage: fmt.Sprintf("%s enable-service-access SERVICE [-p PLAN] [-o ORG]\n\n", cf.Name()) +
+				"Without -o, the plans are made public. With -o, only the named org gains access.\n\n" +
+				"EXAMPLE:\n" +
+				fmt.Sprintf("   %s enable-service-access cleardb -p spark -o my-org", cf.Name()),
+			Flags: []cli.Flag{
+				NewStringFlag("p", "Enable access to a single plan"),
+				NewStringFlag("o", "Enable access for a single org"),
+			},
+			Action: func(c *cli.Context) {
+				cmdRunner.RunCmdByName("enable-service-access", c)
+			},
+		},
 		{
 			Name:        "env",
 			ShortName:   "e",
 			Description: "Show all env variables for an app",
-			Usage:       fmt.Sprintf("%s env APP", cf.Name()),
+			Usage: fmt.Sprintf("%s env APP [--vcap-services-only] [--export] [--export-format FORMAT] [--reveal]\n\n", cf.Name()) +
+				"With --vcap-services-only, shows VCAP_SERVICES and VCAP_APPLICATION as the platform\n" +
+				"   injects them, built from the app's service bindings. With --export, prints the\n" +
+				"   variables alone so that an app can run locally against the same services.\n" +
+				"   Passwords, secrets and tokens are masked unless --reveal is passed.\n\n" +
+				"EXPORT FORMATS:\n" +
+				"   shell  - export lines to source in a shell (default)\n" +
+				"   dotenv - NAME='value' lines for a .env file\n" +
+				"   json   - a JSON object\n\n" +
+				"EXAMPLE:\n" +
+				fmt.Sprintf("   eval \"$(%s env my-app --vcap-services-only --export)\"\n", cf.Name()) +
+				fmt.Sprintf("   %s env my-app --vcap-services-only --export --export-format dotenv --reveal > .env", cf.Name()),
+			Flags: []cli.Flag{
+				cli.BoolFlag{Name: "vcap-services-only", Usage: "Show VCAP_SERVICES and VCAP_APPLICATION only"},
+				cli.BoolFlag{Name: "export", Usage: "Print the variables in a form that can be sourced or saved"},
+				NewStringFlag("export-format", "Format of --export: shell, dotenv or json"),
+				cli.BoolFlag{Name: "reveal", Usage: "Do not mask sensitive values"},
+			},
 			Action: func(c *cli.Context) {
 				cmdRunner.RunCmdByName("env", c)
 			},
@@ -357,6 +476,7 @@ func NewApp(cmdRunner commands.Runner) (app *cli.App, err error) {
 			Name:        "events",
 			Description: "Show recent app events",
 			Usage:       fmt.Sprintf("%s events APP", cf.Name()),
+			Flags:       NewTableFlags(),
 			Action: func(c *cli.Context) {
 				cmdRunner.RunCmdByName("events", c)
 			},
@@ -374,13 +494,15 @@ func NewApp(cmdRunner commands.Runner) (app *cli.App, err error) {
 			Name:        "login",
 			ShortName:   "l",
 			Description: "Log user in",
-			Usage: fmt.Sprintf("%s login [-a API_URL] [-u USERNAME] [-p PASSWORD] [-o ORG] [-s SPACE]\n\n", cf.Name()) +
+			Usage: fmt.Sprintf("%s login [-a API_URL] [-u USERNAME] [-p PASSWORD] [-o ORG] [-s SPACE]\n", cf.Name()) +
+				fmt.Sprintf("   %s login [-a API_URL] --sso [-o ORG] [-s SPACE]\n\n", cf.Name()) +
 				terminal.WarningColor("WARNING:\n   Providing your password as a command line option is highly discouraged\n   Your password may be visible to others and may be recorded in your shell history\n\n") +
 				"EXAMPLE:\n" +
 				fmt.Sprintf("   %s login (omit username and password to login interactively -- %s will prompt for both)\n", cf.Name(), cf.Name()) +
 				fmt.Sprintf("   %s login -u name@example.com -p pa55woRD (specify username and password as arguments)\n", cf.Name()) +
 				fmt.Sprintf("   %s login -u name@example.com -p \"my password\" (use quotes for passwords with a space)\n", cf.Name()) +
-				fmt.Sprintf("   %s login -u name@example.com -p \"\\\"password\\\"\" (escape quotes if used in password)", cf.Name()),
+				fmt.Sprintf("   %s login -u name@example.com -p \"\\\"password\\\"\" (escape quotes if used in password)\n", cf.Name()) +
+				fmt.Sprintf("   %s login --sso (log in through your identity provider with a one-time passcode)", cf.Name()),
 			Flags: []cli.Flag{
 				StringFlagWithNoDefault{cli.StringFlag{
 					Name: "a", Usage: "API endpoint (e.g. https://api.example.com)",
@@ -389,6 +511,7 @@ func NewApp(cmdRunner commands.Runner) (app *cli.App, err error) {
 				NewStringFlag("p", "Password"),
 				NewStringFlag("o", "Org"),
 				NewStringFlag("s", "Space"),
+				cli.BoolFlag{Name: "sso", Usage: "Log in with a one-time passcode from your identity provider"},
 			},
 			Action: func(c *cli.Context) {
 				cmdRunner.RunCmdByName("login", c)
@@ -418,7 +541,14 @@ func NewApp(cmdRunner commands.Runner) (app *cli.App, err error) {
 			Name:        "marketplace",
 			ShortName:   "m",
 			Description: "List available offerings in the marketplace",
-			Usage:       fmt.Sprintf("%s marketplace", cf.Name()),
+			Usage: fmt.Sprintf("%s marketplace [-s SERVICE] [--search TERM]\n\n", cf.Name()) +
+				"EXAMPLE:\n" +
+				fmt.Sprintf("   %s marketplace -s cleardb\n", cf.Name()) +
+				fmt.Sprintf("   %s marketplace --search mysql", cf.Name()),
+			Flags: append(NewTableFlags(),
+				NewStringFlag("s", "Show the plans of a service, with their descriptions and costs"),
+				NewStringFlag("search", "Only list services whose name, description, tags or plan descriptions contain TERM"),
+			),
 			Action: func(c *cli.Context) {
 				cmdRunner.RunCmdByName("marketplace", c)
 			},
@@ -438,6 +568,9 @@ func NewApp(cmdRunner commands.Runner) (app *cli.App, err error) {
 			Name:        "org",
 			Description: "Show org info",
 			Usage:       fmt.Sprintf("%s org ORG", cf.Name()),
+			Flags: []cli.Flag{
+				NewFormatFlag(),
+			},
 			Action: func(c *cli.Context) {
 				cmdRunner.RunCmdByName("org", c)
 			},
@@ -448,6 +581,7 @@ func NewApp(cmdRunner commands.Runner) (app *cli.App, err error) {
 			Usage:       fmt.Sprintf("%s org-users ORG", cf.Name()),
 			Flags: []cli.Flag{
 				cli.BoolFlag{Name: "a", Usage: "List all users in the org"},
+				NewFormatFlag(),
 			},
 			Action: func(c *cli.Context) {
 				cmdRunner.RunCmdByName("org-users", c)
@@ -458,6 +592,7 @@ func NewApp(cmdRunner commands.Runner) (app *cli.App, err error) {
 			ShortName:   "o",
 			Description: "List all orgs",
 			Usage:       fmt.Sprintf("%s orgs", cf.Name()),
+			Flags:       NewTableFlags(),
 			Action: func(c *cli.Context) {
 				cmdRunner.RunCmdByName("orgs", c)
 			},
@@ -471,6 +606,46 @@ func NewApp(cmdRunner commands.Runner) (app *cli.App, err error) {
 				cmdRunner.RunCmdByName("passwd", c)
 			},
 		},
+		{
+			Name:        "plugins",
+			Description: "List the installed plugins",
+			Usage: fmt.Sprintf("%s plugins\n\n", cf.Name()) +
+				"Plugins are executables named cf-NAME on PATH or in $CF_HOME/plugins\n" +
+				"   (~/.cf/plugins by default), run as\n" +
+				fmt.Sprintf("   %s NAME [arguments...]\n\n", cf.Name()) +
+				"They find the target of cf in the environment variables CF_PLUGIN_API_ENDPOINT,\n" +
+				"   CF_PLUGIN_USERNAME, CF_PLUGIN_ORG, CF_PLUGIN_ORG_GUID, CF_PLUGIN_SPACE and\n" +
+				"   CF_PLUGIN_SPACE_GUID. CF_PLUGIN_ACCESS_TOKEN holds a fresh Authorization header\n" +
+				"   for the API and CF_PLUGIN_CLI the path of cf",
+			Flags: NewTableFlags(),
+			Action: func(c *cli.Context) {
+				cmdRunner.RunCmdByName("plugins", c)
+			},
+		},
+		{
+			Name:        "profile",
+			Description: "Switch to, create or delete a named profile used to target a Cloud Foundry instance",
+			Usage: fmt.Sprintf("%s profile use NAME\n", cf.Name()) +
+				fmt.Sprintf("   %s profile create NAME\n", cf.Name()) +
+				fmt.Sprintf("   %s profile delete NAME [-f]\n\n", cf.Name()) +
+				"TIP:\n" +
+				fmt.Sprintf("   Set %s=NAME to use a profile for a single shell without switching", configuration.CF_PROFILE),
+			Flags: []cli.Flag{
+				cli.BoolFlag{Name: "f", Usage: "Force deletion without confirmation"},
+			},
+			Action: func(c *cli.Context) {
+				cmdRunner.RunCmdByName("profile", c)
+			},
+		},
+		{
+			Name:        "profiles",
+			Description: "List all profiles",
+			Usage:       fmt.Sprintf("%s profiles", cf.Name()),
+			Flags:       NewTableFlags(),
+			Action: func(c *cli.Context) {
+				cmdRunner.RunCmdByName("profiles", c)
+			},
+		},
 		{
 			Name:        "purge-service-offering",
 			Description: "Recursively remove a service and child objects from Cloud Foundry database without making requests to a service broker",
@@ -519,6 +694,7 @@ func NewApp(cmdRunner commands.Runner) (app *cli.App, err error) {
 			Name:        "quotas",
 			Description: "List available usage quotas ",
 			Usage:       fmt.Sprintf("%s quotas", cf.Name()),
+			Flags:       NewTableFlags(),
 			Action: func(c *cli.Context) {
 				cmdRunner.RunCmdByName("quotas", c)
 			},
@@ -577,10 +753,28 @@ func NewApp(cmdRunner commands.Runner) (app *cli.App, err error) {
 			ShortName:   "r",
 			Description: "List all routes",
 			Usage:       fmt.Sprintf("%s routes", cf.Name()),
+			Flags:       NewTableFlags(),
 			Action: func(c *cli.Context) {
 				cmdRunner.RunCmdByName("routes", c)
 			},
 		},
+		{
+			Name:        "run-script",
+			Description: "Run the commands in a script file, or read from stdin, in a single session",
+			Usage: fmt.Sprintf("%s run-script [FILE] [--continue-on-error]\n\n", cf.Name()) +
+				"Each line holds one command, with or without the leading cf. Blank lines and lines\n" +
+				"   starting with # are skipped, and lines ending in \\ continue on the next line.\n" +
+				"   Arguments can be quoted like in a shell, but variables are not expanded.\n\n" +
+				"EXAMPLE:\n" +
+				fmt.Sprintf("   %s run-script bootstrap.cf\n", cf.Name()) +
+				fmt.Sprintf("   cat bootstrap.cf | %s run-script --continue-on-error", cf.Name()),
+			Flags: []cli.Flag{
+				cli.BoolFlag{Name: "continue-on-error", Usage: "Run the remaining commands after a command fails"},
+			},
+			Action: func(c *cli.Context) {
+				cmdRunner.RunCmdByName("run-script", c)
+			},
+		},
 		{
 			Name:        "scale",
 			Description: "Change the instance count and memory limit for an app",
@@ -597,14 +791,31 @@ func NewApp(cmdRunner commands.Runner) (app *cli.App, err error) {
 			Name:        "service",
 			Description: "Show service instance info",
 			Usage:       fmt.Sprintf("%s service SERVICE_INSTANCE", cf.Name()),
+			Flags: []cli.Flag{
+				NewFormatFlag(),
+			},
 			Action: func(c *cli.Context) {
 				cmdRunner.RunCmdByName("service", c)
 			},
 		},
+		{
+			Name:        "service-access",
+			Description: "List service plans with the orgs that can access them",
+			Usage: fmt.Sprintf("%s service-access\n\n", cf.Name()) +
+				"ACCESS:\n" +
+				"   all     - the plan is public\n" +
+				"   limited - the plan is enabled for the orgs listed\n" +
+				"   none    - only admins can see the plan",
+			Flags: NewTableFlags(),
+			Action: func(c *cli.Context) {
+				cmdRunner.RunCmdByName("service-access", c)
+			},
+		},
 		{
 			Name:        "service-auth-tokens",
 			Description: "List service auth tokens",
 			Usage:       fmt.Sprintf("%s service-auth-tokens", cf.Name()),
+			Flags:       NewTableFlags(),
 			Action: func(c *cli.Context) {
 				cmdRunner.RunCmdByName("service-auth-tokens", c)
 			},
@@ -613,6 +824,7 @@ func NewApp(cmdRunner commands.Runner) (app *cli.App, err error) {
 			Name:        "service-brokers",
 			Description: "List service brokers",
 			Usage:       fmt.Sprintf("%s service-brokers", cf.Name()),
+			Flags:       NewTableFlags(),
 			Action: func(c *cli.Context) {
 				cmdRunner.RunCmdByName("service-brokers", c)
 			},
@@ -622,6 +834,7 @@ func NewApp(cmdRunner commands.Runner) (app *cli.App, err error) {
 			ShortName:   "s",
 			Description: "List all services in the target space",
 			Usage:       fmt.Sprintf("%s services", cf.Name()),
+			Flags:       NewTableFlags(),
 			Action: func(c *cli.Context) {
 				cmdRunner.RunCmdByName("services", c)
 			},
@@ -669,6 +882,18 @@ func NewApp(cmdRunner commands.Runner) (app *cli.App, err error) {
 				cmdRunner.RunCmdByName("set-space-role", c)
 			},
 		},
+		{
+			Name:        "shell",
+			Description: "Run commands interactively in a single session",
+			Usage: fmt.Sprintf("%s shell\n\n", cf.Name()) +
+				"The prompt shows the targeted org and space. Commands are entered without the\n" +
+				"   leading cf, Tab completes command names and flags, and the arrow keys recall\n" +
+				"   earlier commands. Ctrl-C stops a command such as logs and returns to the prompt.\n" +
+				"   Type exit or press Ctrl-D to leave.",
+			Action: func(c *cli.Context) {
+				cmdRunner.RunCmdByName("shell", c)
+			},
+		},
 		{
 			Name:        "create-shared-domain",
 			Description: "Create a domain that can be used by all orgs (admin-only)",
@@ -681,6 +906,9 @@ func NewApp(cmdRunner commands.Runner) (app *cli.App, err error) {
 			Name:        "space",
 			Description: "Show space info",
 			Usage:       fmt.Sprintf("%s space SPACE", cf.Name()),
+			Flags: []cli.Flag{
+				NewFormatFlag(),
+			},
 			Action: func(c *cli.Context) {
 				cmdRunner.RunCmdByName("space", c)
 			},
@@ -697,6 +925,7 @@ func NewApp(cmdRunner commands.Runner) (app *cli.App, err error) {
 			Name:        "spaces",
 			Description: "List all spaces in an org",
 			Usage:       fmt.Sprintf("%s spaces", cf.Name()),
+			Flags:       NewTableFlags(),
 			Action: func(c *cli.Context) {
 				cmdRunner.RunCmdByName("spaces", c)
 			},
@@ -705,6 +934,7 @@ func NewApp(cmdRunner commands.Runner) (app *cli.App, err error) {
 			Name:        "stacks",
 			Description: "List all stacks",
 			Usage:       fmt.Sprintf("%s stacks", cf.Name()),
+			Flags:       NewTableFlags(),
 			Action: func(c *cli.Context) {
 				cmdRunner.RunCmdByName("stacks", c)
 			},
@@ -808,6 +1038,21 @@ func NewApp(cmdRunner commands.Runner) (app *cli.App, err error) {
 				cmdRunner.RunCmdByName("update-buildpack", c)
 			},
 		},
+		{
+			Name:        "update-service",
+			Description: "Change the plan of a service instance",
+			Usage: fmt.Sprintf("%s update-service SERVICE_INSTANCE -p NEW_PLAN\n\n", cf.Name()) +
+				"The new plan must belong to the same service offering. Not every service supports\n" +
+				"   changing plans.\n\n" +
+				"EXAMPLE:\n" +
+				fmt.Sprintf("   %s update-service mydb -p large", cf.Name()),
+			Flags: []cli.Flag{
+				NewStringFlag("p", "Name of the new plan"),
+			},
+			Action: func(c *cli.Context) {
+				cmdRunner.RunCmdByName("update-service", c)
+			},
+		},
 		{
 			Name:        "update-service-broker",
 			Description: "Update a service broker",
@@ -829,17 +1074,43 @@ func NewApp(cmdRunner commands.Runner) (app *cli.App, err error) {
 			ShortName:   "uups",
 			Description: "Update user-provided service name value pairs",
 			Usage: fmt.Sprintf("%s update-user-provided-service SERVICE_INSTANCE [-p PARAMETERS] [-l SYSLOG-DRAIN-URL]'\n\n", cf.Name()) +
+				"   The new parameters replace the old ones, and the names of the ones that changed are shown.\n\n" +
 				"EXAMPLE:\n" +
 				fmt.Sprintf("   %s update-user-provided-service oracle-db-mine -p '{\"username\":\"admin\",\"password\":\"pa55woRD\"}'\n", cf.Name()) +
+				fmt.Sprintf("   %s update-user-provided-service oracle-db-mine -p @oracle-credentials.json\n", cf.Name()) +
 				fmt.Sprintf("   %s update-user-provided-service my-drain-service -l syslog://example.com\n", cf.Name()),
 			Flags: []cli.Flag{
-				NewStringFlag("p", "Parameters"),
+				NewStringFlag("p", "Parameters, as JSON or @FILE"),
 				NewStringFlag("l", "Syslog Drain Url"),
 			},
 			Action: func(c *cli.Context) {
 				cmdRunner.RunCmdByName("update-user-provided-service", c)
 			},
 		},
+		{
+			Name:        "wait-for-service",
+			Description: "Wait until a service instance is ready",
+			Usage: fmt.Sprintf("%s wait-for-service SERVICE_INSTANCE [-t TIMEOUT]\n\n", cf.Name()) +
+				"Some brokers provision services asynchronously. This waits until the broker reports\n" +
+				fmt.Sprintf("   the service ready, and exits with code %d if the timeout is reached first.\n\n", errors.ExitCodeTimeout) +
+				"EXAMPLE:\n" +
+				fmt.Sprintf("   %s wait-for-service mydb -t 300", cf.Name()),
+			Flags: []cli.Flag{
+				NewIntFlag("t", "Maximum time to wait, in seconds (Default: 600)"),
+			},
+			Action: func(c *cli.Context) {
+				cmdRunner.RunCmdByName("wait-for-service", c)
+			},
+		},
+		{
+			// hidden, used by the completion scripts
+			Name:        commands.CompleteNamesCommandName,
+			Description: "Print the names of apps, services, orgs or spaces for shell completion",
+			Usage:       fmt.Sprintf("%s %s apps|services|orgs|spaces", cf.Name(), commands.CompleteNamesCommandName),
+			Action: func(c *cli.Context) {
+				cmdRunner.RunCmdByName(commands.CompleteNamesCommandName, c)
+			},
+		},
 	}
 	return
 }
@@ -14,18 +14,20 @@ import (
 )
 
 var expectedCommandNames = []string{
-	"api", "app", "apps", "auth", "bind-service", "buildpacks", "create-buildpack",
+	"alias", "aliases", "api", "app", "apps", "auth", "bind-service", "buildpacks", "completion", "create-buildpack",
 	"create-domain", "create-org", "create-route", "create-service", "create-service-auth-token",
 	"create-service-broker", "create-space", "create-user", "create-user-provided-service", "curl",
 	"delete", "delete-buildpack", "delete-domain", "delete-shared-domain", "delete-org", "delete-route",
-	"delete-service", "delete-service-auth-token", "delete-service-broker", "delete-space", "delete-user",
-	"domains", "env", "events", "files", "login", "logout", "logs", "marketplace", "map-route", "org",
-	"org-users", "orgs", "passwd", "purge-service-offering", "push", "quotas", "rename", "rename-org",
-	"rename-service", "rename-service-broker", "rename-space", "restart", "routes", "scale",
-	"service", "service-auth-tokens", "service-brokers", "services", "set-env", "set-org-role", "set-quota",
-	"set-space-role", "create-shared-domain", "space", "space-users", "spaces", "stacks", "start", "stop",
+	"delete-service", "delete-service-auth-token", "delete-service-broker", "delete-space", "delete-user", "disable-service-access",
+	"domains", "enable-service-access", "env", "events", "files", "login", "logout", "logs", "marketplace", "map-route", "org",
+	"org-users", "orgs", "passwd", "plugins", "profile", "profiles", "purge-service-offering", "push", "quotas", "rename", "rename-org",
+	"rename-service", "rename-service-broker", "rename-space", "restart", "routes", "run-script", "scale",
+	"service", "service-access", "service-auth-tokens", "service-brokers", "services", "set-env", "set-org-role", "set-quota",
+	"set-space-role", "shell", "create-shared-domain", "space", "space-users", "spaces", "stacks", "start", "stop",
 	"target", "unbind-service", "unmap-route", "unset-env", "unset-org-role", "unset-space-role",
-	"update-buildpack", "update-service-broker", "update-service-auth-token", "update-user-provided-service",
+	"update-buildpack", "update-service", "update-service-broker", "update-service-auth-token", "update-user-provided-service",
+	"wait-for-service",
+	commands.CompleteNamesCommandName,
 }
 
 var _ = Describe("Testing with ginkgo", func() {
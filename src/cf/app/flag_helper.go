@@ -57,3 +57,17 @@ func prefixFor(name string) (prefix string) {
 
 	return
 }
+
+func NewFormatFlag() StringFlagWithNoDefault {
+	return NewStringFlag("format", "Print each result with a Go template, e.g. '{{.Name}}' (helpers: byteSize, join, color)")
+}
+
+func NewTableFlags() []cli.Flag {
+	return []cli.Flag{
+		NewFormatFlag(),
+		NewStringFlag("sort-by", "Sort rows by a column, comparing sizes and numbers by value"),
+		NewStringSliceFlag("filter", "Only show rows where COLUMN matches a glob, e.g. 'state=start*', flag can be specified multiple times"),
+		NewStringFlag("columns", "Comma separated list of columns to show, e.g. 'name,state'"),
+		cli.BoolFlag{Name: "no-headers", Usage: "Do not print the table header"},
+	}
+}
@@ -1,6 +1,8 @@
 package app
 
 import (
+	"cf/configuration"
+	"cf/plugin"
 	"cf/terminal"
 	"github.com/codegangsta/cli"
 	"os"
@@ -22,17 +24,35 @@ var appHelpTemplate = `{{.Title "NAME:"}}
 {{range .}}   {{.Name}} {{.Description}}
 {{end}}{{end}}{{end}}
 {{.Title "ENVIRONMENT VARIABLES"}}
+   CF_CLIENT_ID=my-ci-client          UAA client used to log in again when the token expires
+   CF_CLIENT_SECRET=secret            Secret of the UAA client named by CF_CLIENT_ID
    CF_COLOR=false                     Do not colorize output
    CF_HOME=path/to/dir/               Override path to default config directory
+   CF_NON_INTERACTIVE=true            Fail instead of prompting for input, like --non-interactive
+   CF_PROFILE=name                    Use the named profile for this invocation
    CF_STAGING_TIMEOUT=15              Max wait time for buildpack staging, in minutes
    CF_STARTUP_TIMEOUT=5               Max wait time for app instance startup, in minutes
    CF_TRACE=true                      Print API request diagnostics to stdout
    CF_TRACE=path/to/trace.log         Append API request diagnostics to a log file
+   CF_TRACE_FORMAT=json               Write API request diagnostics as one JSON record per request
+   CF_TRACE_FORMAT=har                Write API request diagnostics as a HAR file (CF_TRACE must be a path)
    HTTP_PROXY=proxy.example.com:8080  Enable HTTP proxying for API requests
 
 {{.Title "GLOBAL OPTIONS"}}
+   --output FORMAT                    Print list commands as text, json or yaml (e.g. cf --output json apps)
+   --non-interactive                  Fail instead of prompting for input (default when stdin is not a terminal)
    --version, -v                      Print the version
    --help, -h                         Show help
+
+{{.Title "EXIT CODES"}}
+   0                                  Success
+   1                                  General failure
+   2                                  Incorrect usage
+   3                                  Not logged in or not authorized
+   4                                  Resource not found
+   5                                  Error returned by the server
+   6                                  Network error reaching the server
+   7                                  Timed out waiting for an operation to finish
 `
 
 type groupedCommands struct {
@@ -88,8 +108,25 @@ func getMaxCmdNameLength(app *cli.App) (length int) {
 	return
 }
 
+func newPluginPresenters(plugins []plugin.Plugin, maxNameLen int) (presenters []cmdPresenter) {
+	for _, installedPlugin := range plugins {
+		presenters = append(presenters, cmdPresenter{
+			Name:        installedPlugin.Name + strings.Repeat(" ", maxNameLen-len(installedPlugin.Name)),
+			Description: "Plugin " + installedPlugin.Path,
+		})
+	}
+	return
+}
+
 func newAppPresenter(app *cli.App) (presenter appPresenter) {
+	plugins := plugin.Find(plugin.SearchPath(configuration.PluginsDir()))
+
 	maxNameLen := getMaxCmdNameLength(app)
+	for _, installedPlugin := range plugins {
+		if len(installedPlugin.Name) > maxNameLen {
+			maxNameLen = len(installedPlugin.Name)
+		}
+	}
 
 	presenter.Name = app.Name
 	presenter.Usage = app.Usage
@@ -109,6 +146,9 @@ func newAppPresenter(app *cli.App) (presenter appPresenter) {
 				}, {
 					newCmdPresenter(app, maxNameLen, "api"),
 					newCmdPresenter(app, maxNameLen, "auth"),
+				}, {
+					newCmdPresenter(app, maxNameLen, "profiles"),
+					newCmdPresenter(app, maxNameLen, "profile"),
 				},
 			},
 		}, {
@@ -149,6 +189,8 @@ func newAppPresenter(app *cli.App) (presenter appPresenter) {
 					newCmdPresenter(app, maxNameLen, "create-service"),
 					newCmdPresenter(app, maxNameLen, "delete-service"),
 					newCmdPresenter(app, maxNameLen, "rename-service"),
+					newCmdPresenter(app, maxNameLen, "update-service"),
+					newCmdPresenter(app, maxNameLen, "wait-for-service"),
 				}, {
 					newCmdPresenter(app, maxNameLen, "bind-service"),
 					newCmdPresenter(app, maxNameLen, "unbind-service"),
@@ -251,6 +293,10 @@ func newAppPresenter(app *cli.App) (presenter appPresenter) {
 					newCmdPresenter(app, maxNameLen, "update-service-broker"),
 					newCmdPresenter(app, maxNameLen, "delete-service-broker"),
 					newCmdPresenter(app, maxNameLen, "rename-service-broker"),
+				}, {
+					newCmdPresenter(app, maxNameLen, "service-access"),
+					newCmdPresenter(app, maxNameLen, "enable-service-access"),
+					newCmdPresenter(app, maxNameLen, "disable-service-access"),
 				},
 			},
 		}, {
@@ -258,10 +304,29 @@ func newAppPresenter(app *cli.App) (presenter appPresenter) {
 			CommandSubGroups: [][]cmdPresenter{
 				{
 					newCmdPresenter(app, maxNameLen, "curl"),
+					newCmdPresenter(app, maxNameLen, "completion"),
+				}, {
+					newCmdPresenter(app, maxNameLen, "aliases"),
+					newCmdPresenter(app, maxNameLen, "alias"),
+				}, {
+					newCmdPresenter(app, maxNameLen, "shell"),
+					newCmdPresenter(app, maxNameLen, "run-script"),
+				},
+			},
+		}, {
+			Name: "PLUGINS",
+			CommandSubGroups: [][]cmdPresenter{
+				{
+					newCmdPresenter(app, maxNameLen, "plugins"),
 				},
 			},
 		},
 	}
+
+	if len(plugins) > 0 {
+		pluginsGroup := &presenter.Commands[len(presenter.Commands)-1]
+		pluginsGroup.CommandSubGroups = append(pluginsGroup.CommandSubGroups, newPluginPresenters(plugins, maxNameLen))
+	}
 	return
 }
 
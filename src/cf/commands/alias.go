@@ -0,0 +1,134 @@
+package commands
+
+import (
+	"cf/configuration"
+	"cf/errors"
+	"cf/requirements"
+	"cf/terminal"
+	"github.com/codegangsta/cli"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// placeholders in alias expansions: $1, $2... for the arguments given to the
+// alias, $@ for all the arguments and $$ for a dollar sign
+var aliasPlaceholderPattern = regexp.MustCompile(`\$([1-9]\d*|@|\$)`)
+
+type Alias struct {
+	ui     terminal.UI
+	config configuration.ReadWriter
+}
+
+func NewAlias(ui terminal.UI, config configuration.ReadWriter) (cmd Alias) {
+	cmd.ui = ui
+	cmd.config = config
+	return
+}
+
+func (cmd Alias) GetRequirements(reqFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
+	if (c.Bool("d") && len(c.Args()) != 1) || (!c.Bool("d") && len(c.Args()) != 2) {
+		err = errors.New("Incorrect Usage")
+		cmd.ui.FailWithUsage(c, "alias")
+	}
+	return
+}
+
+func (cmd Alias) Run(c *cli.Context) (err error) {
+	name := c.Args()[0]
+
+	if c.Bool("d") {
+		cmd.ui.Say("Deleting alias %s...", terminal.EntityNameColor(name))
+
+		if _, found := cmd.config.Aliases()[name]; !found {
+			cmd.ui.Ok()
+			cmd.ui.Warn("Alias %s does not exist.", name)
+			return
+		}
+
+		cmd.config.DeleteAlias(name)
+		cmd.ui.Ok()
+		return
+	}
+
+	expansion := c.Args()[1]
+	cmd.ui.Say("Creating alias %s for %s...",
+		terminal.EntityNameColor(name),
+		terminal.EntityNameColor(expansion),
+	)
+
+	if name == "" || strings.HasPrefix(name, "-") || strings.ContainsAny(name, " \t\n") {
+		err = errors.New("Alias names cannot be empty, start with - or contain spaces")
+		return
+	}
+
+	if c.App != nil && c.App.Command(name) != nil {
+		err = errors.New("Alias %s would shadow the built-in command %s", name, name)
+		return
+	}
+
+	words, err := SplitArguments(expansion)
+	if err != nil {
+		return
+	}
+	if len(words) == 0 {
+		err = errors.New("The expansion of alias %s is empty", name)
+		return
+	}
+
+	cmd.config.SetAlias(name, expansion)
+	cmd.ui.Ok()
+	return
+}
+
+// ExpandAlias returns the arguments that run the alias with the given
+// expansion and arguments. Arguments that no placeholder refers to are
+// appended to the expansion.
+func ExpandAlias(name, expansion string, args []string) (expanded []string, err error) {
+	words, err := SplitArguments(expansion)
+	if err != nil {
+		return
+	}
+
+	used := 0
+	usesAll := false
+	for _, word := range words {
+		if word == "$@" {
+			expanded = append(expanded, args...)
+			usesAll = true
+			continue
+		}
+
+		var placeholderErr error
+		word = aliasPlaceholderPattern.ReplaceAllStringFunc(word, func(placeholder string) string {
+			switch placeholder {
+			case "$$":
+				return "$"
+			case "$@":
+				usesAll = true
+				return strings.Join(args, " ")
+			}
+
+			position, _ := strconv.Atoi(placeholder[1:])
+			if position > len(args) {
+				placeholderErr = errors.NewUsageError("Alias %s expects at least %d arguments", name, position)
+				return ""
+			}
+			if position > used {
+				used = position
+			}
+			return args[position-1]
+		})
+
+		if placeholderErr != nil {
+			err = placeholderErr
+			return
+		}
+		expanded = append(expanded, word)
+	}
+
+	if !usesAll {
+		expanded = append(expanded, args[used:]...)
+	}
+	return
+}
@@ -0,0 +1,112 @@
+package commands_test
+
+import (
+	"cf/app"
+	. "cf/commands"
+	"cf/configuration"
+	"flag"
+	"github.com/codegangsta/cli"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	testassert "testhelpers/assert"
+	testcmd "testhelpers/commands"
+	testconfig "testhelpers/configuration"
+	testreq "testhelpers/requirements"
+	testterm "testhelpers/terminal"
+)
+
+func runAlias(ui *testterm.FakeUI, config configuration.ReadWriter, args []string) {
+	cfApp, err := app.NewApp(NewRunner(ui, ConcreteFactory{}, &testreq.FakeReqFactory{}))
+	Expect(err).NotTo(HaveOccurred())
+
+	flagSet := new(flag.FlagSet)
+	flagSet.Bool("d", false, "")
+	flagSet.Parse(args)
+
+	testcmd.RunCommand(ui, NewAlias(ui, config), cli.NewContext(cfApp, flagSet, new(flag.FlagSet)), &testreq.FakeReqFactory{})
+}
+
+var _ = Describe("alias command", func() {
+	var (
+		ui     *testterm.FakeUI
+		config configuration.ReadWriter
+	)
+
+	BeforeEach(func() {
+		ui = &testterm.FakeUI{}
+		config = testconfig.NewRepository()
+	})
+
+	It("fails with usage when not given a name and an expansion", func() {
+		runAlias(ui, config, []string{"lp"})
+		Expect(ui.FailedWithUsage).To(BeTrue())
+	})
+
+	It("creates aliases", func() {
+		runAlias(ui, config, []string{"lp", "logs --recent"})
+
+		Expect(config.Aliases()).To(Equal(map[string]string{"lp": "logs --recent"}))
+		testassert.SliceContains(ui.Outputs, testassert.Lines{
+			{"Creating alias", "lp", "logs --recent"},
+			{"OK"},
+		})
+	})
+
+	It("refuses to shadow built-in commands", func() {
+		runAlias(ui, config, []string{"push", "push -f manifest-prod.yml"})
+		runAlias(ui, config, []string{"p", "push -f manifest-prod.yml"})
+
+		Expect(config.Aliases()).To(BeEmpty())
+		testassert.SliceContains(ui.Outputs, testassert.Lines{
+			{"FAILED"},
+			{"would shadow the built-in command push"},
+		})
+	})
+
+	It("refuses expansions that cannot be parsed", func() {
+		runAlias(ui, config, []string{"greet", `set-env my-app GREETING "hello`})
+
+		Expect(config.Aliases()).To(BeEmpty())
+		testassert.SliceContains(ui.Outputs, testassert.Lines{
+			{"FAILED"},
+			{"Unterminated quote"},
+		})
+	})
+
+	It("deletes aliases", func() {
+		config.SetAlias("lp", "logs --recent")
+		runAlias(ui, config, []string{"-d", "lp"})
+
+		Expect(config.Aliases()).To(BeEmpty())
+		testassert.SliceContains(ui.Outputs, testassert.Lines{
+			{"Deleting alias", "lp"},
+			{"OK"},
+		})
+	})
+})
+
+var _ = Describe("expanding aliases", func() {
+	It("appends the arguments to the expansion", func() {
+		args, err := ExpandAlias("lp", "logs --recent", []string{"my-app"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(args).To(Equal([]string{"logs", "--recent", "my-app"}))
+	})
+
+	It("replaces positional placeholders", func() {
+		args, err := ExpandAlias("rename-env", `set-env $1 NAME "$2-$1" $$HOME`, []string{"my-app", "prod", "extra"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(args).To(Equal([]string{"set-env", "my-app", "NAME", "prod-my-app", "$HOME", "extra"}))
+	})
+
+	It("replaces $@ with all the arguments", func() {
+		args, err := ExpandAlias("qpush", "push $@ --no-start", []string{"my-app", "-i", "2"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(args).To(Equal([]string{"push", "my-app", "-i", "2", "--no-start"}))
+	})
+
+	It("fails when arguments are missing", func() {
+		_, err := ExpandAlias("rs", "restart $2", []string{"my-app"})
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("Alias rs expects at least 2 arguments"))
+	})
+})
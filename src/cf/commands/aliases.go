@@ -0,0 +1,57 @@
+package commands
+
+import (
+	"cf/configuration"
+	"cf/requirements"
+	"cf/terminal"
+	"github.com/codegangsta/cli"
+	"sort"
+)
+
+// aliasOutput is what structured output prints for an alias
+type aliasOutput struct {
+	Name      string `json:"name"`
+	Expansion string `json:"expansion"`
+}
+
+type ListAliases struct {
+	ui     terminal.UI
+	config configuration.Reader
+}
+
+func NewListAliases(ui terminal.UI, config configuration.Reader) (cmd ListAliases) {
+	cmd.ui = ui
+	cmd.config = config
+	return
+}
+
+func (cmd ListAliases) GetRequirements(reqFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
+	return
+}
+
+func (cmd ListAliases) Run(c *cli.Context) (err error) {
+	cmd.ui.Say("Getting aliases...")
+
+	aliases := cmd.config.Aliases()
+	names := []string{}
+	for name := range aliases {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	cmd.ui.Ok()
+	cmd.ui.Say("")
+
+	if len(names) == 0 {
+		cmd.ui.Say("No aliases found")
+	}
+
+	table := cmd.ui.Table([]string{"name", "expansion"})
+	for _, name := range names {
+		alias := aliasOutput{Name: name, Expansion: aliases[name]}
+		table.Add(alias, alias.Name, alias.Expansion)
+	}
+
+	err = table.Print()
+	return
+}
@@ -0,0 +1,59 @@
+package commands_test
+
+import (
+	. "cf/commands"
+	"cf/terminal"
+	. "github.com/onsi/ginkgo"
+	testassert "testhelpers/assert"
+	testcmd "testhelpers/commands"
+	testconfig "testhelpers/configuration"
+	testreq "testhelpers/requirements"
+	testterm "testhelpers/terminal"
+)
+
+var _ = Describe("aliases command", func() {
+	It("lists the aliases sorted by name", func() {
+		config := testconfig.NewRepository()
+		config.SetAlias("prod-push", "push -f manifest-prod.yml")
+		config.SetAlias("lp", "logs --recent")
+
+		ui := &testterm.FakeUI{}
+		testcmd.RunCommand(ui, NewListAliases(ui, config), testcmd.NewContext("aliases", []string{}), &testreq.FakeReqFactory{})
+
+		testassert.SliceContains(ui.Outputs, testassert.Lines{
+			{"Getting aliases"},
+			{"OK"},
+			{"name", "expansion"},
+			{"lp", "logs --recent"},
+			{"prod-push", "push -f manifest-prod.yml"},
+		})
+	})
+
+	It("prints the aliases when a structured output format is used", func() {
+		config := testconfig.NewRepository()
+		config.SetAlias("lp", "logs --recent")
+
+		ui := &testterm.FakeUI{}
+		ui.SetOutputOptions(terminal.OutputOptions{Format: terminal.OutputJSON})
+		testcmd.RunCommand(ui, NewListAliases(ui, config), testcmd.NewContext("aliases", []string{}), &testreq.FakeReqFactory{})
+
+		testassert.SliceContains(ui.Outputs, testassert.Lines{
+			{"["},
+			{`"name": "lp"`},
+			{`"expansion": "logs --recent"`},
+			{"]"},
+		})
+		testassert.SliceDoesNotContain(ui.Outputs, testassert.Lines{
+			{"name", "expansion"},
+		})
+	})
+
+	It("says when there are no aliases", func() {
+		ui := &testterm.FakeUI{}
+		testcmd.RunCommand(ui, NewListAliases(ui, testconfig.NewRepository()), testcmd.NewContext("aliases", []string{}), &testreq.FakeReqFactory{})
+
+		testassert.SliceContains(ui.Outputs, testassert.Lines{
+			{"No aliases found"},
+		})
+	})
+})
@@ -3,6 +3,7 @@ package commands
 import (
 	"cf/api"
 	"cf/configuration"
+	"cf/errors"
 	"cf/requirements"
 	"cf/terminal"
 	"github.com/codegangsta/cli"
@@ -16,7 +17,7 @@ type Api struct {
 }
 
 type ApiEndpointSetter interface {
-	SetApiEndpoint(endpoint string)
+	SetApiEndpoint(endpoint string) (err error)
 }
 
 func NewApi(ui terminal.UI, config configuration.Reader, endpointRepo api.EndpointRepository) (cmd Api) {
@@ -30,7 +31,7 @@ func (cmd Api) GetRequirements(reqFactory requirements.Factory, c *cli.Context)
 	return
 }
 
-func (cmd Api) Run(c *cli.Context) {
+func (cmd Api) Run(c *cli.Context) (err error) {
 	if len(c.Args()) == 0 {
 		cmd.ui.Say(
 			"API endpoint: %s (API version: %s)",
@@ -40,10 +41,10 @@ func (cmd Api) Run(c *cli.Context) {
 		return
 	}
 
-	cmd.SetApiEndpoint(c.Args()[0])
+	return cmd.SetApiEndpoint(c.Args()[0])
 }
 
-func (cmd Api) SetApiEndpoint(endpoint string) {
+func (cmd Api) SetApiEndpoint(endpoint string) (err error) {
 	if strings.HasSuffix(endpoint, "/") {
 		endpoint = strings.TrimSuffix(endpoint, "/")
 	}
@@ -52,7 +53,7 @@ func (cmd Api) SetApiEndpoint(endpoint string) {
 
 	endpoint, apiResponse := cmd.endpointRepo.UpdateEndpoint(endpoint)
 	if apiResponse.IsNotSuccessful() {
-		cmd.ui.Failed(apiResponse.Message)
+		err = errors.FromApiResponse(apiResponse)
 		return
 	}
 
@@ -64,4 +65,5 @@ func (cmd Api) SetApiEndpoint(endpoint string) {
 	}
 
 	cmd.ui.ShowConfiguration(cmd.config)
+	return
 }
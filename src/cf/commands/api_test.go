@@ -19,7 +19,7 @@ func callApi(args []string, config configuration.Reader, endpointRepo *testapi.F
 	cmd := NewApi(ui, config, endpointRepo)
 	ctxt := testcmd.NewContext("api", args)
 	reqFactory := &testreq.FakeReqFactory{}
-	testcmd.RunCommand(cmd, ctxt, reqFactory)
+	testcmd.RunCommand(ui, cmd, ctxt, reqFactory)
 	return
 }
 
@@ -3,9 +3,9 @@ package application
 import (
 	"cf/api"
 	"cf/configuration"
+	"cf/errors"
 	"cf/requirements"
 	"cf/terminal"
-	"errors"
 	"github.com/codegangsta/cli"
 )
 
@@ -34,10 +34,15 @@ func (cmd *DeleteApp) GetRequirements(reqFactory requirements.Factory, c *cli.Co
 	return
 }
 
-func (cmd *DeleteApp) Run(c *cli.Context) {
+func (cmd *DeleteApp) Run(c *cli.Context) (err error) {
 	appName := c.Args()[0]
 	force := c.Bool("f")
 
+	if !force && !cmd.ui.IsInteractive() {
+		err = errors.NewMissingInputError("confirmation", "-f")
+		return
+	}
+
 	if !force {
 		response := cmd.ui.Confirm(
 			"Really delete %s?%s",
@@ -59,7 +64,7 @@ func (cmd *DeleteApp) Run(c *cli.Context) {
 	app, apiResponse := cmd.appRepo.Read(appName)
 
 	if apiResponse.IsError() {
-		cmd.ui.Failed(apiResponse.Message)
+		err = errors.FromApiResponse(apiResponse)
 		return
 	}
 
@@ -71,7 +76,7 @@ func (cmd *DeleteApp) Run(c *cli.Context) {
 
 	apiResponse = cmd.appRepo.Delete(app.Guid)
 	if apiResponse.IsNotSuccessful() {
-		cmd.ui.Failed(apiResponse.Message)
+		err = errors.FromApiResponse(apiResponse)
 		return
 	}
 
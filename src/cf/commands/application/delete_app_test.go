@@ -2,7 +2,9 @@ package application_test
 
 import (
 	. "cf/commands/application"
+	"cf/errors"
 	"cf/models"
+	"cf/terminal"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	testapi "testhelpers/api"
@@ -56,7 +58,7 @@ var _ = Describe("Testing with ginkgo", func() {
 		ctxt := testcmd.NewContext("delete", []string{"-f", "app-to-delete"})
 
 		cmd := NewDeleteApp(ui, testconfig.NewRepository(), appRepo)
-		testcmd.RunCommand(cmd, ctxt, reqFactory)
+		testcmd.RunCommand(ui, cmd, ctxt, reqFactory)
 
 		Expect(appRepo.ReadName).To(Equal("app-to-delete"))
 		Expect(appRepo.DeletedAppGuid).To(Equal("app-to-delete-guid"))
@@ -76,7 +78,7 @@ var _ = Describe("Testing with ginkgo", func() {
 		ctxt := testcmd.NewContext("delete", []string{"-f", "app-to-delete"})
 
 		cmd := NewDeleteApp(ui, testconfig.NewRepository(), appRepo)
-		testcmd.RunCommand(cmd, ctxt, reqFactory)
+		testcmd.RunCommand(ui, cmd, ctxt, reqFactory)
 
 		Expect(appRepo.ReadName).To(Equal("app-to-delete"))
 		Expect(appRepo.DeletedAppGuid).To(Equal(""))
@@ -87,6 +89,27 @@ var _ = Describe("Testing with ginkgo", func() {
 			{"app-to-delete", "does not exist"},
 		})
 	})
+	It("requires -f in non-interactive mode", func() {
+		app := models.Application{}
+		app.Name = "app-to-delete"
+		app.Guid = "app-to-delete-guid"
+
+		appRepo := &testapi.FakeApplicationRepository{ReadApp: app}
+		ui := &testterm.FakeUI{}
+		ui.SetOutputOptions(terminal.OutputOptions{NonInteractive: true})
+
+		cmd := NewDeleteApp(ui, testconfig.NewRepositoryWithDefaults(), appRepo)
+		err := testcmd.RunCommand(ui, cmd, testcmd.NewContext("delete", []string{"app-to-delete"}), &testreq.FakeReqFactory{})
+
+		Expect(errors.ExitCode(err)).To(Equal(errors.ExitCodeUsage))
+		Expect(ui.Prompts).To(BeEmpty())
+		Expect(appRepo.DeletedAppGuid).To(BeEmpty())
+		testassert.SliceContains(ui.Outputs, testassert.Lines{
+			{"FAILED"},
+			{"Cannot prompt for confirmation in non-interactive mode", "-f"},
+		})
+	})
+
 	It("TestDeleteCommandFailsWithUsage", func() {
 
 		ui, _, _ := deleteApp("Yes", []string{})
@@ -113,6 +136,6 @@ func deleteApp(confirmation string, args []string) (ui *testterm.FakeUI, reqFact
 
 	ctxt := testcmd.NewContext("delete", args)
 	cmd := NewDeleteApp(ui, configRepo, appRepo)
-	testcmd.RunCommand(cmd, ctxt, reqFactory)
+	testcmd.RunCommand(ui, cmd, ctxt, reqFactory)
 	return
 }
@@ -1,23 +1,28 @@
 package application
 
 import (
+	"cf/api"
 	"cf/configuration"
+	"cf/errors"
 	"cf/requirements"
 	"cf/terminal"
-	"errors"
+	"encoding/json"
 	"github.com/codegangsta/cli"
+	"sort"
 )
 
 type Env struct {
-	ui     terminal.UI
-	config configuration.Reader
-	appReq requirements.ApplicationRequirement
+	ui      terminal.UI
+	config  configuration.Reader
+	appRepo api.ApplicationRepository
+	appReq  requirements.ApplicationRequirement
 }
 
-func NewEnv(ui terminal.UI, config configuration.Reader) (cmd *Env) {
+func NewEnv(ui terminal.UI, config configuration.Reader, appRepo api.ApplicationRepository) (cmd *Env) {
 	cmd = new(Env)
 	cmd.ui = ui
 	cmd.config = config
+	cmd.appRepo = appRepo
 	return
 }
 
@@ -28,6 +33,18 @@ func (cmd *Env) GetRequirements(reqFactory requirements.Factory, c *cli.Context)
 		return
 	}
 
+	if c.IsSet("export-format") && !c.Bool("export") {
+		err = errors.NewUsageError("--export-format can only be used with --export")
+		cmd.ui.Failed(err.Error())
+		return
+	}
+
+	if _, found := envExporters[exportFormat(c)]; !found {
+		err = errors.NewUsageError("Unknown export format %s, use shell, dotenv or json", exportFormat(c))
+		cmd.ui.Failed(err.Error())
+		return
+	}
+
 	cmd.appReq = reqFactory.NewApplicationRequirement(c.Args()[0])
 
 	reqs = []requirements.Requirement{
@@ -37,15 +54,36 @@ func (cmd *Env) GetRequirements(reqFactory requirements.Factory, c *cli.Context)
 	return
 }
 
-func (cmd *Env) Run(c *cli.Context) {
+func (cmd *Env) Run(c *cli.Context) (err error) {
 	app := cmd.appReq.GetApplication()
 
+	if c.Bool("export") {
+		return cmd.export(c)
+	}
+
 	cmd.ui.Say("Getting env variables for app %s in org %s / space %s as %s...",
 		terminal.EntityNameColor(app.Name),
 		terminal.EntityNameColor(cmd.config.OrganizationFields().Name),
 		terminal.EntityNameColor(cmd.config.SpaceFields().Name),
 		terminal.EntityNameColor(cmd.config.Username()),
 	)
+
+	if c.Bool("vcap-services-only") {
+		vars, err := cmd.platformVariables(c)
+		if err != nil {
+			return err
+		}
+
+		cmd.ui.Ok()
+		for _, name := range sortedNames(vars) {
+			output, _ := json.MarshalIndent(vars[name], "", "  ")
+			cmd.ui.Say("")
+			cmd.ui.Say("%s:", name)
+			cmd.ui.Say("%s", string(output))
+		}
+		return nil
+	}
+
 	envVars := app.EnvironmentVars
 
 	cmd.ui.Ok()
@@ -58,4 +96,74 @@ func (cmd *Env) Run(c *cli.Context) {
 	for key, value := range envVars {
 		cmd.ui.Say("%s: %s", key, terminal.EntityNameColor(value))
 	}
+	return
+}
+
+// export prints the variables alone, so that the output can be sourced or
+// saved as is
+func (cmd *Env) export(c *cli.Context) (err error) {
+	vars, err := cmd.platformVariables(c)
+	if err != nil {
+		return
+	}
+
+	if !c.Bool("vcap-services-only") {
+		envVars := cmd.appReq.GetApplication().EnvironmentVars
+		if !c.Bool("reveal") {
+			envVars = maskEnvVars(envVars)
+		}
+		for name, value := range envVars {
+			vars[name] = value
+		}
+	}
+
+	output, err := envExporters[exportFormat(c)](vars, !c.Bool("reveal"))
+	if err != nil {
+		err = errors.New("Error exporting env variables\n%s", err.Error())
+		return
+	}
+
+	cmd.ui.Say("%s", output)
+	return
+}
+
+// platformVariables reads VCAP_SERVICES and VCAP_APPLICATION as the
+// platform injects them into the app
+func (cmd *Env) platformVariables(c *cli.Context) (vars map[string]interface{}, err error) {
+	env, apiResponse := cmd.appRepo.ReadEnv(cmd.appReq.GetApplication().Guid)
+	if apiResponse.IsNotSuccessful() {
+		err = errors.FromApiResponse(apiResponse)
+		return
+	}
+
+	vars = map[string]interface{}{
+		"VCAP_SERVICES":    map[string]interface{}{},
+		"VCAP_APPLICATION": map[string]interface{}{},
+	}
+	if services, found := env.System["VCAP_SERVICES"]; found {
+		vars["VCAP_SERVICES"] = services
+	}
+	if application, found := env.Application["VCAP_APPLICATION"]; found {
+		vars["VCAP_APPLICATION"] = application
+	}
+
+	if !c.Bool("reveal") {
+		vars["VCAP_SERVICES"] = maskCredentials(vars["VCAP_SERVICES"], false)
+	}
+	return
+}
+
+func exportFormat(c *cli.Context) string {
+	if c.String("export-format") == "" {
+		return "shell"
+	}
+	return c.String("export-format")
+}
+
+func sortedNames(vars map[string]interface{}) (names []string) {
+	for name := range vars {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return
 }
@@ -0,0 +1,134 @@
+package application
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+const (
+	maskedValue       = "***"
+	maskedValueNotice = "# Sensitive values are masked, pass --reveal to export them"
+)
+
+type envExporter func(vars map[string]interface{}, masked bool) (output string, err error)
+
+var envExporters = map[string]envExporter{
+	"shell":  exportShell,
+	"dotenv": exportDotenv,
+	"json":   exportJson,
+}
+
+var (
+	sensitiveKeyPattern     = regexp.MustCompile(`(?i)(pass|pwd|secret|token|private|(^|_|-|api|access)key$)`)
+	urlPasswordPattern      = regexp.MustCompile(`(://[^:/@\s]+:)[^@/\s]+@`)
+	urlPasswordParamPattern = regexp.MustCompile(`(?i)([?&;](password|pwd|secret|token)=)[^&;\s]*`)
+)
+
+func exportShell(vars map[string]interface{}, masked bool) (output string, err error) {
+	lines := []string{}
+	if masked {
+		lines = append(lines, maskedValueNotice)
+	}
+
+	for _, name := range sortedNames(vars) {
+		value, err := envValue(vars[name])
+		if err != nil {
+			return "", err
+		}
+		value = "'" + strings.Replace(value, "'", `'\''`, -1) + "'"
+		lines = append(lines, fmt.Sprintf("export %s=%s", name, value))
+	}
+	output = strings.Join(lines, "\n")
+	return
+}
+
+func exportDotenv(vars map[string]interface{}, masked bool) (output string, err error) {
+	lines := []string{}
+	if masked {
+		lines = append(lines, maskedValueNotice)
+	}
+
+	for _, name := range sortedNames(vars) {
+		value, err := envValue(vars[name])
+		if err != nil {
+			return "", err
+		}
+
+		// single quoted values are taken literally, the others need escaping
+		if strings.Contains(value, "'") {
+			value = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`, "$", `\$`).Replace(value)
+			value = `"` + value + `"`
+		} else {
+			value = "'" + value + "'"
+		}
+		lines = append(lines, fmt.Sprintf("%s=%s", name, value))
+	}
+	output = strings.Join(lines, "\n")
+	return
+}
+
+func exportJson(vars map[string]interface{}, masked bool) (output string, err error) {
+	bytes, err := json.MarshalIndent(vars, "", "  ")
+	output = string(bytes)
+	return
+}
+
+// envValue formats a value like the platform does, with JSON for anything
+// but strings
+func envValue(value interface{}) (string, error) {
+	if text, ok := value.(string); ok {
+		return text, nil
+	}
+	bytes, err := json.Marshal(value)
+	return string(bytes), err
+}
+
+// maskCredentials masks the values of sensitive keys, and the passwords in
+// URIs, anywhere in a decoded JSON value
+func maskCredentials(value interface{}, sensitive bool) interface{} {
+	switch value := value.(type) {
+	case map[string]interface{}:
+		masked := map[string]interface{}{}
+		for key, item := range value {
+			masked[key] = maskCredentials(item, sensitive || sensitiveKeyPattern.MatchString(key))
+		}
+		return masked
+	case []interface{}:
+		masked := []interface{}{}
+		for _, item := range value {
+			masked = append(masked, maskCredentials(item, sensitive))
+		}
+		return masked
+	case string:
+		if sensitive {
+			return maskedValue
+		}
+		return maskUrlPasswords(value)
+	case nil:
+		return nil
+	}
+
+	if sensitive {
+		return maskedValue
+	}
+	return value
+}
+
+func maskEnvVars(envVars map[string]string) (masked map[string]string) {
+	masked = map[string]string{}
+	for name, value := range envVars {
+		if sensitiveKeyPattern.MatchString(name) {
+			masked[name] = maskedValue
+		} else {
+			masked[name] = maskUrlPasswords(value)
+		}
+	}
+	return
+}
+
+func maskUrlPasswords(value string) string {
+	value = urlPasswordPattern.ReplaceAllString(value, "${1}"+maskedValue+"@")
+	return urlPasswordParamPattern.ReplaceAllString(value, "${1}"+maskedValue)
+}
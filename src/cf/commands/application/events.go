@@ -3,10 +3,10 @@ package application
 import (
 	"cf/api"
 	"cf/configuration"
+	"cf/errors"
 	"cf/models"
 	"cf/requirements"
 	"cf/terminal"
-	"errors"
 	"github.com/codegangsta/cli"
 )
 
@@ -42,7 +42,7 @@ func (cmd *Events) GetRequirements(reqFactory requirements.Factory, c *cli.Conte
 	return
 }
 
-func (cmd *Events) Run(c *cli.Context) {
+func (cmd *Events) Run(c *cli.Context) (err error) {
 	app := cmd.appReq.GetApplication()
 
 	cmd.ui.Say("Getting events for app %s in org %s / space %s as %s...\n",
@@ -56,21 +56,27 @@ func (cmd *Events) Run(c *cli.Context) {
 	noEvents := true
 
 	apiResponse := cmd.eventsRepo.ListEvents(app.Guid, func(event models.EventFields) bool {
-		table.Print([][]string{{
+		table.Add(newEventOutput(event),
 			event.Timestamp.Local().Format(TIMESTAMP_FORMAT),
 			event.Name,
 			event.Description,
-		}})
+		)
 		noEvents = false
 		return true
 	})
 
 	if apiResponse.IsNotSuccessful() {
-		cmd.ui.Failed("Failed fetching events.\n%s", apiResponse.Message)
+		err = errors.FromApiResponseWithMessage(apiResponse, "Failed fetching events.\n%s", apiResponse.Message)
+		return
+	}
+
+	err = table.Print()
+	if err != nil {
 		return
 	}
 	if noEvents {
 		cmd.ui.Say("No events for app %s", terminal.EntityNameColor(app.Name))
 		return
 	}
+	return
 }
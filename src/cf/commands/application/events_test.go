@@ -95,6 +95,6 @@ func callEvents(args []string, reqFactory *testreq.FakeReqFactory, eventsRepo *t
 
 	configRepo := testconfig.NewRepositoryWithDefaults()
 	cmd := NewEvents(ui, configRepo, eventsRepo)
-	testcmd.RunCommand(cmd, ctxt, reqFactory)
+	testcmd.RunCommand(ui, cmd, ctxt, reqFactory)
 	return
 }
@@ -3,9 +3,9 @@ package application
 import (
 	"cf/api"
 	"cf/configuration"
+	"cf/errors"
 	"cf/requirements"
 	"cf/terminal"
-	"errors"
 	"github.com/codegangsta/cli"
 )
 
@@ -41,7 +41,7 @@ func (cmd *Files) GetRequirements(reqFactory requirements.Factory, c *cli.Contex
 	return
 }
 
-func (cmd *Files) Run(c *cli.Context) {
+func (cmd *Files) Run(c *cli.Context) (err error) {
 	app := cmd.appReq.GetApplication()
 
 	cmd.ui.Say("Getting files for app %s in org %s / space %s as %s...",
@@ -58,11 +58,12 @@ func (cmd *Files) Run(c *cli.Context) {
 
 	list, apiResponse := cmd.appFilesRepo.ListFiles(app.Guid, path)
 	if apiResponse.IsNotSuccessful() {
-		cmd.ui.Failed(apiResponse.Message)
+		err = errors.FromApiResponse(apiResponse)
 		return
 	}
 
 	cmd.ui.Ok()
 	cmd.ui.Say("")
 	cmd.ui.Say("%s", list)
+	return
 }
@@ -84,7 +84,7 @@ func callFiles(args []string, reqFactory *testreq.FakeReqFactory, appFilesRepo *
 
 	configRepo := testconfig.NewRepositoryWithDefaults()
 	cmd := NewFiles(ui, configRepo, appFilesRepo)
-	testcmd.RunCommand(cmd, ctxt, reqFactory)
+	testcmd.RunCommand(ui, cmd, ctxt, reqFactory)
 
 	return
 }
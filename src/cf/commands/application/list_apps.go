@@ -3,6 +3,7 @@ package application
 import (
 	"cf/api"
 	"cf/configuration"
+	"cf/errors"
 	"cf/formatters"
 	"cf/requirements"
 	"cf/terminal"
@@ -31,7 +32,7 @@ func (cmd ListApps) GetRequirements(reqFactory requirements.Factory, c *cli.Cont
 	return
 }
 
-func (cmd ListApps) Run(c *cli.Context) {
+func (cmd ListApps) Run(c *cli.Context) (err error) {
 	cmd.ui.Say("Getting apps in org %s / space %s as %s...",
 		terminal.EntityNameColor(cmd.config.OrganizationFields().Name),
 		terminal.EntityNameColor(cmd.config.SpaceFields().Name),
@@ -41,7 +42,7 @@ func (cmd ListApps) Run(c *cli.Context) {
 	apps, apiResponse := cmd.appSummaryRepo.GetSummariesInCurrentSpace()
 
 	if apiResponse.IsNotSuccessful() {
-		cmd.ui.Failed(apiResponse.Message)
+		err = errors.FromApiResponse(apiResponse)
 		return
 	}
 
@@ -50,12 +51,9 @@ func (cmd ListApps) Run(c *cli.Context) {
 
 	if len(apps) == 0 {
 		cmd.ui.Say("No apps found")
-		return
 	}
 
-	table := [][]string{
-		[]string{"name", "requested state", "instances", "memory", "disk", "urls"},
-	}
+	table := cmd.ui.Table([]string{"name", "requested state", "instances", "memory", "disk", "urls"})
 
 	for _, appSummary := range apps {
 		var urls []string
@@ -63,15 +61,16 @@ func (cmd ListApps) Run(c *cli.Context) {
 			urls = append(urls, route.URL())
 		}
 
-		table = append(table, []string{
+		table.Add(newAppOutput(appSummary),
 			appSummary.Name,
 			coloredAppState(appSummary.ApplicationFields),
 			coloredAppInstances(appSummary.ApplicationFields),
-			formatters.ByteSize(appSummary.Memory * formatters.MEGABYTE),
-			formatters.ByteSize(appSummary.DiskQuota * formatters.MEGABYTE),
+			formatters.ByteSize(appSummary.Memory*formatters.MEGABYTE),
+			formatters.ByteSize(appSummary.DiskQuota*formatters.MEGABYTE),
 			strings.Join(urls, ", "),
-		})
+		)
 	}
 
-	cmd.ui.DisplayTable(table)
+	err = table.Print()
+	return
 }
@@ -3,6 +3,7 @@ package application_test
 import (
 	. "cf/commands/application"
 	"cf/models"
+	"cf/terminal"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	testapi "testhelpers/api"
@@ -93,6 +94,50 @@ var _ = Describe("list-apps command", func() {
 			{"No apps found"},
 		})
 	})
+	It("prints the app summaries when a structured output format is used", func() {
+		app := models.AppSummary{}
+		app.Name = "Application-1"
+		app.State = "started"
+		app.Memory = 512
+
+		appSummaryRepo := &testapi.FakeAppSummaryRepo{
+			GetSummariesInCurrentSpaceApps: []models.AppSummary{app},
+		}
+		reqFactory := &testreq.FakeReqFactory{LoginSuccess: true, TargetedSpaceSuccess: true}
+
+		ui := &testterm.FakeUI{}
+		ui.SetOutputOptions(terminal.OutputOptions{Format: terminal.OutputJSON})
+		cmd := NewListApps(ui, testconfig.NewRepositoryWithDefaults(), appSummaryRepo)
+		testcmd.RunCommand(ui, cmd, testcmd.NewContext("apps", []string{}), reqFactory)
+
+		testassert.SliceContains(ui.Outputs, testassert.Lines{
+			{"OK"},
+			{"["},
+			{`"name": "Application-1"`},
+			{`"memory": 512`},
+			{`"urls": []`},
+			{"]"},
+		})
+	})
+
+	It("prints each app with the --format template", func() {
+		app := models.AppSummary{}
+		app.Name = "Application-1"
+		app.Memory = 512
+
+		appSummaryRepo := &testapi.FakeAppSummaryRepo{
+			GetSummariesInCurrentSpaceApps: []models.AppSummary{app},
+		}
+		reqFactory := &testreq.FakeReqFactory{LoginSuccess: true, TargetedSpaceSuccess: true}
+
+		ui := &testterm.FakeUI{}
+		ui.SetOutputOptions(terminal.OutputOptions{Format: terminal.OutputTemplate, Template: `{{.Name}}: {{byteSize .Memory "M"}}`})
+		cmd := NewListApps(ui, testconfig.NewRepositoryWithDefaults(), appSummaryRepo)
+		testcmd.RunCommand(ui, cmd, testcmd.NewContext("apps", []string{}), reqFactory)
+
+		Expect(ui.Outputs[len(ui.Outputs)-1]).To(Equal("Application-1: 512M"))
+	})
+
 	It("TestAppsRequiresLogin", func() {
 
 		appSummaryRepo := &testapi.FakeAppSummaryRepo{}
@@ -118,7 +163,7 @@ func callApps(appSummaryRepo *testapi.FakeAppSummaryRepo, reqFactory *testreq.Fa
 	configRepo := testconfig.NewRepositoryWithDefaults()
 	ctxt := testcmd.NewContext("apps", []string{})
 	cmd := NewListApps(ui, configRepo, appSummaryRepo)
-	testcmd.RunCommand(cmd, ctxt, reqFactory)
+	testcmd.RunCommand(ui, cmd, ctxt, reqFactory)
 
 	return
 }
@@ -3,12 +3,14 @@ package application
 import (
 	"cf/api"
 	"cf/configuration"
+	"cf/errors"
 	"cf/models"
 	"cf/requirements"
 	"cf/terminal"
-	"errors"
 	"github.com/cloudfoundry/loggregatorlib/logmessage"
 	"github.com/codegangsta/cli"
+	"os"
+	"os/signal"
 	"time"
 )
 
@@ -44,7 +46,7 @@ func (cmd *Logs) GetRequirements(reqFactory requirements.Factory, c *cli.Context
 	return
 }
 
-func (cmd *Logs) Run(c *cli.Context) {
+func (cmd *Logs) Run(c *cli.Context) (err error) {
 	app := cmd.appReq.GetApplication()
 	logChan := make(chan *logmessage.Message, 1000)
 
@@ -58,6 +60,7 @@ func (cmd *Logs) Run(c *cli.Context) {
 	}()
 
 	cmd.displayLogMessages(logChan)
+	return
 }
 
 func (cmd *Logs) recentLogsFor(app models.Application, logChan chan *logmessage.Message) {
@@ -72,7 +75,6 @@ func (cmd *Logs) recentLogsFor(app models.Application, logChan chan *logmessage.
 
 	err := cmd.logsRepo.RecentLogsFor(app.Guid, onConnect, logChan)
 	if err != nil {
-		cmd.ui.Failed(err.Error())
 		return
 	}
 }
@@ -87,13 +89,25 @@ func (cmd *Logs) tailLogsFor(app models.Application, logChan chan *logmessage.Me
 		)
 	}
 
-	// in this case we tail the logs forever, so we never send true on this channel
+	// the logs are tailed until Ctrl-C, which returns to the prompt in cf shell
 	stopLoggingChan := make(chan bool)
-	defer close(stopLoggingChan)
+	done := make(chan bool)
+	defer close(done)
+
+	interrupts := make(chan os.Signal, 1)
+	signal.Notify(interrupts, os.Interrupt)
+	defer signal.Stop(interrupts)
+
+	go func() {
+		select {
+		case <-interrupts:
+			close(stopLoggingChan)
+		case <-done:
+		}
+	}()
 
 	err := cmd.logsRepo.TailLogsFor(app.Guid, onConnect, logChan, stopLoggingChan, 5*time.Second)
 	if err != nil {
-		cmd.ui.Failed(err.Error())
 		return
 	}
 }
@@ -122,6 +122,6 @@ func callLogs(args []string, reqFactory *testreq.FakeReqFactory, logsRepo *testa
 
 	configRepo := testconfig.NewRepositoryWithDefaults()
 	cmd := NewLogs(ui, configRepo, logsRepo)
-	testcmd.RunCommand(cmd, ctxt, reqFactory)
+	testcmd.RunCommand(ui, cmd, ctxt, reqFactory)
 	return
 }
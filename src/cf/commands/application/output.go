@@ -0,0 +1,78 @@
+package application
+
+import (
+	"cf/models"
+	"time"
+)
+
+// appOutput is what structured output prints for an app. Environment
+// variables are left out as they often hold credentials.
+type appOutput struct {
+	Guid             string   `json:"guid"`
+	Name             string   `json:"name"`
+	State            string   `json:"state"`
+	InstanceCount    int      `json:"instance_count"`
+	RunningInstances int      `json:"running_instances"`
+	Memory           uint64   `json:"memory"`     // in megabytes
+	DiskQuota        uint64   `json:"disk_quota"` // in megabytes
+	Urls             []string `json:"urls"`
+
+	// Instances are only shown by app
+	Instances []appInstanceOutput `json:"instances,omitempty"`
+}
+
+type appInstanceOutput struct {
+	Index     int       `json:"index"`
+	State     string    `json:"state"`
+	Since     time.Time `json:"since"`
+	CpuUsage  float64   `json:"cpu_usage"`  // a fraction of one core
+	MemUsage  uint64    `json:"mem_usage"`  // in bytes
+	MemQuota  uint64    `json:"mem_quota"`  // in bytes
+	DiskUsage uint64    `json:"disk_usage"` // in bytes
+	DiskQuota uint64    `json:"disk_quota"` // in bytes
+}
+
+type eventOutput struct {
+	Timestamp   time.Time `json:"timestamp"`
+	Name        string    `json:"name"`
+	Description string    `json:"description"`
+}
+
+func newAppOutput(appSummary models.AppSummary) (output appOutput) {
+	output = appOutput{
+		Guid:             appSummary.Guid,
+		Name:             appSummary.Name,
+		State:            appSummary.State,
+		InstanceCount:    appSummary.InstanceCount,
+		RunningInstances: appSummary.RunningInstances,
+		Memory:           appSummary.Memory,
+		DiskQuota:        appSummary.DiskQuota,
+		Urls:             []string{},
+	}
+
+	for _, route := range appSummary.RouteSummaries {
+		output.Urls = append(output.Urls, route.URL())
+	}
+	return
+}
+
+func newAppInstanceOutput(index int, instance models.AppInstanceFields) appInstanceOutput {
+	return appInstanceOutput{
+		Index:     index,
+		State:     string(instance.State),
+		Since:     instance.Since,
+		CpuUsage:  instance.CpuUsage,
+		MemUsage:  instance.MemUsage,
+		MemQuota:  instance.MemQuota,
+		DiskUsage: instance.DiskUsage,
+		DiskQuota: instance.DiskQuota,
+	}
+}
+
+func newEventOutput(event models.EventFields) eventOutput {
+	return eventOutput{
+		Timestamp:   event.Timestamp,
+		Name:        event.Name,
+		Description: event.Description,
+	}
+}
@@ -4,13 +4,13 @@ import (
 	"cf/api"
 	"cf/commands/service"
 	"cf/configuration"
+	"cf/errors"
 	"cf/formatters"
 	"cf/manifest"
 	"cf/models"
 	"cf/net"
 	"cf/requirements"
 	"cf/terminal"
-	"errors"
 	"fmt"
 	"github.com/codegangsta/cli"
 	"os"
@@ -64,39 +64,59 @@ func (cmd *Push) GetRequirements(reqFactory requirements.Factory, c *cli.Context
 	return
 }
 
-func (cmd *Push) Run(c *cli.Context) {
-	appSet := cmd.findAndValidateAppsToPush(c)
+func (cmd *Push) Run(c *cli.Context) (err error) {
+	appSet, err := cmd.findAndValidateAppsToPush(c)
+	if err != nil {
+		return
+	}
 
 	for _, appParams := range appSet {
-		cmd.fetchStackGuid(&appParams)
+		err = cmd.fetchStackGuid(&appParams)
+		if err != nil {
+			return
+		}
 
-		app := cmd.createOrUpdateApp(appParams)
+		var app models.Application
+		app, err = cmd.createOrUpdateApp(appParams)
+		if err != nil {
+			return
+		}
 
-		cmd.bindAppToRoute(app, appParams, c)
+		err = cmd.bindAppToRoute(app, appParams, c)
+		if err != nil {
+			return
+		}
 
 		cmd.ui.Say("Uploading %s...", terminal.EntityNameColor(app.Name))
 
 		apiResponse := cmd.appBitsRepo.UploadApp(app.Guid, *appParams.Path, cmd.describeUploadOperation)
 		if apiResponse.IsNotSuccessful() {
-			cmd.ui.Failed(fmt.Sprintf("Error uploading application.\n%s", apiResponse.Message))
+			err = errors.FromApiResponseWithMessage(apiResponse, "Error uploading application.\n%s", apiResponse.Message)
 			return
 		}
 		cmd.ui.Ok()
 
 		if appParams.Services != nil {
-			cmd.bindAppToServices(*appParams.Services, app)
+			err = cmd.bindAppToServices(*appParams.Services, app)
+			if err != nil {
+				return
+			}
 		}
 
-		cmd.restart(app, appParams, c)
+		err = cmd.restart(app, appParams, c)
+		if err != nil {
+			return
+		}
 	}
+	return
 }
 
-func (cmd *Push) bindAppToServices(services []string, app models.Application) {
+func (cmd *Push) bindAppToServices(services []string, app models.Application) (err error) {
 	for _, serviceName := range services {
 		serviceInstance, response := cmd.serviceRepo.FindInstanceByName(serviceName)
 
 		if response.IsNotSuccessful() {
-			cmd.ui.Failed("Could not find service %s to bind to %s", serviceName, app.Name)
+			err = errors.FromApiResponseWithMessage(response, "Could not find service %s to bind to %s", serviceName, app.Name)
 			return
 		}
 
@@ -105,10 +125,11 @@ func (cmd *Push) bindAppToServices(services []string, app models.Application) {
 		cmd.ui.Ok()
 
 		if bindResponse.IsNotSuccessful() && bindResponse.ErrorCode != service.AppAlreadyBoundErrorCode {
-			cmd.ui.Failed("Could not find to service %s\nError: %s", serviceName, bindResponse.Message)
+			err = errors.FromApiResponseWithMessage(bindResponse, "Could not find to service %s\nError: %s", serviceName, bindResponse.Message)
 			return
 		}
 	}
+	return
 }
 
 func (cmd *Push) describeUploadOperation(path string, zipFileBytes, fileCount uint64) {
@@ -116,7 +137,7 @@ func (cmd *Push) describeUploadOperation(path string, zipFileBytes, fileCount ui
 	cmd.ui.Say("Uploading from: %s\n%s, %d files", path, humanReadableBytes, fileCount)
 }
 
-func (cmd *Push) fetchStackGuid(appParams *models.AppParams) {
+func (cmd *Push) fetchStackGuid(appParams *models.AppParams) (err error) {
 	if appParams.StackName == nil {
 		return
 	}
@@ -126,15 +147,16 @@ func (cmd *Push) fetchStackGuid(appParams *models.AppParams) {
 
 	stack, apiResponse := cmd.stackRepo.FindByName(stackName)
 	if apiResponse.IsNotSuccessful() {
-		cmd.ui.Failed(apiResponse.Message)
+		err = errors.FromApiResponse(apiResponse)
 		return
 	}
 
 	cmd.ui.Ok()
 	appParams.StackGuid = &stack.Guid
+	return
 }
 
-func (cmd *Push) bindAppToRoute(app models.Application, params models.AppParams, c *cli.Context) {
+func (cmd *Push) bindAppToRoute(app models.Application, params models.AppParams, c *cli.Context) (err error) {
 	if c.Bool("no-route") {
 		return
 	}
@@ -164,8 +186,15 @@ func (cmd *Push) bindAppToRoute(app models.Application, params models.AppParams,
 	}
 
 	hostName := cmd.hostname(c, defaultHostname)
-	domain := cmd.domain(c, domainName)
-	route := cmd.route(hostName, domain)
+	domain, err := cmd.domain(c, domainName)
+	if err != nil {
+		return
+	}
+
+	route, err := cmd.route(hostName, domain)
+	if err != nil {
+		return
+	}
 
 	for _, boundRoute := range app.Routes {
 		if boundRoute.Guid == route.Guid {
@@ -177,12 +206,13 @@ func (cmd *Push) bindAppToRoute(app models.Application, params models.AppParams,
 
 	apiResponse := cmd.routeRepo.Bind(route.Guid, app.Guid)
 	if apiResponse.IsNotSuccessful() {
-		cmd.ui.Failed(apiResponse.Message)
+		err = errors.FromApiResponse(apiResponse)
 		return
 	}
 
 	cmd.ui.Ok()
 	cmd.ui.Say("")
+	return
 }
 
 var forbiddenHostCharRegex = regexp.MustCompile("[^a-z0-9-]")
@@ -195,10 +225,13 @@ func hostNameForString(name string) string {
 	return string(nameBytes)
 }
 
-func (cmd *Push) restart(app models.Application, params models.AppParams, c *cli.Context) {
+func (cmd *Push) restart(app models.Application, params models.AppParams, c *cli.Context) (err error) {
 	if app.State != "stopped" {
 		cmd.ui.Say("")
-		app, _ = cmd.stopper.ApplicationStop(app)
+		app, err = cmd.stopper.ApplicationStop(app)
+		if err != nil {
+			return
+		}
 	}
 
 	cmd.ui.Say("")
@@ -211,17 +244,18 @@ func (cmd *Push) restart(app models.Application, params models.AppParams, c *cli
 		cmd.starter.SetStartTimeoutSeconds(*params.HealthCheckTimeout)
 	}
 
-	cmd.starter.ApplicationStart(app)
+	_, err = cmd.starter.ApplicationStart(app)
+	return
 }
 
-func (cmd *Push) route(hostName string, domain models.DomainFields) (route models.Route) {
+func (cmd *Push) route(hostName string, domain models.DomainFields) (route models.Route, err error) {
 	route, apiResponse := cmd.routeRepo.FindByHostAndDomain(hostName, domain.Name)
 	if apiResponse.IsNotSuccessful() {
 		cmd.ui.Say("Creating route %s...", terminal.EntityNameColor(domain.UrlForHost(hostName)))
 
 		route, apiResponse = cmd.routeRepo.Create(hostName, domain.Guid)
 		if apiResponse.IsNotSuccessful() {
-			cmd.ui.Failed(apiResponse.Message)
+			err = errors.FromApiResponse(apiResponse)
 			return
 		}
 
@@ -234,25 +268,24 @@ func (cmd *Push) route(hostName string, domain models.DomainFields) (route model
 	return
 }
 
-func (cmd *Push) domain(c *cli.Context, domainName string) (domain models.DomainFields) {
+func (cmd *Push) domain(c *cli.Context, domainName string) (domain models.DomainFields, err error) {
 	var apiResponse net.ApiResponse
 
 	if domainName != "" {
 		domain, apiResponse = cmd.domainRepo.FindByNameInOrg(domainName, cmd.config.OrganizationFields().Guid)
 		if apiResponse.IsNotSuccessful() {
-			cmd.ui.Failed(apiResponse.Message)
+			err = errors.FromApiResponse(apiResponse)
 		}
 		return
 	}
 
-	domain, err := cmd.findDefaultDomain()
-
+	domain, err = cmd.findDefaultDomain()
 	if err != nil {
-		cmd.ui.Failed(err.Error())
+		return
 	}
 
 	if domain.Guid == "" {
-		cmd.ui.Failed("No default domain exists")
+		err = errors.NewNotFoundError("No default domain exists")
 	}
 
 	return
@@ -274,11 +307,12 @@ func (cmd *Push) findDefaultDomain() (domain models.DomainFields, err error) {
 	}
 
 	if apiResponse.IsNotSuccessful() {
-		err = errors.New(apiResponse.Message)
+		err = errors.FromApiResponse(apiResponse)
+		return
 	}
 
 	if !foundIt {
-		err = errors.New("Could not find a default domain")
+		err = errors.NewNotFoundError("Could not find a default domain")
 		return
 	}
 
@@ -298,36 +332,35 @@ func (cmd *Push) hostname(c *cli.Context, defaultName string) (hostName string)
 	return
 }
 
-func (cmd *Push) createOrUpdateApp(appParams models.AppParams) (app models.Application) {
+func (cmd *Push) createOrUpdateApp(appParams models.AppParams) (app models.Application, err error) {
 	if appParams.Name == nil {
-		cmd.ui.Failed("Error: No name found for app")
+		err = errors.New("Error: No name found for app")
 		return
 	}
 
 	app, apiResponse := cmd.appRepo.Read(*appParams.Name)
 	if apiResponse.IsError() {
-		cmd.ui.Failed(apiResponse.Message)
+		err = errors.FromApiResponse(apiResponse)
 		return
 	}
 
 	var didCreate bool = false
 	if apiResponse.IsNotFound() {
-		app, apiResponse = cmd.createApp(appParams)
-		if apiResponse.IsNotSuccessful() {
-			cmd.ui.Failed(apiResponse.Message)
+		app, err = cmd.createApp(appParams)
+		if err != nil {
 			return
 		}
 		didCreate = true
 	}
 
 	if !didCreate {
-		app = cmd.updateApp(app, appParams)
+		app, err = cmd.updateApp(app, appParams)
 	}
 
 	return
 }
 
-func (cmd *Push) createApp(appParams models.AppParams) (app models.Application, apiResponse net.ApiResponse) {
+func (cmd *Push) createApp(appParams models.AppParams) (app models.Application, err error) {
 	spaceGuid := cmd.config.SpaceFields().Guid
 	appParams.SpaceGuid = &spaceGuid
 
@@ -338,9 +371,9 @@ func (cmd *Push) createApp(appParams models.AppParams) (app models.Application,
 		terminal.EntityNameColor(cmd.config.Username()),
 	)
 
-	app, apiResponse = cmd.appRepo.Create(appParams)
+	app, apiResponse := cmd.appRepo.Create(appParams)
 	if apiResponse.IsNotSuccessful() {
-		cmd.ui.Failed(apiResponse.Message)
+		err = errors.FromApiResponse(apiResponse)
 		return
 	}
 
@@ -350,7 +383,7 @@ func (cmd *Push) createApp(appParams models.AppParams) (app models.Application,
 	return
 }
 
-func (cmd *Push) updateApp(app models.Application, appParams models.AppParams) (updatedApp models.Application) {
+func (cmd *Push) updateApp(app models.Application, appParams models.AppParams) (updatedApp models.Application, err error) {
 	cmd.ui.Say("Updating app %s in org %s / space %s as %s...",
 		terminal.EntityNameColor(app.Name),
 		terminal.EntityNameColor(cmd.config.OrganizationFields().Name),
@@ -369,7 +402,7 @@ func (cmd *Push) updateApp(app models.Application, appParams models.AppParams) (
 	var apiResponse net.ApiResponse
 	updatedApp, apiResponse = cmd.appRepo.Update(app.Guid, appParams)
 	if apiResponse.IsNotSuccessful() {
-		cmd.ui.Failed(apiResponse.Message)
+		err = errors.FromApiResponse(apiResponse)
 		return
 	}
 
@@ -379,29 +412,34 @@ func (cmd *Push) updateApp(app models.Application, appParams models.AppParams) (
 	return
 }
 
-func (cmd *Push) findAndValidateAppsToPush(c *cli.Context) (appSet []models.AppParams) {
-	m := cmd.instantiateManifest(c)
+func (cmd *Push) findAndValidateAppsToPush(c *cli.Context) (appSet []models.AppParams, err error) {
+	m, err := cmd.instantiateManifest(c)
+	if err != nil {
+		return
+	}
 
 	contextParams, err := newAppParamsFromContext(c)
 	if err != nil {
-		cmd.ui.Failed("Error: %s", err)
+		err = errors.New("Error: %s", err)
 		return
 	}
 
 	if contextParams.Name == nil && len(m.Applications) > 1 && !contextParams.Equals(&models.AppParams{}) {
-		cmd.ui.Failed("%s", "Incorrect Usage. Command line flags (except -f) cannot be applied when pushing multiple apps from a manifest file.")
+		err = errors.New("Incorrect Usage. Command line flags (except -f) cannot be applied when pushing multiple apps from a manifest file.")
 		return
 	}
 
 	appSet, err = cmd.createAppSetFromContextAndManifest(c, contextParams, m)
 	if err != nil {
-		cmd.ui.Failed("Error: %s", err)
+		if _, isUsageError := err.(*errors.UsageError); !isUsageError {
+			err = errors.New("Error: %s", err)
+		}
 	}
 
 	return
 }
 
-func (cmd *Push) instantiateManifest(c *cli.Context) (m *manifest.Manifest) {
+func (cmd *Push) instantiateManifest(c *cli.Context) (m *manifest.Manifest, err error) {
 	if c.Bool("no-manifest") {
 		m = manifest.NewEmptyManifest()
 		return
@@ -411,10 +449,9 @@ func (cmd *Push) instantiateManifest(c *cli.Context) (m *manifest.Manifest) {
 	if c.String("f") != "" {
 		path = c.String("f")
 	} else {
-		var err error
 		path, err = os.Getwd()
 		if err != nil {
-			cmd.ui.Failed("Could not determine the current working directory!", err)
+			err = errors.New("Could not determine the current working directory!\n%s", err)
 			return
 		}
 	}
@@ -425,7 +462,7 @@ func (cmd *Push) instantiateManifest(c *cli.Context) (m *manifest.Manifest) {
 		if manifestPath == "" && c.String("f") == "" {
 			m = manifest.NewEmptyManifest()
 		} else {
-			cmd.ui.Failed("Error reading manifest file:\n%s", errs)
+			err = errors.New("Error reading manifest file:\n%s", errs)
 		}
 		return
 	}
@@ -441,7 +478,7 @@ func (cmd *Push) createAppSetFromContextAndManifest(c *cli.Context, contextParam
 			app, err = findAppWithNameInManifest(*contextParams.Name, m)
 
 			if err != nil {
-				cmd.ui.Failed(fmt.Sprintf("Could not find app named '%s' in manifest", *contextParams.Name))
+				err = errors.New(fmt.Sprintf("Could not find app named '%s' in manifest", *contextParams.Name))
 				return
 			}
 
@@ -453,6 +490,7 @@ func (cmd *Push) createAppSetFromContextAndManifest(c *cli.Context, contextParam
 	if len(m.Applications) == 0 {
 		if contextParams.Name == nil || *contextParams.Name == "" {
 			cmd.ui.FailWithUsage(c, "push")
+			err = errors.NewUsageError("Incorrect Usage")
 			return
 		}
 		err = addApp(&appSet, contextParams)
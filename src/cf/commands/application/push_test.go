@@ -42,17 +42,17 @@ var _ = Describe("Push Command", func() {
 		ctxt := testcmd.NewContext("push", []string{})
 
 		reqFactory := &testreq.FakeReqFactory{LoginSuccess: true, TargetedSpaceSuccess: true}
-		testcmd.RunCommand(cmd, ctxt, reqFactory)
+		testcmd.RunCommand(ui, cmd, ctxt, reqFactory)
 		Expect(testcmd.CommandDidPassRequirements).To(BeTrue())
 
 		reqFactory = &testreq.FakeReqFactory{LoginSuccess: false, TargetedSpaceSuccess: true}
-		testcmd.RunCommand(cmd, ctxt, reqFactory)
+		testcmd.RunCommand(ui, cmd, ctxt, reqFactory)
 		Expect(testcmd.CommandDidPassRequirements).To(BeFalse())
 
 		testcmd.CommandDidPassRequirements = true
 
 		reqFactory = &testreq.FakeReqFactory{LoginSuccess: true, TargetedSpaceSuccess: false}
-		testcmd.RunCommand(cmd, ctxt, reqFactory)
+		testcmd.RunCommand(ui, cmd, ctxt, reqFactory)
 		Expect(testcmd.CommandDidPassRequirements).To(BeFalse())
 	})
 
@@ -1100,7 +1100,7 @@ func callPush(args []string, deps pushDependencies) (ui *testterm.FakeUI) {
 		deps.routeRepo, deps.stackRepo, deps.serviceRepo, deps.appBitsRepo)
 
 	reqFactory := &testreq.FakeReqFactory{LoginSuccess: true, TargetedSpaceSuccess: true}
-	testcmd.RunCommand(cmd, ctxt, reqFactory)
+	testcmd.RunCommand(ui, cmd, ctxt, reqFactory)
 
 	return
 }
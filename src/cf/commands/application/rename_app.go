@@ -3,10 +3,10 @@ package application
 import (
 	"cf/api"
 	"cf/configuration"
+	"cf/errors"
 	"cf/models"
 	"cf/requirements"
 	"cf/terminal"
-	"errors"
 	"github.com/codegangsta/cli"
 )
 
@@ -39,7 +39,7 @@ func (cmd *RenameApp) GetRequirements(reqFactory requirements.Factory, c *cli.Co
 	return
 }
 
-func (cmd *RenameApp) Run(c *cli.Context) {
+func (cmd *RenameApp) Run(c *cli.Context) (err error) {
 	app := cmd.appReq.GetApplication()
 	newName := c.Args()[1]
 
@@ -55,8 +55,9 @@ func (cmd *RenameApp) Run(c *cli.Context) {
 
 	_, apiResponse := cmd.appRepo.Update(app.Guid, params)
 	if apiResponse.IsNotSuccessful() {
-		cmd.ui.Failed(apiResponse.Message)
+		err = errors.FromApiResponse(apiResponse)
 		return
 	}
 	cmd.ui.Ok()
+	return
 }
@@ -57,6 +57,6 @@ func callRename(args []string, reqFactory *testreq.FakeReqFactory, appRepo *test
 
 	configRepo := testconfig.NewRepositoryWithDefaults()
 	cmd := NewRenameApp(ui, configRepo, appRepo)
-	testcmd.RunCommand(cmd, ctxt, reqFactory)
+	testcmd.RunCommand(ui, cmd, ctxt, reqFactory)
 	return
 }
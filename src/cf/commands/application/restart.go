@@ -1,10 +1,10 @@
 package application
 
 import (
+	"cf/errors"
 	"cf/models"
 	"cf/requirements"
 	"cf/terminal"
-	"errors"
 	"github.com/codegangsta/cli"
 )
 
@@ -16,7 +16,7 @@ type Restart struct {
 }
 
 type ApplicationRestarter interface {
-	ApplicationRestart(app models.Application)
+	ApplicationRestart(app models.Application) (err error)
 }
 
 func NewRestart(ui terminal.UI, starter ApplicationStarter, stopper ApplicationStopper) (cmd *Restart) {
@@ -44,23 +44,19 @@ func (cmd *Restart) GetRequirements(reqFactory requirements.Factory, c *cli.Cont
 	return
 }
 
-func (cmd *Restart) Run(c *cli.Context) {
+func (cmd *Restart) Run(c *cli.Context) (err error) {
 	app := cmd.appReq.GetApplication()
-	cmd.ApplicationRestart(app)
+	return cmd.ApplicationRestart(app)
 }
 
-func (cmd *Restart) ApplicationRestart(app models.Application) {
+func (cmd *Restart) ApplicationRestart(app models.Application) (err error) {
 	stoppedApp, err := cmd.stopper.ApplicationStop(app)
 	if err != nil {
-		cmd.ui.Failed(err.Error())
 		return
 	}
 
 	cmd.ui.Say("")
 
 	_, err = cmd.starter.ApplicationStart(stoppedApp)
-	if err != nil {
-		cmd.ui.Failed(err.Error())
-		return
-	}
+	return
 }
@@ -15,7 +15,7 @@ func callRestart(args []string, reqFactory *testreq.FakeReqFactory, starter Appl
 	ctxt := testcmd.NewContext("restart", args)
 
 	cmd := NewRestart(ui, starter, stopper)
-	testcmd.RunCommand(cmd, ctxt, reqFactory)
+	testcmd.RunCommand(ui, cmd, ctxt, reqFactory)
 	return
 }
 
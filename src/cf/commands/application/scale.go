@@ -3,11 +3,11 @@ package application
 import (
 	"cf/api"
 	"cf/configuration"
+	"cf/errors"
 	"cf/formatters"
 	"cf/models"
 	"cf/requirements"
 	"cf/terminal"
-	"errors"
 	"github.com/codegangsta/cli"
 )
 
@@ -52,7 +52,7 @@ func (cmd *Scale) GetRequirements(reqFactory requirements.Factory, c *cli.Contex
 	return
 }
 
-func (cmd *Scale) Run(c *cli.Context) {
+func (cmd *Scale) Run(c *cli.Context) (err error) {
 	currentApp := cmd.appReq.GetApplication()
 	cmd.ui.Say("Scaling app %s in org %s / space %s as %s...",
 		terminal.EntityNameColor(currentApp.Name),
@@ -65,10 +65,11 @@ func (cmd *Scale) Run(c *cli.Context) {
 	shouldRestart := false
 
 	if c.String("m") != "" {
-		memory, err := formatters.ToMegabytes(c.String("m"))
-		if err != nil {
+		memory, parseErr := formatters.ToMegabytes(c.String("m"))
+		if parseErr != nil {
 			cmd.ui.Say("Invalid value for memory")
 			cmd.ui.FailWithUsage(c, "scale")
+			err = errors.NewUsageError("Invalid value for memory")
 			return
 		}
 		params.Memory = &memory
@@ -82,7 +83,7 @@ func (cmd *Scale) Run(c *cli.Context) {
 
 	updatedApp, apiResponse := cmd.appRepo.Update(currentApp.Guid, params)
 	if apiResponse.IsNotSuccessful() {
-		cmd.ui.Failed(apiResponse.Message)
+		err = errors.FromApiResponse(apiResponse)
 		return
 	}
 
@@ -90,6 +91,7 @@ func (cmd *Scale) Run(c *cli.Context) {
 	cmd.ui.Say("")
 
 	if shouldRestart {
-		cmd.restarter.ApplicationRestart(updatedApp)
+		err = cmd.restarter.ApplicationRestart(updatedApp)
 	}
+	return
 }
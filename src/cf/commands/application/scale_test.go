@@ -124,6 +124,6 @@ func callScale(args []string, deps scaleDependencies) (ui *testterm.FakeUI) {
 	ctxt := testcmd.NewContext("scale", args)
 	configRepo := testconfig.NewRepositoryWithDefaults()
 	cmd := NewScale(ui, configRepo, deps.restarter, deps.appRepo)
-	testcmd.RunCommand(cmd, ctxt, deps.reqFactory)
+	testcmd.RunCommand(ui, cmd, ctxt, deps.reqFactory)
 	return
 }
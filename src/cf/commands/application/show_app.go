@@ -4,11 +4,11 @@ import (
 	"cf"
 	"cf/api"
 	"cf/configuration"
+	"cf/errors"
 	"cf/formatters"
 	"cf/models"
 	"cf/requirements"
 	"cf/terminal"
-	"errors"
 	"fmt"
 	"github.com/codegangsta/cli"
 	"strings"
@@ -23,7 +23,7 @@ type ShowApp struct {
 }
 
 type ApplicationDisplayer interface {
-	ShowApp(app models.Application)
+	ShowApp(app models.Application) (err error)
 }
 
 func NewShowApp(ui terminal.UI, config configuration.Reader, appSummaryRepo api.AppSummaryRepository, appInstancesRepo api.AppInstancesRepository) (cmd *ShowApp) {
@@ -52,12 +52,12 @@ func (cmd *ShowApp) GetRequirements(reqFactory requirements.Factory, c *cli.Cont
 	return
 }
 
-func (cmd *ShowApp) Run(c *cli.Context) {
+func (cmd *ShowApp) Run(c *cli.Context) (err error) {
 	app := cmd.appReq.GetApplication()
-	cmd.ShowApp(app)
+	return cmd.ShowApp(app)
 }
 
-func (cmd *ShowApp) ShowApp(app models.Application) {
+func (cmd *ShowApp) ShowApp(app models.Application) (err error) {
 
 	cmd.ui.Say("Showing health and status for app %s in org %s / space %s as %s...",
 		terminal.EntityNameColor(app.Name),
@@ -72,18 +72,30 @@ func (cmd *ShowApp) ShowApp(app models.Application) {
 		appSummary.State == "stopped"
 
 	if apiResponse.IsNotSuccessful() && !appIsStopped {
-		cmd.ui.Failed(apiResponse.Message)
+		err = errors.FromApiResponse(apiResponse)
 		return
 	}
 
 	var instances []models.AppInstanceFields
 	instances, apiResponse = cmd.appInstancesRepo.GetInstances(app.Guid)
 	if apiResponse.IsNotSuccessful() && !appIsStopped {
-		cmd.ui.Failed(apiResponse.Message)
+		err = errors.FromApiResponse(apiResponse)
 		return
 	}
 
 	cmd.ui.Ok()
+
+	if cmd.ui.OutputOptions().IsStructured() {
+		output := newAppOutput(appSummary)
+		if !appIsStopped {
+			for index, instance := range instances {
+				output.Instances = append(output.Instances, newAppInstanceOutput(index, instance))
+			}
+		}
+		err = cmd.ui.PrintValue(output)
+		return
+	}
+
 	cmd.ui.Say("\n%s %s", terminal.HeaderColor("requested state:"), coloredAppState(appSummary.ApplicationFields))
 	cmd.ui.Say("%s %s", terminal.HeaderColor("instances:"), coloredAppInstances(appSummary.ApplicationFields))
 	cmd.ui.Say("%s %s x %d instances", terminal.HeaderColor("usage:"), formatters.ByteSize(appSummary.Memory*formatters.MEGABYTE), appSummary.InstanceCount)
@@ -116,4 +128,5 @@ func (cmd *ShowApp) ShowApp(app models.Application) {
 	}
 
 	cmd.ui.DisplayTable(table)
+	return
 }
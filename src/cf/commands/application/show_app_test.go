@@ -5,6 +5,7 @@ import (
 	. "cf/commands/application"
 	"cf/formatters"
 	"cf/models"
+	"cf/terminal"
 	. "github.com/onsi/gomega"
 	testapi "testhelpers/api"
 	testassert "testhelpers/assert"
@@ -110,6 +111,72 @@ var _ = Describe("Testing with ginkgo", func() {
 			{"#1", "down", "2012-04-01 03:04:05 PM", "0%", "0 of 0", "0 of 0"},
 		})
 	})
+	It("prints the app summary and its instances when a structured output format is used", func() {
+		reqApp := models.Application{}
+		reqApp.Name = "my-app"
+		reqApp.Guid = "my-app-guid"
+
+		route := models.RouteSummary{}
+		route.Host = "my-app"
+		route.Domain.Name = "example.com"
+
+		appSummary := models.AppSummary{}
+		appSummary.Name = "my-app"
+		appSummary.State = "started"
+		appSummary.InstanceCount = 1
+		appSummary.Memory = 256
+		appSummary.EnvironmentVars = map[string]string{"DATABASE_PASSWORD": "s3cret"}
+		appSummary.RouteSummaries = []models.RouteSummary{route}
+
+		appInstance := models.AppInstanceFields{}
+		appInstance.State = models.InstanceRunning
+		appInstance.MemUsage = 13 * formatters.BYTE
+
+		appSummaryRepo := &testapi.FakeAppSummaryRepo{GetSummarySummary: appSummary}
+		appInstancesRepo := &testapi.FakeAppInstancesRepo{GetInstancesResponses: [][]models.AppInstanceFields{{appInstance}}}
+		reqFactory := &testreq.FakeReqFactory{LoginSuccess: true, TargetedSpaceSuccess: true, Application: reqApp}
+
+		ui := &testterm.FakeUI{}
+		ui.SetOutputOptions(terminal.OutputOptions{Format: terminal.OutputJSON})
+		cmd := NewShowApp(ui, testconfig.NewRepositoryWithDefaults(), appSummaryRepo, appInstancesRepo)
+		testcmd.RunCommand(ui, cmd, testcmd.NewContext("app", []string{"my-app"}), reqFactory)
+
+		testassert.SliceContains(ui.Outputs, testassert.Lines{
+			{`"name": "my-app"`},
+			{`"memory": 256`},
+			{`"my-app.example.com"`},
+			{`"state": "running"`},
+			{`"mem_usage": 13`},
+		})
+		testassert.SliceDoesNotContain(ui.Outputs, testassert.Lines{
+			{"requested state"},
+			{"#0"},
+			{"s3cret"},
+		})
+	})
+
+	It("prints the app summary with the --format template", func() {
+		reqApp := models.Application{}
+		reqApp.Name = "my-app"
+		reqApp.Guid = "my-app-guid"
+
+		appSummary := models.AppSummary{}
+		appSummary.Name = "my-app"
+		appSummary.State = "started"
+		appSummary.Memory = 256
+
+		appSummaryRepo := &testapi.FakeAppSummaryRepo{GetSummarySummary: appSummary}
+		appInstancesRepo := &testapi.FakeAppInstancesRepo{GetInstancesResponses: [][]models.AppInstanceFields{{}}}
+		reqFactory := &testreq.FakeReqFactory{LoginSuccess: true, TargetedSpaceSuccess: true, Application: reqApp}
+
+		ui := &testterm.FakeUI{}
+		ui.SetOutputOptions(terminal.OutputOptions{Format: terminal.OutputTemplate, Template: `{{.Name}} is {{.State}} with {{byteSize .Memory "M"}}`})
+		cmd := NewShowApp(ui, testconfig.NewRepositoryWithDefaults(), appSummaryRepo, appInstancesRepo)
+		testcmd.RunCommand(ui, cmd, testcmd.NewContext("app", []string{"my-app"}), reqFactory)
+
+		Expect(ui.Outputs[len(ui.Outputs)-1]).To(Equal("my-app is started with 256M"))
+	})
+
 	It("TestDisplayingStoppedAppSummary", func() {
 
 		testDisplayingAppSummaryWithErrorCode(cf.APP_STOPPED)
@@ -177,7 +244,7 @@ func callApp(args []string, reqFactory *testreq.FakeReqFactory, appSummaryRepo *
 
 	configRepo := testconfig.NewRepositoryWithDefaults()
 	cmd := NewShowApp(ui, configRepo, appSummaryRepo, appInstancesRepo)
-	testcmd.RunCommand(cmd, ctxt, reqFactory)
+	testcmd.RunCommand(ui, cmd, ctxt, reqFactory)
 
 	return
 }
@@ -4,10 +4,10 @@ import (
 	"cf"
 	"cf/api"
 	"cf/configuration"
+	"cf/errors"
 	"cf/models"
 	"cf/requirements"
 	"cf/terminal"
-	"errors"
 	"fmt"
 	"github.com/cloudfoundry/loggregatorlib/logmessage"
 	"github.com/codegangsta/cli"
@@ -37,6 +37,10 @@ type Start struct {
 	StartupTimeout time.Duration
 	StagingTimeout time.Duration
 	PingerThrottle time.Duration
+
+	// invalid timeouts in the environment are reported when starting an app,
+	// so that they don't break unrelated commands
+	timeoutErr error
 }
 
 type ApplicationStarter interface {
@@ -58,7 +62,7 @@ func NewStart(ui terminal.UI, config configuration.Reader, appDisplayer Applicat
 	if os.Getenv("CF_STAGING_TIMEOUT") != "" {
 		duration, err := strconv.ParseInt(os.Getenv("CF_STAGING_TIMEOUT"), 10, 64)
 		if err != nil {
-			cmd.ui.Failed("invalid value for env var CF_STAGING_TIMEOUT\n%s", err)
+			cmd.timeoutErr = errors.New("invalid value for env var CF_STAGING_TIMEOUT\n%s", err)
 		}
 		cmd.StagingTimeout = time.Duration(duration) * time.Minute
 	} else {
@@ -68,7 +72,7 @@ func NewStart(ui terminal.UI, config configuration.Reader, appDisplayer Applicat
 	if os.Getenv("CF_STARTUP_TIMEOUT") != "" {
 		duration, err := strconv.ParseInt(os.Getenv("CF_STARTUP_TIMEOUT"), 10, 64)
 		if err != nil {
-			cmd.ui.Failed("invalid value for env var CF_STARTUP_TIMEOUT\n%s", err)
+			cmd.timeoutErr = errors.New("invalid value for env var CF_STARTUP_TIMEOUT\n%s", err)
 		}
 		cmd.StartupTimeout = time.Duration(duration) * time.Minute
 	} else {
@@ -91,11 +95,17 @@ func (cmd *Start) GetRequirements(reqFactory requirements.Factory, c *cli.Contex
 	return
 }
 
-func (cmd *Start) Run(c *cli.Context) {
-	cmd.ApplicationStart(cmd.appReq.GetApplication())
+func (cmd *Start) Run(c *cli.Context) (err error) {
+	_, err = cmd.ApplicationStart(cmd.appReq.GetApplication())
+	return
 }
 
 func (cmd *Start) ApplicationStart(app models.Application) (updatedApp models.Application, err error) {
+	if cmd.timeoutErr != nil {
+		err = cmd.timeoutErr
+		return
+	}
+
 	if app.State == "started" {
 		cmd.ui.Say(terminal.WarningColor("App " + app.Name + " is already started"))
 		return
@@ -120,21 +130,27 @@ func (cmd *Start) ApplicationStart(app models.Application) (updatedApp models.Ap
 	state := "STARTED"
 	updatedApp, apiResponse := cmd.appRepo.Update(app.Guid, models.AppParams{State: &state})
 	if apiResponse.IsNotSuccessful() {
-		cmd.ui.Failed(apiResponse.Message)
+		err = errors.FromApiResponse(apiResponse)
 		return
 	}
 
 	cmd.ui.Ok()
 
-	cmd.waitForInstancesToStage(updatedApp)
+	err = cmd.waitForInstancesToStage(updatedApp)
 	stopLoggingChan <- true
+	if err != nil {
+		return
+	}
 
 	cmd.ui.Say("")
 
-	cmd.waitForOneRunningInstance(updatedApp)
+	err = cmd.waitForOneRunningInstance(updatedApp)
+	if err != nil {
+		return
+	}
 	cmd.ui.Say(terminal.HeaderColor("\nApp started\n"))
 
-	cmd.appDisplayer.ShowApp(updatedApp)
+	err = cmd.appDisplayer.ShowApp(updatedApp)
 	return
 }
 
@@ -171,37 +187,43 @@ func (cmd Start) displayLogMessages(logChan chan *logmessage.Message) {
 	}
 }
 
-func (cmd Start) waitForInstancesToStage(app models.Application) {
+func (cmd Start) waitForInstancesToStage(app models.Application) (err error) {
 	stagingStartTime := time.Now()
 	_, apiResponse := cmd.appInstancesRepo.GetInstances(app.Guid)
 
 	for apiResponse.IsNotSuccessful() && time.Since(stagingStartTime) < cmd.StagingTimeout {
 		if apiResponse.ErrorCode != cf.APP_NOT_STAGED {
 			cmd.ui.Say("")
-			cmd.ui.Failed(fmt.Sprintf("%s\n\nTIP: use '%s' for more information",
+			err = errors.FromApiResponseWithMessage(apiResponse, "%s\n\nTIP: use '%s' for more information",
 				apiResponse.Message,
-				terminal.CommandColor(fmt.Sprintf("%s logs %s --recent", cf.Name(), app.Name))))
+				terminal.CommandColor(fmt.Sprintf("%s logs %s --recent", cf.Name(), app.Name)))
+			return
+		}
+		if cmd.ui.Wait(cmd.PingerThrottle) {
+			err = interruptedStartError(app)
 			return
 		}
-		cmd.ui.Wait(cmd.PingerThrottle)
 		_, apiResponse = cmd.appInstancesRepo.GetInstances(app.Guid)
 	}
 	return
 }
 
-func (cmd Start) waitForOneRunningInstance(app models.Application) {
+func (cmd Start) waitForOneRunningInstance(app models.Application) (err error) {
 	var runningCount, startingCount, flappingCount, downCount int
 	startupStartTime := time.Now()
 
 	for runningCount == 0 {
 		if time.Since(startupStartTime) > cmd.StartupTimeout {
-			cmd.ui.Failed(fmt.Sprintf("Start app timeout\n\nTIP: use '%s' for more information", terminal.CommandColor(fmt.Sprintf("%s logs %s --recent", cf.Name(), app.Name))))
+			err = errors.New("Start app timeout\n\nTIP: use '%s' for more information", terminal.CommandColor(fmt.Sprintf("%s logs %s --recent", cf.Name(), app.Name)))
 			return
 		}
 
 		instances, apiResponse := cmd.appInstancesRepo.GetInstances(app.Guid)
 		if apiResponse.IsNotSuccessful() {
-			cmd.ui.Wait(cmd.PingerThrottle)
+			if cmd.ui.Wait(cmd.PingerThrottle) {
+				err = interruptedStartError(app)
+				return
+			}
 			continue
 		}
 
@@ -224,10 +246,23 @@ func (cmd Start) waitForOneRunningInstance(app models.Application) {
 		cmd.ui.Say(instancesDetails(startingCount, downCount, runningCount, flappingCount, totalCount))
 
 		if flappingCount > 0 {
-			cmd.ui.Failed(fmt.Sprintf("Start unsuccessful\n\nTIP: use '%s' for more information", terminal.CommandColor(fmt.Sprintf("%s logs %s --recent", cf.Name(), app.Name))))
+			err = errors.New("Start unsuccessful\n\nTIP: use '%s' for more information", terminal.CommandColor(fmt.Sprintf("%s logs %s --recent", cf.Name(), app.Name)))
+			return
+		}
+
+		if runningCount == 0 && cmd.ui.Wait(cmd.PingerThrottle) {
+			err = interruptedStartError(app)
 			return
 		}
 	}
+	return
+}
+
+// interruptedStartError is returned when Ctrl-C stops the wait, which leaves
+// the app starting on the platform
+func interruptedStartError(app models.Application) error {
+	return errors.New("Stopped waiting for app %s to start, which is still in progress\nTIP: use '%s' to check on it",
+		app.Name, terminal.CommandColor(fmt.Sprintf("%s app %s", cf.Name(), app.Name)))
 }
 
 func instancesDetails(startingCount, downCount, runningCount, flappingCount, totalCount int) string {
@@ -372,7 +372,7 @@ func callStart(args []string, config configuration.Reader, reqFactory *testreq.F
 	cmd.StartupTimeout = 50 * time.Millisecond
 	cmd.PingerThrottle = 50 * time.Millisecond
 
-	testcmd.RunCommand(cmd, ctxt, reqFactory)
+	testcmd.RunCommand(ui, cmd, ctxt, reqFactory)
 	return
 }
 
@@ -3,10 +3,10 @@ package application
 import (
 	"cf/api"
 	"cf/configuration"
+	"cf/errors"
 	"cf/models"
 	"cf/requirements"
 	"cf/terminal"
-	"errors"
 	"github.com/codegangsta/cli"
 )
 
@@ -61,7 +61,7 @@ func (cmd *Stop) ApplicationStop(app models.Application) (updatedApp models.Appl
 	updatedApp, apiResponse := cmd.appRepo.Update(app.Guid, models.AppParams{State: &state})
 	if apiResponse.IsNotSuccessful() {
 		err = errors.New(apiResponse.Message)
-		cmd.ui.Failed(apiResponse.Message)
+		err = errors.FromApiResponse(apiResponse)
 		return
 	}
 
@@ -69,7 +69,8 @@ func (cmd *Stop) ApplicationStop(app models.Application) (updatedApp models.Appl
 	return
 }
 
-func (cmd *Stop) Run(c *cli.Context) {
+func (cmd *Stop) Run(c *cli.Context) (err error) {
 	app := cmd.appReq.GetApplication()
 	cmd.ApplicationStop(app)
+	return
 }
@@ -120,6 +120,6 @@ func callStop(args []string, reqFactory *testreq.FakeReqFactory, appRepo api.App
 
 	configRepo := testconfig.NewRepositoryWithDefaults()
 	cmd := NewStop(ui, configRepo, appRepo)
-	testcmd.RunCommand(cmd, ctxt, reqFactory)
+	testcmd.RunCommand(ui, cmd, ctxt, reqFactory)
 	return
 }
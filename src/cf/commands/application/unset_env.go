@@ -4,10 +4,10 @@ import (
 	"cf"
 	"cf/api"
 	"cf/configuration"
+	"cf/errors"
 	"cf/models"
 	"cf/requirements"
 	"cf/terminal"
-	"errors"
 	"github.com/codegangsta/cli"
 )
 
@@ -42,7 +42,7 @@ func (cmd *UnsetEnv) GetRequirements(reqFactory requirements.Factory, c *cli.Con
 	return
 }
 
-func (cmd *UnsetEnv) Run(c *cli.Context) {
+func (cmd *UnsetEnv) Run(c *cli.Context) (err error) {
 	varName := c.Args()[1]
 	app := cmd.appReq.GetApplication()
 
@@ -66,10 +66,11 @@ func (cmd *UnsetEnv) Run(c *cli.Context) {
 
 	_, apiResponse := cmd.appRepo.Update(app.Guid, models.AppParams{EnvironmentVars: &envParams})
 	if apiResponse.IsNotSuccessful() {
-		cmd.ui.Failed(apiResponse.Message)
+		err = errors.FromApiResponse(apiResponse)
 		return
 	}
 
 	cmd.ui.Ok()
 	cmd.ui.Say("TIP: Use '%s' to ensure your env variable changes take effect", terminal.CommandColor(cf.Name()+" push"))
+	return
 }
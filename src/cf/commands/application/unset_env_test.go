@@ -124,6 +124,6 @@ func callUnsetEnv(args []string, reqFactory *testreq.FakeReqFactory, appRepo api
 	ctxt := testcmd.NewContext("unset-env", args)
 	configRepo := testconfig.NewRepositoryWithDefaults()
 	cmd := NewUnsetEnv(ui, configRepo, appRepo)
-	testcmd.RunCommand(cmd, ctxt, reqFactory)
+	testcmd.RunCommand(ui, cmd, ctxt, reqFactory)
 	return
 }
@@ -0,0 +1,60 @@
+package commands
+
+import (
+	"cf/errors"
+	"unicode"
+)
+
+// SplitArguments splits a command line into arguments like a POSIX shell
+// does, honouring single and double quotes and backslash escapes. Nothing
+// else is interpreted.
+func SplitArguments(line string) (args []string, err error) {
+	var (
+		current  []rune
+		inWord   bool
+		quote    rune
+		escaping bool
+	)
+
+	for _, char := range line {
+		switch {
+		case escaping:
+			current = append(current, char)
+			escaping = false
+		case char == '\\' && quote != '\'':
+			escaping = true
+			inWord = true
+		case quote != 0:
+			if char == quote {
+				quote = 0
+			} else {
+				current = append(current, char)
+			}
+		case char == '\'' || char == '"':
+			quote = char
+			inWord = true
+		case unicode.IsSpace(char):
+			if inWord {
+				args = append(args, string(current))
+				current = current[:0]
+				inWord = false
+			}
+		default:
+			current = append(current, char)
+			inWord = true
+		}
+	}
+
+	if quote != 0 {
+		err = errors.New("Unterminated quote in: %s", line)
+		return
+	}
+	if escaping {
+		err = errors.New("Unterminated escape in: %s", line)
+		return
+	}
+	if inWord {
+		args = append(args, string(current))
+	}
+	return
+}
@@ -0,0 +1,32 @@
+package commands_test
+
+import (
+	. "cf/commands"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("splitting command lines", func() {
+	It("splits on whitespace", func() {
+		args, err := SplitArguments("  push  my-app\t-i 2 ")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(args).To(Equal([]string{"push", "my-app", "-i", "2"}))
+	})
+
+	It("honours quotes and escapes", func() {
+		args, err := SplitArguments(`set-env my-app GREETING "hello world" 'it''s' a\ b ""`)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(args).To(Equal([]string{"set-env", "my-app", "GREETING", "hello world", "its", "a b", ""}))
+	})
+
+	It("keeps backslashes inside single quotes", func() {
+		args, err := SplitArguments(`curl '/v2/a\b'`)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(args).To(Equal([]string{"curl", `/v2/a\b`}))
+	})
+
+	It("fails on unterminated quotes", func() {
+		_, err := SplitArguments(`set-env my-app GREETING "hello`)
+		Expect(err).To(HaveOccurred())
+	})
+})
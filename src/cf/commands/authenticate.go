@@ -4,10 +4,10 @@ import (
 	"cf"
 	"cf/api"
 	"cf/configuration"
+	"cf/errors"
 	"cf/net"
 	"cf/requirements"
 	"cf/terminal"
-	"errors"
 	"github.com/codegangsta/cli"
 )
 
@@ -33,28 +33,23 @@ func (cmd Authenticate) GetRequirements(reqFactory requirements.Factory, c *cli.
 	return
 }
 
-func (cmd Authenticate) Run(c *cli.Context) {
+func (cmd Authenticate) Run(c *cli.Context) (err error) {
 	cmd.ui.Say("API endpoint: %s", terminal.EntityNameColor(cmd.config.ApiEndpoint()))
 
-	username := c.Args()[0]
-	password := c.Args()[1]
-
 	cmd.ui.Say("Authenticating...")
 
-	apiResponse := cmd.doLogin(username, password)
+	var apiResponse net.ApiResponse
+	if c.Bool("client-credentials") {
+		apiResponse = cmd.authenticator.AuthenticateClient(c.Args()[0], c.Args()[1])
+	} else {
+		apiResponse = cmd.authenticator.Authenticate(c.Args()[0], c.Args()[1])
+	}
 	if apiResponse.IsNotSuccessful() {
-		cmd.ui.Failed(apiResponse.Message)
+		err = errors.FromApiResponse(apiResponse)
 		return
 	}
 
-	return
-}
-
-func (cmd Authenticate) doLogin(username, password string) (apiResponse net.ApiResponse) {
-	apiResponse = cmd.authenticator.Authenticate(username, password)
-	if apiResponse.IsSuccessful() {
-		cmd.ui.Ok()
-		cmd.ui.Say("Use '%s' to view or set your target org and space", terminal.CommandColor(cf.Name()+" target"))
-	}
+	cmd.ui.Ok()
+	cmd.ui.Say("Use '%s' to view or set your target org and space", terminal.CommandColor(cf.Name()+" target"))
 	return
 }
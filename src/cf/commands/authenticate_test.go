@@ -38,6 +38,26 @@ var _ = Describe("Testing with ginkgo", func() {
 		testSuccessfulAuthenticate([]string{"user@example.com", "password"})
 	})
 
+	It("authenticates with client credentials when --client-credentials is given", func() {
+		config := testconfig.NewRepository()
+		auth := &testapi.FakeAuthenticationRepository{
+			AccessToken: "my_access_token",
+			Config:      config,
+		}
+
+		ui := callAuthenticate([]string{"--client-credentials", "my-client", "my-secret"}, config, auth)
+
+		testassert.SliceContains(ui.Outputs, testassert.Lines{
+			{"Authenticating..."},
+			{"OK"},
+		})
+		Expect(auth.ClientId).To(Equal("my-client"))
+		Expect(auth.ClientSecret).To(Equal("my-secret"))
+		Expect(auth.Email).To(BeEmpty())
+		Expect(config.AccessToken()).To(Equal("my_access_token"))
+		Expect(config.ClientId()).To(Equal("my-client"))
+	})
+
 	It("TestUnsuccessfullyAuthenticatingWithoutInteractivity", func() {
 		config := testconfig.NewRepository()
 
@@ -89,6 +109,6 @@ func callAuthenticate(args []string, config configuration.Reader, auth api.Authe
 	ui = new(testterm.FakeUI)
 	ctxt := testcmd.NewContext("auth", args)
 	cmd := NewAuthenticate(ui, config, auth)
-	testcmd.RunCommand(cmd, ctxt, &testreq.FakeReqFactory{})
+	testcmd.RunCommand(ui, cmd, ctxt, &testreq.FakeReqFactory{})
 	return
 }
@@ -3,6 +3,7 @@ package buildpack
 import (
 	"cf"
 	"cf/api"
+	"cf/errors"
 	"cf/models"
 	"cf/net"
 	"cf/requirements"
@@ -31,8 +32,9 @@ func (cmd CreateBuildpack) GetRequirements(reqFactory requirements.Factory, c *c
 	return
 }
 
-func (cmd CreateBuildpack) Run(c *cli.Context) {
+func (cmd CreateBuildpack) Run(c *cli.Context) (err error) {
 	if len(c.Args()) != 3 {
+		err = errors.NewUsageError("Incorrect Usage")
 		cmd.ui.FailWithUsage(c, "create-buildpack")
 		return
 	}
@@ -48,7 +50,7 @@ func (cmd CreateBuildpack) Run(c *cli.Context) {
 			cmd.ui.Warn("Buildpack %s already exists", buildpackName)
 			cmd.ui.Say("TIP: use '%s' to update this buildpack", terminal.CommandColor(cf.Name()+" update-buildpack"))
 		} else {
-			cmd.ui.Failed(apiResponse.Message)
+			err = errors.FromApiResponse(apiResponse)
 		}
 		return
 	}
@@ -61,11 +63,12 @@ func (cmd CreateBuildpack) Run(c *cli.Context) {
 
 	apiResponse = cmd.buildpackBitsRepo.UploadBuildpack(buildpack, dir)
 	if apiResponse.IsNotSuccessful() {
-		cmd.ui.Failed(apiResponse.Message)
+		err = errors.FromApiResponse(apiResponse)
 		return
 	}
 
 	cmd.ui.Ok()
+	return
 }
 
 func (cmd CreateBuildpack) createBuildpack(buildpackName string, c *cli.Context) (buildpack models.Buildpack, apiResponse net.ApiResponse) {
@@ -22,7 +22,7 @@ func callCreateBuildpack(args []string, reqFactory *testreq.FakeReqFactory, fake
 	ctxt := testcmd.NewContext("create-buildpack", args)
 
 	cmd := NewCreateBuildpack(ui, fakeRepo, fakeBitsRepo)
-	testcmd.RunCommand(cmd, ctxt, reqFactory)
+	testcmd.RunCommand(ui, cmd, ctxt, reqFactory)
 	return
 }
 
@@ -2,9 +2,9 @@ package buildpack
 
 import (
 	"cf/api"
+	"cf/errors"
 	"cf/requirements"
 	"cf/terminal"
-	"errors"
 	"github.com/codegangsta/cli"
 )
 
@@ -36,11 +36,16 @@ func (cmd *DeleteBuildpack) GetRequirements(reqFactory requirements.Factory, c *
 	return
 }
 
-func (cmd *DeleteBuildpack) Run(c *cli.Context) {
+func (cmd *DeleteBuildpack) Run(c *cli.Context) (err error) {
 	buildpackName := c.Args()[0]
 
 	force := c.Bool("f")
 
+	if !force && !cmd.ui.IsInteractive() {
+		err = errors.NewMissingInputError("confirmation", "-f")
+		return
+	}
+
 	if !force {
 		answer := cmd.ui.Confirm("Are you sure you want to delete the buildpack %s ?", terminal.EntityNameColor(buildpackName))
 		if !answer {
@@ -59,15 +64,16 @@ func (cmd *DeleteBuildpack) Run(c *cli.Context) {
 	}
 
 	if apiResponse.IsError() {
-		cmd.ui.Failed(apiResponse.Message)
+		err = errors.FromApiResponse(apiResponse)
 		return
 	}
 
 	apiResponse = cmd.buildpackRepo.Delete(buildpack.Guid)
 	if apiResponse.IsNotSuccessful() {
-		cmd.ui.Failed("Error deleting buildpack %s\n%s", terminal.EntityNameColor(buildpack.Name), apiResponse.Message)
+		err = errors.FromApiResponseWithMessage(apiResponse, "Error deleting buildpack %s\n%s", terminal.EntityNameColor(buildpack.Name), apiResponse.Message)
 		return
 	}
 
 	cmd.ui.Ok()
+	return
 }
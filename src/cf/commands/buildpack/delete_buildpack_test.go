@@ -23,12 +23,12 @@ var _ = Describe("Testing with ginkgo", func() {
 		ctxt := testcmd.NewContext("delete-buildpack", []string{"my-buildpack"})
 
 		reqFactory := &testreq.FakeReqFactory{LoginSuccess: true}
-		testcmd.RunCommand(cmd, ctxt, reqFactory)
+		testcmd.RunCommand(ui, cmd, ctxt, reqFactory)
 
 		Expect(testcmd.CommandDidPassRequirements).To(BeTrue())
 
 		reqFactory = &testreq.FakeReqFactory{LoginSuccess: false}
-		testcmd.RunCommand(cmd, ctxt, reqFactory)
+		testcmd.RunCommand(ui, cmd, ctxt, reqFactory)
 
 		Expect(testcmd.CommandDidPassRequirements).To(BeFalse())
 	})
@@ -46,7 +46,7 @@ var _ = Describe("Testing with ginkgo", func() {
 		ctxt := testcmd.NewContext("delete-buildpack", []string{"my-buildpack"})
 		reqFactory := &testreq.FakeReqFactory{LoginSuccess: true}
 
-		testcmd.RunCommand(cmd, ctxt, reqFactory)
+		testcmd.RunCommand(ui, cmd, ctxt, reqFactory)
 
 		Expect(buildpackRepo.DeleteBuildpackGuid).To(Equal("my-buildpack-guid"))
 
@@ -72,7 +72,7 @@ var _ = Describe("Testing with ginkgo", func() {
 		ctxt := testcmd.NewContext("delete-buildpack", []string{"my-buildpack"})
 		reqFactory := &testreq.FakeReqFactory{LoginSuccess: true}
 
-		testcmd.RunCommand(cmd, ctxt, reqFactory)
+		testcmd.RunCommand(ui, cmd, ctxt, reqFactory)
 
 		Expect(buildpackRepo.DeleteBuildpackGuid).To(Equal(""))
 
@@ -95,7 +95,7 @@ var _ = Describe("Testing with ginkgo", func() {
 		ctxt := testcmd.NewContext("delete-buildpack", []string{"-f", "my-buildpack"})
 
 		cmd := NewDeleteBuildpack(ui, buildpackRepo)
-		testcmd.RunCommand(cmd, ctxt, reqFactory)
+		testcmd.RunCommand(ui, cmd, ctxt, reqFactory)
 
 		Expect(buildpackRepo.FindByNameName).To(Equal("my-buildpack"))
 		Expect(buildpackRepo.FindByNameNotFound).To(BeTrue())
@@ -122,7 +122,7 @@ var _ = Describe("Testing with ginkgo", func() {
 		ctxt := testcmd.NewContext("delete-buildpack", []string{"my-buildpack"})
 		reqFactory := &testreq.FakeReqFactory{LoginSuccess: true}
 
-		testcmd.RunCommand(cmd, ctxt, reqFactory)
+		testcmd.RunCommand(ui, cmd, ctxt, reqFactory)
 
 		Expect(buildpackRepo.DeleteBuildpackGuid).To(Equal("my-buildpack-guid"))
 
@@ -148,7 +148,7 @@ var _ = Describe("Testing with ginkgo", func() {
 		ctxt := testcmd.NewContext("delete-buildpack", []string{"-f", "my-buildpack"})
 		reqFactory := &testreq.FakeReqFactory{LoginSuccess: true}
 
-		testcmd.RunCommand(cmd, ctxt, reqFactory)
+		testcmd.RunCommand(ui, cmd, ctxt, reqFactory)
 
 		Expect(buildpackRepo.DeleteBuildpackGuid).To(Equal("my-buildpack-guid"))
 
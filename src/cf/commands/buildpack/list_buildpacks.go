@@ -2,6 +2,7 @@ package buildpack
 
 import (
 	"cf/api"
+	"cf/errors"
 	"cf/models"
 	"cf/requirements"
 	"cf/terminal"
@@ -9,6 +10,17 @@ import (
 	"strconv"
 )
 
+// buildpackOutput is what structured output prints for a buildpack, where
+// settings the server did not report are null
+type buildpackOutput struct {
+	Guid     string `json:"guid"`
+	Name     string `json:"name"`
+	Position *int   `json:"position"`
+	Enabled  *bool  `json:"enabled"`
+	Locked   *bool  `json:"locked"`
+	Filename string `json:"filename"`
+}
+
 type ListBuildpacks struct {
 	ui            terminal.UI
 	buildpackRepo api.BuildpackRepository
@@ -27,7 +39,7 @@ func (cmd ListBuildpacks) GetRequirements(reqFactory requirements.Factory, c *cl
 	return
 }
 
-func (cmd ListBuildpacks) Run(c *cli.Context) {
+func (cmd ListBuildpacks) Run(c *cli.Context) (err error) {
 	cmd.ui.Say("Getting buildpacks...\n")
 
 	table := cmd.ui.Table([]string{"buildpack", "position", "enabled", "locked", "filename"})
@@ -46,23 +58,37 @@ func (cmd ListBuildpacks) Run(c *cli.Context) {
 		if buildpack.Locked != nil {
 			locked = strconv.FormatBool(*buildpack.Locked)
 		}
-		table.Print([][]string{{
+		output := buildpackOutput{
+			Guid:     buildpack.Guid,
+			Name:     buildpack.Name,
+			Position: buildpack.Position,
+			Enabled:  buildpack.Enabled,
+			Locked:   buildpack.Locked,
+			Filename: buildpack.Filename,
+		}
+		table.Add(output,
 			buildpack.Name,
 			position,
 			enabled,
 			locked,
 			buildpack.Filename,
-		}})
+		)
 		noBuildpacks = false
 		return true
 	})
 
 	if apiResponse.IsNotSuccessful() {
-		cmd.ui.Failed("Failed fetching buildpacks.\n%s", apiResponse.Message)
+		err = errors.FromApiResponseWithMessage(apiResponse, "Failed fetching buildpacks.\n%s", apiResponse.Message)
+		return
+	}
+
+	err = table.Print()
+	if err != nil {
 		return
 	}
 
 	if noBuildpacks {
 		cmd.ui.Say("No buildpacks found")
 	}
+	return
 }
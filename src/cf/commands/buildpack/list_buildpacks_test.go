@@ -16,7 +16,7 @@ func callListBuildpacks(reqFactory *testreq.FakeReqFactory, buildpackRepo *testa
 	ui = &testterm.FakeUI{}
 	ctxt := testcmd.NewContext("buildpacks", []string{})
 	cmd := buildpack.NewListBuildpacks(ui, buildpackRepo)
-	testcmd.RunCommand(cmd, ctxt, reqFactory)
+	testcmd.RunCommand(ui, cmd, ctxt, reqFactory)
 	return
 }
 
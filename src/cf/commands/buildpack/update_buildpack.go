@@ -2,9 +2,9 @@ package buildpack
 
 import (
 	"cf/api"
+	"cf/errors"
 	"cf/requirements"
 	"cf/terminal"
-	"errors"
 	"github.com/codegangsta/cli"
 )
 
@@ -41,7 +41,7 @@ func (cmd *UpdateBuildpack) GetRequirements(reqFactory requirements.Factory, c *
 	return
 }
 
-func (cmd *UpdateBuildpack) Run(c *cli.Context) {
+func (cmd *UpdateBuildpack) Run(c *cli.Context) (err error) {
 	buildpack := cmd.buildpackReq.GetBuildpack()
 
 	cmd.ui.Say("Updating buildpack %s...", terminal.EntityNameColor(buildpack.Name))
@@ -58,7 +58,7 @@ func (cmd *UpdateBuildpack) Run(c *cli.Context) {
 	enabled := c.Bool("enable")
 	disabled := c.Bool("disable")
 	if enabled && disabled {
-		cmd.ui.Failed("Cannot specify both enabled and disabled options.")
+		err = errors.New("Cannot specify both enabled and disabled options.")
 		return
 	}
 
@@ -75,13 +75,14 @@ func (cmd *UpdateBuildpack) Run(c *cli.Context) {
 	lock := c.Bool("lock")
 	unlock := c.Bool("unlock")
 	if lock && unlock {
-		cmd.ui.Failed("Cannot specify both lock and unlock options.")
+		err = errors.New("Cannot specify both lock and unlock options.")
 		return
 	}
 
 	dir := c.String("p")
 	if dir != "" && (lock || unlock) {
-		cmd.ui.Failed("Cannot specify buildpack bits and lock/unlock.")
+		err = errors.New("Cannot specify buildpack bits and lock/unlock.")
+		return
 	}
 
 	if lock {
@@ -97,7 +98,7 @@ func (cmd *UpdateBuildpack) Run(c *cli.Context) {
 	if updateBuildpack {
 		buildpack, apiResponse := cmd.buildpackRepo.Update(buildpack)
 		if apiResponse.IsNotSuccessful() {
-			cmd.ui.Failed("Error updating buildpack %s\n%s", terminal.EntityNameColor(buildpack.Name), apiResponse.Message)
+			err = errors.FromApiResponseWithMessage(apiResponse, "Error updating buildpack %s\n%s", terminal.EntityNameColor(buildpack.Name), apiResponse.Message)
 			return
 		}
 	}
@@ -105,9 +106,10 @@ func (cmd *UpdateBuildpack) Run(c *cli.Context) {
 	if dir != "" {
 		apiResponse := cmd.buildpackBitsRepo.UploadBuildpack(buildpack, dir)
 		if apiResponse.IsNotSuccessful() {
-			cmd.ui.Failed("Error uploading buildpack %s\n%s", terminal.EntityNameColor(buildpack.Name), apiResponse.Message)
+			err = errors.FromApiResponseWithMessage(apiResponse, "Error uploading buildpack %s\n%s", terminal.EntityNameColor(buildpack.Name), apiResponse.Message)
 			return
 		}
 	}
 	cmd.ui.Ok()
+	return
 }
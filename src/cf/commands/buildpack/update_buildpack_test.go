@@ -17,7 +17,7 @@ func callUpdateBuildpack(args []string, reqFactory *testreq.FakeReqFactory, fake
 	ctxt := testcmd.NewContext("update-buildpack", args)
 
 	cmd := NewUpdateBuildpack(ui, fakeRepo, fakeBitsRepo)
-	testcmd.RunCommand(cmd, ctxt, reqFactory)
+	testcmd.RunCommand(ui, cmd, ctxt, reqFactory)
 	return
 }
 
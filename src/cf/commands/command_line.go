@@ -0,0 +1,143 @@
+package commands
+
+import (
+	"cf"
+	"cf/configuration"
+	"cf/errors"
+	"cf/terminal"
+	"flag"
+	"fmt"
+	"github.com/codegangsta/cli"
+	"io/ioutil"
+	"strings"
+)
+
+// commandLineRunner runs command lines typed into the shell or read from a
+// script within the current process. Failures are reported as they happen,
+// and returned so that the caller can tally them.
+type commandLineRunner struct {
+	ui       terminal.UI
+	config   configuration.Reader
+	runner   Runner
+	context  *cli.Context
+	source   string
+	excluded map[string]string
+}
+
+func newCommandLineRunner(ui terminal.UI, config configuration.Reader, runner Runner, c *cli.Context, source string, excluded map[string]string) *commandLineRunner {
+	return &commandLineRunner{
+		ui:       ui,
+		config:   config,
+		runner:   runner,
+		context:  c,
+		source:   source,
+		excluded: excluded,
+	}
+}
+
+// CommandName returns the full name of the command a line runs, after alias
+// expansion, so that short names are recognised too
+func (r *commandLineRunner) CommandName(text string) string {
+	args, err := r.arguments(text)
+	if err != nil || len(args) == 0 {
+		return ""
+	}
+	return r.fullName(args[0])
+}
+
+func (r *commandLineRunner) fullName(name string) string {
+	if cliCommand := r.context.App.Command(name); cliCommand != nil {
+		return cliCommand.Name
+	}
+	return name
+}
+
+func (r *commandLineRunner) Run(text string) (err error) {
+	args, err := r.arguments(text)
+	if err != nil {
+		r.ui.Failed(err.Error())
+		return
+	}
+
+	name := args[0]
+	if reason, found := r.excluded[r.fullName(name)]; found {
+		err = errors.NewUsageError(reason)
+		r.ui.Failed(err.Error())
+		return
+	}
+
+	c := r.context
+	globalSet := flag.NewFlagSet("global", flag.ContinueOnError)
+	for _, globalFlag := range c.App.Flags {
+		globalFlag.Apply(globalSet)
+	}
+	globalSet.Set("output", c.GlobalString("output"))
+	globalSet.Set("non-interactive", fmt.Sprintf("%t", c.GlobalBool("non-interactive")))
+
+	cliCommand := c.App.Command(name)
+	if cliCommand == nil {
+		// plugins and unknown commands get their name as first argument,
+		// like they do on the command line
+		flagSet := flag.NewFlagSet(name, flag.ContinueOnError)
+		flagSet.Parse(args)
+		return r.runner.RunCmdByName(name, cli.NewContext(c.App, flagSet, globalSet))
+	}
+
+	flagSet := flag.NewFlagSet(cliCommand.Name, flag.ContinueOnError)
+	flagSet.SetOutput(ioutil.Discard)
+	for _, commandFlag := range cliCommand.Flags {
+		commandFlag.Apply(flagSet)
+	}
+
+	err = flagSet.Parse(flagsFirst(args[1:]))
+	if err != nil {
+		err = errors.NewUsageError("Incorrect Usage: %s", err.Error())
+		r.ui.Failed(err.Error())
+		return
+	}
+
+	ctxt := cli.NewContext(c.App, flagSet, globalSet)
+	if cliCommand.Name == "help" {
+		cliCommand.Action(ctxt)
+		return
+	}
+	return r.runner.RunCmdByName(cliCommand.Name, ctxt)
+}
+
+// arguments splits a line, drops a leading cf and expands aliases
+func (r *commandLineRunner) arguments(text string) (args []string, err error) {
+	args, err = SplitArguments(text)
+	if err != nil {
+		return
+	}
+
+	if len(args) > 0 && (args[0] == "cf" || args[0] == cf.Name()) {
+		args = args[1:]
+	}
+	if len(args) == 0 {
+		err = errors.NewUsageError("No command given")
+		return
+	}
+
+	name := args[0]
+	if strings.HasPrefix(name, "-") {
+		err = errors.NewUsageError("Global options are not supported in %s", r.source)
+		return
+	}
+
+	if expansion, found := r.config.Aliases()[name]; found && r.context.App.Command(name) == nil {
+		args, err = ExpandAlias(name, expansion, args[1:])
+	}
+	return
+}
+
+// flagsFirst moves the flags ahead of the arguments preceding them, as the
+// flag package stops at the first argument
+func flagsFirst(args []string) []string {
+	for index, arg := range args {
+		if strings.HasPrefix(arg, "-") {
+			return append(append([]string{}, args[index:]...), args[:index]...)
+		}
+	}
+	return args
+}
@@ -0,0 +1,153 @@
+package commands
+
+import (
+	"cf/api"
+	"cf/configuration"
+	"cf/models"
+	"cf/net"
+	"cf/requirements"
+	"cf/terminal"
+	"encoding/json"
+	"github.com/codegangsta/cli"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// CompleteNamesCommandName is the hidden command the completion scripts run
+// to complete the names of apps, services, orgs and spaces
+const CompleteNamesCommandName = hiddenCommandPrefix + "complete-names"
+
+// names are cached briefly, as the scripts ask for them on every tab press
+const completionCacheTTL = 30 * time.Second
+
+type CompleteNames struct {
+	ui                 terminal.UI
+	config             configuration.Reader
+	appSummaryRepo     api.AppSummaryRepository
+	serviceSummaryRepo api.ServiceSummaryRepository
+	orgRepo            api.OrganizationRepository
+	spaceRepo          api.SpaceRepository
+	cachePath          string
+}
+
+type completionCacheEntry struct {
+	Names   []string
+	Expires time.Time
+}
+
+func NewCompleteNames(ui terminal.UI, config configuration.Reader, appSummaryRepo api.AppSummaryRepository, serviceSummaryRepo api.ServiceSummaryRepository, orgRepo api.OrganizationRepository, spaceRepo api.SpaceRepository, cachePath string) (cmd CompleteNames) {
+	cmd.ui = ui
+	cmd.config = config
+	cmd.appSummaryRepo = appSummaryRepo
+	cmd.serviceSummaryRepo = serviceSummaryRepo
+	cmd.orgRepo = orgRepo
+	cmd.spaceRepo = spaceRepo
+	cmd.cachePath = cachePath
+	return
+}
+
+func (cmd CompleteNames) GetRequirements(reqFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
+	return
+}
+
+// Run prints the names one per line. Failures are not reported, as anything
+// printed would be offered as a completion.
+func (cmd CompleteNames) Run(c *cli.Context) (err error) {
+	if len(c.Args()) != 1 || !cmd.config.IsLoggedIn() {
+		return
+	}
+
+	kind := c.Args()[0]
+	key := strings.Join([]string{
+		kind,
+		cmd.config.ApiEndpoint(),
+		cmd.config.Username(),
+		cmd.config.OrganizationFields().Guid,
+		cmd.config.SpaceFields().Guid,
+	}, " ")
+
+	cache := cmd.loadCache()
+	entry, found := cache[key]
+	if !found || time.Now().After(entry.Expires) {
+		names, apiResponse := cmd.fetchNames(kind)
+		if apiResponse.IsNotSuccessful() {
+			return
+		}
+
+		entry = completionCacheEntry{Names: names, Expires: time.Now().Add(completionCacheTTL)}
+		cache[key] = entry
+		cmd.saveCache(cache)
+	}
+
+	for _, name := range entry.Names {
+		cmd.ui.Say("%s", name)
+	}
+	return
+}
+
+func (cmd CompleteNames) fetchNames(kind string) (names []string, apiResponse net.ApiResponse) {
+	switch {
+	case kind == "apps" && cmd.config.HasSpace():
+		var apps []models.AppSummary
+		apps, apiResponse = cmd.appSummaryRepo.GetSummariesInCurrentSpace()
+		for _, app := range apps {
+			names = append(names, app.Name)
+		}
+	case kind == "services" && cmd.config.HasSpace():
+		var instances []models.ServiceInstance
+		instances, apiResponse = cmd.serviceSummaryRepo.GetSummariesInCurrentSpace()
+		for _, instance := range instances {
+			names = append(names, instance.Name)
+		}
+	case kind == "orgs":
+		apiResponse = cmd.orgRepo.ListOrgs(func(org models.Organization) bool {
+			names = append(names, org.Name)
+			return true
+		})
+	case kind == "spaces" && cmd.config.HasOrganization():
+		apiResponse = cmd.spaceRepo.ListSpaces(func(space models.Space) bool {
+			names = append(names, space.Name)
+			return true
+		})
+	}
+	return
+}
+
+func (cmd CompleteNames) loadCache() (cache map[string]completionCacheEntry) {
+	cache = map[string]completionCacheEntry{}
+
+	data, err := ioutil.ReadFile(cmd.cachePath)
+	if err != nil {
+		return
+	}
+
+	err = json.Unmarshal(data, &cache)
+	if err != nil {
+		cache = map[string]completionCacheEntry{}
+		return
+	}
+
+	for key, entry := range cache {
+		if time.Now().After(entry.Expires) {
+			delete(cache, key)
+		}
+	}
+	return
+}
+
+func (cmd CompleteNames) saveCache(cache map[string]completionCacheEntry) {
+	data, err := json.Marshal(cache)
+	if err != nil {
+		return
+	}
+
+	err = os.MkdirAll(filepath.Dir(cmd.cachePath), 0700)
+	if err != nil {
+		return
+	}
+
+	ioutil.WriteFile(cmd.cachePath, data, 0600)
+}
@@ -0,0 +1,82 @@
+package commands_test
+
+import (
+	. "cf/commands"
+	"cf/configuration"
+	"cf/models"
+	"fileutils"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"path/filepath"
+	testapi "testhelpers/api"
+	testcmd "testhelpers/commands"
+	testconfig "testhelpers/configuration"
+	testreq "testhelpers/requirements"
+	testterm "testhelpers/terminal"
+)
+
+var _ = Describe("complete-names command", func() {
+	var (
+		config      configuration.Repository
+		appRepo     *testapi.FakeAppSummaryRepo
+		serviceRepo *testapi.FakeServiceSummaryRepo
+		orgRepo     *testapi.FakeOrgRepository
+		spaceRepo   *testapi.FakeSpaceRepository
+	)
+
+	BeforeEach(func() {
+		config = testconfig.NewRepositoryWithDefaults()
+		appRepo = &testapi.FakeAppSummaryRepo{}
+		serviceRepo = &testapi.FakeServiceSummaryRepo{}
+		orgRepo = &testapi.FakeOrgRepository{}
+		spaceRepo = &testapi.FakeSpaceRepository{}
+
+		app := models.AppSummary{}
+		app.Name = "my-app"
+		appRepo.GetSummariesInCurrentSpaceApps = []models.AppSummary{app}
+
+		org := models.Organization{}
+		org.Name = "my-org"
+		orgRepo.Organizations = []models.Organization{org}
+	})
+
+	runCompleteNames := func(cachePath string, args ...string) (ui *testterm.FakeUI) {
+		ui = &testterm.FakeUI{}
+		ctxt := testcmd.NewContext(CompleteNamesCommandName, args)
+		cmd := NewCompleteNames(ui, config, appRepo, serviceRepo, orgRepo, spaceRepo, cachePath)
+		testcmd.RunCommand(ui, cmd, ctxt, &testreq.FakeReqFactory{})
+		return
+	}
+
+	It("prints the names of the given kind", func() {
+		fileutils.TempDir("complete_names", func(dir string, err error) {
+			Expect(err).NotTo(HaveOccurred())
+			cachePath := filepath.Join(dir, "cache.json")
+
+			Expect(runCompleteNames(cachePath, "apps").Outputs).To(Equal([]string{"my-app"}))
+			Expect(runCompleteNames(cachePath, "orgs").Outputs).To(Equal([]string{"my-org"}))
+		})
+	})
+
+	It("uses the cached names until they expire", func() {
+		fileutils.TempDir("complete_names", func(dir string, err error) {
+			Expect(err).NotTo(HaveOccurred())
+			cachePath := filepath.Join(dir, "cache.json")
+
+			runCompleteNames(cachePath, "apps")
+			appRepo.GetSummariesInCurrentSpaceApps = []models.AppSummary{}
+
+			Expect(runCompleteNames(cachePath, "apps").Outputs).To(Equal([]string{"my-app"}))
+		})
+	})
+
+	It("prints nothing when not logged in", func() {
+		fileutils.TempDir("complete_names", func(dir string, err error) {
+			Expect(err).NotTo(HaveOccurred())
+			config.SetAccessToken("")
+
+			ui := runCompleteNames(filepath.Join(dir, "cache.json"), "apps")
+			Expect(ui.Outputs).To(BeEmpty())
+		})
+	})
+})
@@ -0,0 +1,403 @@
+package commands
+
+import (
+	"bytes"
+	"cf"
+	"cf/errors"
+	"cf/requirements"
+	"cf/terminal"
+	"fmt"
+	"github.com/codegangsta/cli"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// commands named like this are used by the completion scripts and are left
+// out of them
+const hiddenCommandPrefix = "__"
+
+// names of the arguments in command usages that can be completed with the
+// names of existing entities, see CompleteNames
+var completedArgumentKinds = map[string]string{
+	"APP":              "apps",
+	"SERVICE_INSTANCE": "services",
+	"ORG":              "orgs",
+	"SPACE":            "spaces",
+}
+
+var completionShells = []string{"bash", "zsh", "fish"}
+
+var flagNamePattern = regexp.MustCompile(`^-{1,2}[\w-]+`)
+var usageFlagPattern = regexp.MustCompile(`[\s\[](-{1,2}[\w-]+) ([A-Z_]+)`)
+var nonIdentifierPattern = regexp.MustCompile(`[^A-Za-z0-9_]`)
+
+type Completion struct {
+	ui terminal.UI
+}
+
+type completionCommand struct {
+	Names       []string
+	Description string
+	Flags       []string
+	ValueFlags  []string
+	ArgKinds    []string
+	FlagKinds   map[string]string
+}
+
+func NewCompletion(ui terminal.UI) (cmd Completion) {
+	cmd.ui = ui
+	return
+}
+
+func (cmd Completion) GetRequirements(reqFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
+	if len(c.Args()) != 1 || !contains(completionShells, c.Args()[0]) {
+		err = errors.New("Incorrect Usage")
+		cmd.ui.FailWithUsage(c, "completion")
+	}
+	return
+}
+
+func (cmd Completion) Run(c *cli.Context) (err error) {
+	commands := newCompletionCommands(c.App.Commands)
+	globalFlags, globalValueFlags := completionFlags(c.App.Flags)
+
+	var script string
+	switch c.Args()[0] {
+	case "bash":
+		script = bashCompletion(commands, globalFlags, globalValueFlags)
+	case "zsh":
+		script = zshCompletion(commands, globalFlags, globalValueFlags)
+	case "fish":
+		script = fishCompletion(commands, globalFlags, globalValueFlags)
+	}
+
+	cmd.ui.Say("%s", script)
+	return
+}
+
+func newCompletionCommands(cliCommands []cli.Command) (commands []completionCommand) {
+	for _, cliCommand := range cliCommands {
+		if strings.HasPrefix(cliCommand.Name, hiddenCommandPrefix) {
+			continue
+		}
+
+		command := completionCommand{
+			Names:       []string{cliCommand.Name},
+			Description: cliCommand.Description,
+			FlagKinds:   map[string]string{},
+		}
+		if cliCommand.ShortName != "" {
+			command.Names = append(command.Names, cliCommand.ShortName)
+		}
+
+		command.Flags, command.ValueFlags = completionFlags(cliCommand.Flags)
+		command.ArgKinds, command.FlagKinds = argumentKinds(cliCommand.Usage)
+		commands = append(commands, command)
+	}
+
+	sort.Sort(completionCommandsByName(commands))
+	return
+}
+
+func completionFlags(cliFlags []cli.Flag) (flags, valueFlags []string) {
+	for _, cliFlag := range cliFlags {
+		name := flagNamePattern.FindString(cliFlag.String())
+		if name == "" {
+			continue
+		}
+
+		flags = append(flags, name)
+		switch cliFlag.(type) {
+		case cli.BoolFlag:
+		default:
+			valueFlags = append(valueFlags, name)
+		}
+	}
+	return
+}
+
+// argumentKinds reads the first line of a usage, e.g. "cf bind-service APP
+// SERVICE_INSTANCE" or "cf target [-o ORG] [-s SPACE]", to find the arguments
+// and flag values that name existing entities
+func argumentKinds(usage string) (argKinds []string, flagKinds map[string]string) {
+	flagKinds = map[string]string{}
+	usage = strings.SplitN(usage, "\n", 2)[0]
+
+	words := strings.Fields(usage)
+	if len(words) > 2 {
+		for _, word := range words[2:] {
+			if strings.HasPrefix(word, "[") || strings.HasPrefix(word, "-") {
+				break
+			}
+			argKinds = append(argKinds, completedArgumentKinds[word])
+		}
+	}
+
+	for _, match := range usageFlagPattern.FindAllStringSubmatch(usage, -1) {
+		kind, ok := completedArgumentKinds[match[2]]
+		if ok {
+			flagKinds[match[1]] = kind
+		}
+	}
+	return
+}
+
+func bashCompletion(commands []completionCommand, globalFlags, globalValueFlags []string) string {
+	buffer := new(bytes.Buffer)
+	name := cf.Name()
+	prefix := completionFunctionPrefix()
+
+	fmt.Fprintf(buffer, "# bash completion for %s, load it with: source <(%s completion bash)\n\n", name, name)
+
+	fmt.Fprintf(buffer, "_%s_command_name() {\n    case \"$1\" in\n", prefix)
+	for _, command := range commands {
+		if len(command.Names) > 1 {
+			fmt.Fprintf(buffer, "        %s) echo %s ;;\n", command.Names[1], command.Names[0])
+		}
+	}
+	fmt.Fprintf(buffer, "        *) echo \"$1\" ;;\n    esac\n}\n\n")
+
+	fmt.Fprintf(buffer, "_%s_flags() {\n    case \"$1\" in\n", prefix)
+	fmt.Fprintf(buffer, "        \"\") echo %q ;;\n", strings.Join(globalFlags, " "))
+	for _, command := range commands {
+		if len(command.Flags) > 0 {
+			fmt.Fprintf(buffer, "        %s) echo %q ;;\n", command.Names[0], strings.Join(command.Flags, " "))
+		}
+	}
+	fmt.Fprintf(buffer, "    esac\n}\n\n")
+
+	fmt.Fprintf(buffer, "_%s_value_flags() {\n    case \"$1\" in\n", prefix)
+	fmt.Fprintf(buffer, "        \"\") echo %q ;;\n", strings.Join(globalValueFlags, " "))
+	for _, command := range commands {
+		if len(command.ValueFlags) > 0 {
+			fmt.Fprintf(buffer, "        %s) echo %q ;;\n", command.Names[0], strings.Join(command.ValueFlags, " "))
+		}
+	}
+	fmt.Fprintf(buffer, "    esac\n}\n\n")
+
+	fmt.Fprintf(buffer, "_%s_name_kind() {\n    case \"$1 $2\" in\n", prefix)
+	for _, kind := range sortedKinds() {
+		patterns := []string{}
+		for _, command := range commands {
+			for position, argKind := range command.ArgKinds {
+				if argKind == kind {
+					patterns = append(patterns, fmt.Sprintf("\"%s %d\"", command.Names[0], position))
+				}
+			}
+			for _, flag := range sortedFlags(command.FlagKinds) {
+				if command.FlagKinds[flag] == kind {
+					patterns = append(patterns, fmt.Sprintf("\"%s %s\"", command.Names[0], flag))
+				}
+			}
+		}
+		if len(patterns) > 0 {
+			fmt.Fprintf(buffer, "        %s) echo %s ;;\n", strings.Join(patterns, "|"), kind)
+		}
+	}
+	fmt.Fprintf(buffer, "    esac\n}\n\n")
+
+	fmt.Fprintf(buffer, `_%[1]s() {
+    local cur="${COMP_WORDS[COMP_CWORD]}" prev="" cmd="" position=0 i word kind
+
+    for ((i = 1; i < COMP_CWORD; i++)); do
+        word="${COMP_WORDS[i]}"
+        prev=""
+        if [[ "$word" == -* ]]; then
+            if [[ " $(_%[1]s_value_flags "$cmd") " == *" $word "* ]]; then
+                prev="$word"
+                ((i++))
+                if ((i == COMP_CWORD)); then
+                    break
+                fi
+                prev=""
+            fi
+        elif [ -z "$cmd" ]; then
+            cmd="$(_%[1]s_command_name "$word")"
+        else
+            ((position++))
+        fi
+    done
+
+    if [ -n "$prev" ]; then
+        kind="$(_%[1]s_name_kind "$cmd" "$prev")"
+    elif [[ "$cur" == -* ]]; then
+        COMPREPLY=($(compgen -W "$(_%[1]s_flags "$cmd")" -- "$cur"))
+        return
+    elif [ -z "$cmd" ]; then
+        COMPREPLY=($(compgen -W %[2]q -- "$cur"))
+        return
+    else
+        kind="$(_%[1]s_name_kind "$cmd" "$position")"
+    fi
+
+    if [ -n "$kind" ]; then
+        local IFS=$'\n'
+        COMPREPLY=($(compgen -W "$(%[4]s %[3]s "$kind" 2>/dev/null)" -- "$cur"))
+    fi
+}
+
+complete -o default -F _%[1]s %[4]s
+`, prefix, strings.Join(commandNames(commands), " "), CompleteNamesCommandName, name)
+
+	return buffer.String()
+}
+
+func zshCompletion(commands []completionCommand, globalFlags, globalValueFlags []string) string {
+	return fmt.Sprintf(`# zsh completion for %[1]s, load it with: source <(%[1]s completion zsh)
+
+autoload -U +X bashcompinit && bashcompinit
+
+%[2]s`, cf.Name(), bashCompletion(commands, globalFlags, globalValueFlags))
+}
+
+func fishCompletion(commands []completionCommand, globalFlags, globalValueFlags []string) string {
+	buffer := new(bytes.Buffer)
+	name := cf.Name()
+
+	prefix := completionFunctionPrefix()
+
+	fmt.Fprintf(buffer, "# fish completion for %s, load it with: %s completion fish | source\n\n", name, name)
+
+	fmt.Fprintf(buffer, `function __%[1]s_value_flags
+    switch $argv[1]
+        case ''
+            echo %[2]s
+`, prefix, strings.Join(globalValueFlags, " "))
+	for _, command := range commands {
+		if len(command.ValueFlags) > 0 {
+			fmt.Fprintf(buffer, "        case %s\n            echo %s\n", strings.Join(command.Names, " "), strings.Join(command.ValueFlags, " "))
+		}
+	}
+	fmt.Fprintf(buffer, "    end\nend\n\n")
+
+	fmt.Fprintf(buffer, `# __%[1]s_argument_is COMMAND POSITION tells if the word being completed is the
+# argument at POSITION of COMMAND, counting from 0
+function __%[1]s_argument_is
+    set -l words (commandline -opc)
+    set -l cmd ''
+    set -l position 0
+    set -l skip 0
+    for word in $words[2..-1]
+        if test $skip -eq 1
+            set skip 0
+        else if string match -q -- '-*' $word
+            if contains -- $word (string split ' ' -- (__%[1]s_value_flags $cmd))
+                set skip 1
+            end
+        else if test -z "$cmd"
+            set cmd $word
+        else
+            set position (math $position + 1)
+        end
+    end
+    test $skip -eq 0; and contains -- $cmd (string split ' ' -- $argv[1]); and test $position -eq $argv[2]
+end
+
+complete -c %[2]s -f
+`, prefix, name)
+
+	for _, flag := range globalFlags {
+		fmt.Fprintf(buffer, "complete -c %s -n '__fish_use_subcommand' %s\n", name, fishFlag(flag, contains(globalValueFlags, flag)))
+	}
+
+	for _, command := range commands {
+		fmt.Fprintf(buffer, "\ncomplete -c %s -n '__fish_use_subcommand' -a %s -d %s\n",
+			name, command.Names[0], fishQuote(command.Description))
+		if len(command.Names) > 1 {
+			fmt.Fprintf(buffer, "complete -c %s -n '__fish_use_subcommand' -a %s -d %s\n",
+				name, command.Names[1], fishQuote(command.Description))
+		}
+
+		seenCommand := fmt.Sprintf("__fish_seen_subcommand_from %s", strings.Join(command.Names, " "))
+		for _, flag := range command.Flags {
+			completion := fishFlag(flag, contains(command.ValueFlags, flag))
+			kind, ok := command.FlagKinds[flag]
+			if ok {
+				completion += fmt.Sprintf(" -a '(%s %s %s 2>/dev/null)'", name, CompleteNamesCommandName, kind)
+			} else if contains(command.ValueFlags, flag) {
+				completion += " -F"
+			}
+			fmt.Fprintf(buffer, "complete -c %s -n '%s' %s\n", name, seenCommand, completion)
+		}
+
+		for position, kind := range command.ArgKinds {
+			if kind == "" {
+				continue
+			}
+			fmt.Fprintf(buffer, "complete -c %s -n '__%s_argument_is \"%s\" %d' -a '(%s %s %s 2>/dev/null)'\n",
+				name, prefix, strings.Join(command.Names, " "), position, name, CompleteNamesCommandName, kind)
+		}
+	}
+
+	return buffer.String()
+}
+
+func fishFlag(flag string, takesValue bool) (completion string) {
+	if strings.HasPrefix(flag, "--") {
+		completion = "-l " + strings.TrimPrefix(flag, "--")
+	} else {
+		completion = "-s " + strings.TrimPrefix(flag, "-")
+	}
+
+	if takesValue {
+		completion += " -r"
+	}
+	return
+}
+
+// the functions of the scripts are named after the binary, so that the
+// scripts of differently named binaries don't clash
+func completionFunctionPrefix() string {
+	return nonIdentifierPattern.ReplaceAllString(cf.Name(), "_")
+}
+
+func fishQuote(value string) string {
+	return "'" + strings.Replace(strings.Replace(value, `\`, `\\`, -1), "'", `\'`, -1) + "'"
+}
+
+func commandNames(commands []completionCommand) (names []string) {
+	for _, command := range commands {
+		names = append(names, command.Names...)
+	}
+	return
+}
+
+func sortedKinds() (kinds []string) {
+	for _, kind := range completedArgumentKinds {
+		kinds = append(kinds, kind)
+	}
+	sort.Strings(kinds)
+	return
+}
+
+func sortedFlags(flagKinds map[string]string) (flags []string) {
+	for flag, _ := range flagKinds {
+		flags = append(flags, flag)
+	}
+	sort.Strings(flags)
+	return
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+type completionCommandsByName []completionCommand
+
+func (commands completionCommandsByName) Len() int {
+	return len(commands)
+}
+
+func (commands completionCommandsByName) Less(i, j int) bool {
+	return commands[i].Names[0] < commands[j].Names[0]
+}
+
+func (commands completionCommandsByName) Swap(i, j int) {
+	commands[i], commands[j] = commands[j], commands[i]
+}
@@ -0,0 +1,72 @@
+package commands_test
+
+import (
+	"cf/app"
+	. "cf/commands"
+	"flag"
+	"github.com/codegangsta/cli"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"strings"
+	testcmd "testhelpers/commands"
+	testreq "testhelpers/requirements"
+	testterm "testhelpers/terminal"
+)
+
+func runCompletion(ui *testterm.FakeUI, args []string) {
+	cmdRunner := NewRunner(ui, ConcreteFactory{}, &testreq.FakeReqFactory{})
+	cfApp, err := app.NewApp(cmdRunner)
+	Expect(err).NotTo(HaveOccurred())
+
+	flagSet := new(flag.FlagSet)
+	flagSet.Parse(args)
+	ctxt := cli.NewContext(cfApp, flagSet, new(flag.FlagSet))
+
+	testcmd.RunCommand(ui, NewCompletion(ui), ctxt, &testreq.FakeReqFactory{})
+}
+
+var _ = Describe("completion command", func() {
+	It("fails with usage when not given a shell", func() {
+		ui := &testterm.FakeUI{}
+		runCompletion(ui, []string{})
+		Expect(ui.FailedWithUsage).To(BeTrue())
+	})
+
+	It("fails with usage when given an unknown shell", func() {
+		ui := &testterm.FakeUI{}
+		runCompletion(ui, []string{"tcsh"})
+		Expect(ui.FailedWithUsage).To(BeTrue())
+	})
+
+	It("prints a bash script completing commands, flags and names", func() {
+		ui := &testterm.FakeUI{}
+		runCompletion(ui, []string{"bash"})
+		script := strings.Join(ui.Outputs, "\n")
+
+		Expect(script).To(ContainSubstring("push"))
+		Expect(script).To(ContainSubstring("--non-interactive"))
+		Expect(script).To(ContainSubstring(`"start 0"`))
+		Expect(script).To(ContainSubstring(`"target -o"`))
+		Expect(script).To(ContainSubstring("__complete-names"))
+		Expect(script).To(ContainSubstring("complete -o default -F"))
+		Expect(script).NotTo(ContainSubstring(`"bind-service -service"`))
+	})
+
+	It("leaves hidden commands out of the command list", func() {
+		ui := &testterm.FakeUI{}
+		runCompletion(ui, []string{"fish"})
+		script := strings.Join(ui.Outputs, "\n")
+
+		Expect(script).To(ContainSubstring("-a push "))
+		Expect(script).NotTo(ContainSubstring("-a __complete-names"))
+	})
+
+	It("prints a zsh script built on bashcompinit", func() {
+		ui := &testterm.FakeUI{}
+		runCompletion(ui, []string{"zsh"})
+		script := strings.Join(ui.Outputs, "\n")
+
+		Expect(script).To(ContainSubstring("bashcompinit"))
+		Expect(script).To(ContainSubstring("complete -o default -F"))
+	})
+})
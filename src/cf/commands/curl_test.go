@@ -151,5 +151,5 @@ func newCurlDependencies() (deps curlDependencies) {
 func runCurlWithInputs(deps curlDependencies, inputs []string) {
 	ctxt := testcmd.NewContext("curl", inputs)
 	cmd := NewCurl(deps.ui, deps.config, deps.curlRepo)
-	testcmd.RunCommand(cmd, ctxt, deps.reqFactory)
+	testcmd.RunCommand(deps.ui, cmd, ctxt, deps.reqFactory)
 }
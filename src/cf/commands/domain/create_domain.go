@@ -3,9 +3,9 @@ package domain
 import (
 	"cf/api"
 	"cf/configuration"
+	"cf/errors"
 	"cf/requirements"
 	"cf/terminal"
-	"errors"
 	"github.com/codegangsta/cli"
 )
 
@@ -39,7 +39,7 @@ func (cmd *CreateDomain) GetRequirements(reqFactory requirements.Factory, c *cli
 	return
 }
 
-func (cmd *CreateDomain) Run(c *cli.Context) {
+func (cmd *CreateDomain) Run(c *cli.Context) (err error) {
 	domainName := c.Args()[1]
 	owningOrg := cmd.orgReq.GetOrganization()
 
@@ -51,9 +51,10 @@ func (cmd *CreateDomain) Run(c *cli.Context) {
 
 	_, apiResponse := cmd.domainRepo.Create(domainName, owningOrg.Guid)
 	if apiResponse.IsNotSuccessful() {
-		cmd.ui.Failed(apiResponse.Message)
+		err = errors.FromApiResponse(apiResponse)
 		return
 	}
 
 	cmd.ui.Ok()
+	return
 }
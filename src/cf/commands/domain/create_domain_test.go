@@ -68,6 +68,6 @@ func callCreateDomain(args []string, reqFactory *testreq.FakeReqFactory, domainR
 
 	cmd := domain.NewCreateDomain(fakeUI, configRepo, domainRepo)
 
-	testcmd.RunCommand(cmd, ctxt, reqFactory)
+	testcmd.RunCommand(fakeUI, cmd, ctxt, reqFactory)
 	return
 }
@@ -3,9 +3,9 @@ package domain
 import (
 	"cf/api"
 	"cf/configuration"
+	"cf/errors"
 	"cf/requirements"
 	"cf/terminal"
-	"errors"
 	"github.com/codegangsta/cli"
 )
 
@@ -37,7 +37,7 @@ func (cmd *CreateSharedDomain) GetRequirements(reqFactory requirements.Factory,
 	return
 }
 
-func (cmd *CreateSharedDomain) Run(c *cli.Context) {
+func (cmd *CreateSharedDomain) Run(c *cli.Context) (err error) {
 	domainName := c.Args()[0]
 
 	cmd.ui.Say("Creating shared domain %s as %s...",
@@ -47,9 +47,10 @@ func (cmd *CreateSharedDomain) Run(c *cli.Context) {
 
 	apiResponse := cmd.domainRepo.CreateSharedDomain(domainName)
 	if apiResponse.IsNotSuccessful() {
-		cmd.ui.Failed(apiResponse.Message)
+		err = errors.FromApiResponse(apiResponse)
 		return
 	}
 
 	cmd.ui.Ok()
+	return
 }
@@ -54,6 +54,6 @@ func callShareDomain(args []string, reqFactory *testreq.FakeReqFactory, domainRe
 	ctxt := testcmd.NewContext("create-shared-domain", args)
 	configRepo := testconfig.NewRepositoryWithAccessToken(configuration.TokenInfo{Username: "my-user"})
 	cmd := NewCreateSharedDomain(fakeUI, configRepo, domainRepo)
-	testcmd.RunCommand(cmd, ctxt, reqFactory)
+	testcmd.RunCommand(fakeUI, cmd, ctxt, reqFactory)
 	return
 }
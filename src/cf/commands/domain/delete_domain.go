@@ -3,9 +3,9 @@ package domain
 import (
 	"cf/api"
 	"cf/configuration"
+	"cf/errors"
 	"cf/requirements"
 	"cf/terminal"
-	"errors"
 	"github.com/codegangsta/cli"
 )
 
@@ -42,7 +42,7 @@ func (cmd *DeleteDomain) GetRequirements(reqFactory requirements.Factory, c *cli
 	return
 }
 
-func (cmd *DeleteDomain) Run(c *cli.Context) {
+func (cmd *DeleteDomain) Run(c *cli.Context) (err error) {
 	domainName := c.Args()[0]
 	force := c.Bool("f")
 
@@ -53,7 +53,7 @@ func (cmd *DeleteDomain) Run(c *cli.Context) {
 
 	domain, apiResponse := cmd.domainRepo.FindByNameInOrg(domainName, cmd.orgReq.GetOrganizationFields().Guid)
 	if apiResponse.IsError() {
-		cmd.ui.Failed("Error finding domain %s\n%s", domainName, apiResponse.Message)
+		err = errors.FromApiResponseWithMessage(apiResponse, "Error finding domain %s\n%s", domainName, apiResponse.Message)
 		return
 	}
 	if apiResponse.IsNotFound() {
@@ -62,6 +62,11 @@ func (cmd *DeleteDomain) Run(c *cli.Context) {
 		return
 	}
 
+	if !force && !cmd.ui.IsInteractive() {
+		err = errors.NewMissingInputError("confirmation", "-f")
+		return
+	}
+
 	if !force {
 		answer := cmd.ui.Confirm("Are you sure you want to delete the domain %s and all of its associations?", domainName)
 
@@ -72,9 +77,10 @@ func (cmd *DeleteDomain) Run(c *cli.Context) {
 
 	apiResponse = cmd.domainRepo.Delete(domain.Guid)
 	if apiResponse.IsNotSuccessful() {
-		cmd.ui.Failed("Error deleting domain %s\n%s", domainName, apiResponse.Message)
+		err = errors.FromApiResponseWithMessage(apiResponse, "Error deleting domain %s\n%s", domainName, apiResponse.Message)
 		return
 	}
 
 	cmd.ui.Ok()
+	return
 }
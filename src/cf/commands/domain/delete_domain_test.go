@@ -172,6 +172,6 @@ func callDeleteDomain(args []string, inputs []string, reqFactory *testreq.FakeRe
 	configRepo.SetOrganizationFields(orgFields)
 
 	cmd := domain.NewDeleteDomain(ui, configRepo, domainRepo)
-	testcmd.RunCommand(cmd, ctxt, reqFactory)
+	testcmd.RunCommand(ui, cmd, ctxt, reqFactory)
 	return
 }
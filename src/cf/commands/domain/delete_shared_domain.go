@@ -3,9 +3,9 @@ package domain
 import (
 	"cf/api"
 	"cf/configuration"
+	"cf/errors"
 	"cf/requirements"
 	"cf/terminal"
-	"errors"
 	"github.com/codegangsta/cli"
 )
 
@@ -42,7 +42,7 @@ func (cmd *DeleteSharedDomain) GetRequirements(reqFactory requirements.Factory,
 	return
 }
 
-func (cmd *DeleteSharedDomain) Run(c *cli.Context) {
+func (cmd *DeleteSharedDomain) Run(c *cli.Context) (err error) {
 	domainName := c.Args()[0]
 	force := c.Bool("f")
 
@@ -53,7 +53,7 @@ func (cmd *DeleteSharedDomain) Run(c *cli.Context) {
 
 	domain, apiResponse := cmd.domainRepo.FindByNameInOrg(domainName, cmd.orgReq.GetOrganizationFields().Guid)
 	if apiResponse.IsError() {
-		cmd.ui.Failed("Error finding domain %s\n%s", domainName, apiResponse.Message)
+		err = errors.FromApiResponseWithMessage(apiResponse, "Error finding domain %s\n%s", domainName, apiResponse.Message)
 		return
 	}
 	if apiResponse.IsNotFound() {
@@ -62,6 +62,11 @@ func (cmd *DeleteSharedDomain) Run(c *cli.Context) {
 		return
 	}
 
+	if !force && !cmd.ui.IsInteractive() {
+		err = errors.NewMissingInputError("confirmation", "-f")
+		return
+	}
+
 	if !force {
 		answer := cmd.ui.Confirm(
 			`This domain is shared across all orgs.
@@ -75,9 +80,10 @@ Are you sure you want to delete the domain %s? `, domainName)
 
 	apiResponse = cmd.domainRepo.DeleteSharedDomain(domain.Guid)
 	if apiResponse.IsNotSuccessful() {
-		cmd.ui.Failed("Error deleting domain %s\n%s", domainName, apiResponse.Message)
+		err = errors.FromApiResponseWithMessage(apiResponse, "Error deleting domain %s\n%s", domainName, apiResponse.Message)
 		return
 	}
 
 	cmd.ui.Ok()
+	return
 }
@@ -140,6 +140,6 @@ func callDeleteSharedDomain(args []string, inputs []string, deps deleteSharedDom
 	configRepo.SetOrganizationFields(orgFields)
 
 	cmd := domain.NewDeleteSharedDomain(ui, configRepo, deps.domainRepo)
-	testcmd.RunCommand(cmd, ctxt, deps.requirementsFactory)
+	testcmd.RunCommand(ui, cmd, ctxt, deps.requirementsFactory)
 	return
 }
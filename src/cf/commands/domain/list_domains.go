@@ -3,13 +3,20 @@ package domain
 import (
 	"cf/api"
 	"cf/configuration"
+	"cf/errors"
 	"cf/models"
 	"cf/requirements"
 	"cf/terminal"
-	"errors"
 	"github.com/codegangsta/cli"
 )
 
+// domainOutput is what structured output prints for a domain
+type domainOutput struct {
+	Guid   string `json:"guid"`
+	Name   string `json:"name"`
+	Status string `json:"status"`
+}
+
 type ListDomains struct {
 	ui         terminal.UI
 	config     configuration.Reader
@@ -40,7 +47,7 @@ func (cmd *ListDomains) GetRequirements(reqFactory requirements.Factory, c *cli.
 	return
 }
 
-func (cmd *ListDomains) Run(c *cli.Context) {
+func (cmd *ListDomains) Run(c *cli.Context) (err error) {
 	org := cmd.orgReq.GetOrganizationFields()
 
 	cmd.ui.Say("Getting domains in org %s as %s...",
@@ -53,24 +60,31 @@ func (cmd *ListDomains) Run(c *cli.Context) {
 	apiResponse := cmd.domainRepo.ListSharedDomains(domainsCallback(table, &noDomains))
 
 	if apiResponse.IsNotSuccessful() && !apiResponse.IsNotFound() {
-		cmd.ui.Failed("Failed fetching shared domains.\n%s", apiResponse.Message)
+		err = errors.FromApiResponseWithMessage(apiResponse, "Failed fetching shared domains.\n%s", apiResponse.Message)
 		return
 	}
 
 	apiResponse = cmd.domainRepo.ListDomainsForOrg(org.Guid, domainsCallback(table, &noDomains))
 	if apiResponse.IsNotSuccessful() {
-		cmd.ui.Failed("Failed fetching private domains.\n%s", apiResponse.Message)
+		err = errors.FromApiResponseWithMessage(apiResponse, "Failed fetching private domains.\n%s", apiResponse.Message)
+		return
+	}
+
+	err = table.Print()
+	if err != nil {
 		return
 	}
 
 	if noDomains {
 		cmd.ui.Say("No domains found")
 	}
+	return
 }
 
 func domainsCallback(table terminal.Table, noDomains *bool) func(models.DomainFields) bool {
 	return func(domain models.DomainFields) bool {
-		table.Print([][]string{{domain.Name, domainStatusString(domain)}})
+		output := domainOutput{Guid: domain.Guid, Name: domain.Name, Status: domainStatusString(domain)}
+		table.Add(output, output.Name, output.Status)
 		*noDomains = false
 		return true
 	}
@@ -171,6 +171,6 @@ func callListDomains(args []string, reqFactory *testreq.FakeReqFactory, domainRe
 	configRepo.SetOrganizationFields(orgFields)
 
 	cmd := domain.NewListDomains(fakeUI, configRepo, domainRepo)
-	testcmd.RunCommand(cmd, ctxt, reqFactory)
+	testcmd.RunCommand(fakeUI, cmd, ctxt, reqFactory)
 	return
 }
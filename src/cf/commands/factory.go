@@ -8,27 +8,43 @@ import (
 	"cf/commands/organization"
 	"cf/commands/route"
 	"cf/commands/service"
+	"cf/commands/serviceaccess"
 	"cf/commands/serviceauthtoken"
 	"cf/commands/servicebroker"
 	"cf/commands/space"
 	"cf/commands/user"
 	"cf/configuration"
+	"cf/errors"
 	"cf/manifest"
+	"cf/plugin"
 	"cf/terminal"
-	"errors"
+	"os"
 )
 
 type Factory interface {
 	GetByCmdName(cmdName string) (cmd Command, err error)
+	CommandNames() []string
 }
 
 type ConcreteFactory struct {
 	cmdsByName map[string]Command
+
+	// unknown commands are looked up as plugins
+	ui         terminal.UI
+	config     configuration.Reader
+	authRepo   api.AuthenticationRepository
+	pluginDirs []string
 }
 
 func NewFactory(ui terminal.UI, config configuration.ReadWriter, manifestRepo manifest.ManifestRepository, repoLocator api.RepositoryLocator) (factory ConcreteFactory) {
 	factory.cmdsByName = make(map[string]Command)
+	factory.ui = ui
+	factory.config = config
+	factory.authRepo = repoLocator.GetAuthenticationRepository()
+	factory.pluginDirs = plugin.SearchPath(configuration.PluginsDir())
 
+	factory.cmdsByName["alias"] = NewAlias(ui, config)
+	factory.cmdsByName["aliases"] = NewListAliases(ui, config)
 	factory.cmdsByName["api"] = NewApi(ui, config, repoLocator.GetEndpointRepository())
 	factory.cmdsByName["apps"] = application.NewListApps(ui, config, repoLocator.GetAppSummaryRepository())
 	factory.cmdsByName["auth"] = NewAuthenticate(ui, config, repoLocator.GetAuthenticationRepository())
@@ -41,6 +57,7 @@ func NewFactory(ui terminal.UI, config configuration.ReadWriter, manifestRepo ma
 	factory.cmdsByName["create-service-broker"] = servicebroker.NewCreateServiceBroker(ui, config, repoLocator.GetServiceBrokerRepository())
 	factory.cmdsByName["create-user"] = user.NewCreateUser(ui, config, repoLocator.GetUserRepository())
 	factory.cmdsByName["create-user-provided-service"] = service.NewCreateUserProvidedService(ui, config, repoLocator.GetUserProvidedServiceInstanceRepository())
+	factory.cmdsByName["completion"] = NewCompletion(ui)
 	factory.cmdsByName["curl"] = NewCurl(ui, config, repoLocator.GetCurlRepository())
 	factory.cmdsByName["delete"] = application.NewDeleteApp(ui, config, repoLocator.GetApplicationRepository())
 	factory.cmdsByName["delete-buildpack"] = buildpack.NewDeleteBuildpack(ui, repoLocator.GetBuildpackRepository())
@@ -52,9 +69,11 @@ func NewFactory(ui terminal.UI, config configuration.ReadWriter, manifestRepo ma
 	factory.cmdsByName["delete-service-auth-token"] = serviceauthtoken.NewDeleteServiceAuthToken(ui, config, repoLocator.GetServiceAuthTokenRepository())
 	factory.cmdsByName["delete-service-broker"] = servicebroker.NewDeleteServiceBroker(ui, config, repoLocator.GetServiceBrokerRepository())
 	factory.cmdsByName["delete-space"] = space.NewDeleteSpace(ui, config, repoLocator.GetSpaceRepository())
+	factory.cmdsByName["disable-service-access"] = serviceaccess.NewDisableServiceAccess(ui, config, repoLocator.GetServiceBrokerRepository(), repoLocator.GetServiceRepository(), repoLocator.GetServicePlanVisibilityRepository(), repoLocator.GetOrganizationRepository())
 	factory.cmdsByName["delete-user"] = user.NewDeleteUser(ui, config, repoLocator.GetUserRepository())
 	factory.cmdsByName["domains"] = domain.NewListDomains(ui, config, repoLocator.GetDomainRepository())
-	factory.cmdsByName["env"] = application.NewEnv(ui, config)
+	factory.cmdsByName["enable-service-access"] = serviceaccess.NewEnableServiceAccess(ui, config, repoLocator.GetServiceBrokerRepository(), repoLocator.GetServiceRepository(), repoLocator.GetServicePlanVisibilityRepository(), repoLocator.GetOrganizationRepository())
+	factory.cmdsByName["env"] = application.NewEnv(ui, config, repoLocator.GetApplicationRepository())
 	factory.cmdsByName["events"] = application.NewEvents(ui, config, repoLocator.GetAppEventsRepository())
 	factory.cmdsByName["files"] = application.NewFiles(ui, config, repoLocator.GetAppFilesRepository())
 	factory.cmdsByName["login"] = NewLogin(ui, config, repoLocator.GetAuthenticationRepository(), repoLocator.GetEndpointRepository(), repoLocator.GetOrganizationRepository(), repoLocator.GetSpaceRepository())
@@ -65,6 +84,9 @@ func NewFactory(ui terminal.UI, config configuration.ReadWriter, manifestRepo ma
 	factory.cmdsByName["org-users"] = user.NewOrgUsers(ui, config, repoLocator.GetUserRepository())
 	factory.cmdsByName["orgs"] = organization.NewListOrgs(ui, config, repoLocator.GetOrganizationRepository())
 	factory.cmdsByName["passwd"] = NewPassword(ui, repoLocator.GetPasswordRepository(), config)
+	factory.cmdsByName["profile"] = NewProfile(ui, config)
+	factory.cmdsByName["plugins"] = NewListPlugins(ui, factory.pluginDirs)
+	factory.cmdsByName["profiles"] = NewListProfiles(ui, config)
 	factory.cmdsByName["purge-service-offering"] = service.NewPurgeServiceOffering(ui, config, repoLocator.GetServiceRepository())
 	factory.cmdsByName["quotas"] = organization.NewListQuotas(ui, config, repoLocator.GetQuotaRepository())
 	factory.cmdsByName["rename"] = application.NewRenameApp(ui, config, repoLocator.GetApplicationRepository())
@@ -74,6 +96,7 @@ func NewFactory(ui terminal.UI, config configuration.ReadWriter, manifestRepo ma
 	factory.cmdsByName["rename-space"] = space.NewRenameSpace(ui, config, repoLocator.GetSpaceRepository())
 	factory.cmdsByName["routes"] = route.NewListRoutes(ui, config, repoLocator.GetRouteRepository())
 	factory.cmdsByName["service"] = service.NewShowService(ui)
+	factory.cmdsByName["service-access"] = serviceaccess.NewServiceAccess(ui, config, repoLocator.GetServiceBrokerRepository(), repoLocator.GetServiceRepository(), repoLocator.GetServicePlanVisibilityRepository(), repoLocator.GetOrganizationRepository())
 	factory.cmdsByName["service-auth-tokens"] = serviceauthtoken.NewListServiceAuthTokens(ui, config, repoLocator.GetServiceAuthTokenRepository())
 	factory.cmdsByName["service-brokers"] = servicebroker.NewListServiceBrokers(ui, config, repoLocator.GetServiceBrokerRepository())
 	factory.cmdsByName["services"] = service.NewListServices(ui, config, repoLocator.GetServiceSummaryRepository())
@@ -91,9 +114,11 @@ func NewFactory(ui terminal.UI, config configuration.ReadWriter, manifestRepo ma
 	factory.cmdsByName["unset-org-role"] = user.NewUnsetOrgRole(ui, config, repoLocator.GetUserRepository())
 	factory.cmdsByName["unset-space-role"] = user.NewUnsetSpaceRole(ui, config, repoLocator.GetSpaceRepository(), repoLocator.GetUserRepository())
 	factory.cmdsByName["update-buildpack"] = buildpack.NewUpdateBuildpack(ui, repoLocator.GetBuildpackRepository(), repoLocator.GetBuildpackBitsRepository())
+	factory.cmdsByName["update-service"] = service.NewUpdateService(ui, config, repoLocator.GetServiceRepository())
 	factory.cmdsByName["update-service-broker"] = servicebroker.NewUpdateServiceBroker(ui, config, repoLocator.GetServiceBrokerRepository())
 	factory.cmdsByName["update-service-auth-token"] = serviceauthtoken.NewUpdateServiceAuthToken(ui, config, repoLocator.GetServiceAuthTokenRepository())
 	factory.cmdsByName["update-user-provided-service"] = service.NewUpdateUserProvidedService(ui, config, repoLocator.GetUserProvidedServiceInstanceRepository())
+	factory.cmdsByName["wait-for-service"] = service.NewWaitForService(ui, config, repoLocator.GetServiceRepository())
 
 	createRoute := route.NewCreateRoute(ui, config, repoLocator.GetRouteRepository())
 	factory.cmdsByName["create-route"] = createRoute
@@ -112,8 +137,12 @@ func NewFactory(ui terminal.UI, config configuration.ReadWriter, manifestRepo ma
 	factory.cmdsByName["stop"] = stop
 	factory.cmdsByName["restart"] = restart
 	factory.cmdsByName["push"] = application.NewPush(ui, config, manifestRepo, start, stop, bind, repoLocator.GetApplicationRepository(), repoLocator.GetDomainRepository(), repoLocator.GetRouteRepository(), repoLocator.GetStackRepository(), repoLocator.GetServiceRepository(), repoLocator.GetApplicationBitsRepository())
+	factory.cmdsByName["run-script"] = NewRunScript(ui, config, factory)
+	factory.cmdsByName["shell"] = NewShell(ui, config, factory, os.Stdin, os.Stdout, configuration.ShellHistoryPath())
 	factory.cmdsByName["scale"] = application.NewScale(ui, config, restart, repoLocator.GetApplicationRepository())
 
+	factory.cmdsByName[CompleteNamesCommandName] = NewCompleteNames(ui, config, repoLocator.GetAppSummaryRepository(), repoLocator.GetServiceSummaryRepository(), repoLocator.GetOrganizationRepository(), repoLocator.GetSpaceRepository(), configuration.CompletionCachePath())
+
 	spaceRoleSetter := user.NewSetSpaceRole(ui, config, repoLocator.GetSpaceRepository(), repoLocator.GetUserRepository())
 	factory.cmdsByName["set-space-role"] = spaceRoleSetter
 	factory.cmdsByName["create-space"] = space.NewCreateSpace(ui, config, spaceRoleSetter, repoLocator.GetSpaceRepository(), repoLocator.GetOrganizationRepository(), repoLocator.GetUserRepository())
@@ -123,8 +152,25 @@ func NewFactory(ui terminal.UI, config configuration.ReadWriter, manifestRepo ma
 
 func (f ConcreteFactory) GetByCmdName(cmdName string) (cmd Command, err error) {
 	cmd, found := f.cmdsByName[cmdName]
+	if found {
+		return
+	}
+
+	cmdPlugin, found := plugin.FindByName(f.pluginDirs, cmdName)
 	if !found {
 		err = errors.New("Command not found")
+		return
+	}
+	cmd = NewRunPlugin(f.ui, f.config, f.authRepo, cmdPlugin)
+	return
+}
+
+func (f ConcreteFactory) CommandNames() (names []string) {
+	for name := range f.cmdsByName {
+		names = append(names, name)
+	}
+	for _, cmdPlugin := range plugin.Find(f.pluginDirs) {
+		names = append(names, cmdPlugin.Name)
 	}
 	return
 }
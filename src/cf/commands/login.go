@@ -3,12 +3,11 @@ package commands
 import (
 	"cf/api"
 	"cf/configuration"
+	"cf/errors"
 	"cf/models"
 	"cf/net"
 	"cf/requirements"
 	"cf/terminal"
-	"errors"
-	"fmt"
 	"github.com/codegangsta/cli"
 	"strconv"
 	"strings"
@@ -46,38 +45,47 @@ func NewLogin(ui terminal.UI,
 const userSkippedInput string = "user_skipped_input"
 
 func (cmd Login) GetRequirements(reqFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
+	if c.Bool("sso") && (c.String("u") != "" || c.String("p") != "") {
+		err = errors.New("Incorrect Usage")
+		cmd.ui.FailWithUsage(c, "login")
+	}
 	return
 }
 
-func (cmd Login) Run(c *cli.Context) {
+func (cmd Login) Run(c *cli.Context) (err error) {
+	if !cmd.ui.IsInteractive() {
+		err = cmd.checkNonInteractiveInputs(c)
+		if err != nil {
+			return
+		}
+	}
+
 	oldUserName := cmd.config.Username()
 
 	apiResponse := cmd.setApi(c)
 	if apiResponse.IsNotSuccessful() {
-		cmd.ui.Failed("Invalid API endpoint.\n%s", apiResponse.Message)
+		err = errors.FromApiResponseWithMessage(apiResponse, "Invalid API endpoint.\n%s", apiResponse.Message)
 		return
 	}
 
 	apiResponse = cmd.authenticate(c)
 	if apiResponse.IsNotSuccessful() {
-		cmd.ui.Failed("Unable to authenticate.")
+		err = authenticationError(apiResponse)
 		return
 	}
 
 	userChanged := (cmd.config.Username() != oldUserName && oldUserName != "")
 
-	err := cmd.setOrganization(c, userChanged)
+	err = cmd.setOrganization(c, userChanged)
 	shouldSkipSpace := err != nil && err.Error() == userSkippedInput
 
 	if err != nil && !shouldSkipSpace {
-		cmd.ui.Failed(err.Error())
 		return
 	}
 
 	if !shouldSkipSpace {
 		err = cmd.setSpace(c, userChanged)
 		if err != nil && err.Error() != userSkippedInput {
-			cmd.ui.Failed(err.Error())
 			return
 		}
 	}
@@ -86,6 +94,20 @@ func (cmd Login) Run(c *cli.Context) {
 	return
 }
 
+func (cmd Login) checkNonInteractiveInputs(c *cli.Context) (err error) {
+	switch {
+	case c.String("a") == "" && cmd.config.ApiEndpoint() == "":
+		err = errors.NewMissingInputError("the API endpoint", "-a")
+	case c.Bool("sso"):
+		err = errors.NewMissingInputError("a one-time passcode", "-u and -p instead of --sso")
+	case c.String("u") == "":
+		err = errors.NewMissingInputError("the username", "-u")
+	case c.String("p") == "":
+		err = errors.NewMissingInputError("the password", "-p")
+	}
+	return
+}
+
 func (cmd Login) setApi(c *cli.Context) (apiResponse net.ApiResponse) {
 	api := c.String("a")
 	if api == "" {
@@ -108,6 +130,10 @@ func (cmd Login) setApi(c *cli.Context) (apiResponse net.ApiResponse) {
 }
 
 func (cmd Login) authenticate(c *cli.Context) (apiResponse net.ApiResponse) {
+	if c.Bool("sso") {
+		return cmd.authenticateWithPasscode()
+	}
+
 	username := c.String("u")
 	if username == "" {
 		username = cmd.ui.Ask("Username%s", terminal.PromptColor(">"))
@@ -115,7 +141,12 @@ func (cmd Login) authenticate(c *cli.Context) (apiResponse net.ApiResponse) {
 
 	password := c.String("p")
 
-	for i := 0; i < maxLoginTries; i++ {
+	tries := maxLoginTries
+	if !cmd.ui.IsInteractive() {
+		tries = 1
+	}
+
+	for i := 0; i < tries; i++ {
 		if password == "" || i > 0 {
 			password = cmd.ui.AskForPassword("Password%s", terminal.PromptColor(">"))
 		}
@@ -134,6 +165,41 @@ func (cmd Login) authenticate(c *cli.Context) (apiResponse net.ApiResponse) {
 	return
 }
 
+// authenticationError reports rejected credentials with the auth exit code,
+// unless the authentication server could not be reached or failed itself
+func authenticationError(apiResponse net.ApiResponse) error {
+	if apiResponse.IsNetworkError() || (apiResponse.IsHttpError() && apiResponse.StatusCode >= 500) {
+		return errors.FromApiResponseWithMessage(apiResponse, "Unable to authenticate.")
+	}
+	return errors.NewAuthError("Unable to authenticate.")
+}
+
+func (cmd Login) authenticateWithPasscode() (apiResponse net.ApiResponse) {
+	passcodeURL, apiResponse := cmd.authenticator.GetPasscodeURL()
+	if apiResponse.IsNotSuccessful() {
+		cmd.ui.Say(apiResponse.Message)
+		return
+	}
+
+	cmd.ui.Say("Get a one-time passcode at %s", terminal.EntityNameColor(passcodeURL))
+
+	for i := 0; i < maxLoginTries; i++ {
+		passcode := cmd.ui.AskForPassword("One-time passcode%s", terminal.PromptColor(">"))
+
+		cmd.ui.Say("Authenticating...")
+
+		apiResponse = cmd.authenticator.AuthenticateWithPasscode(passcode)
+		if apiResponse.IsSuccessful() {
+			cmd.ui.Ok()
+			cmd.ui.Say("")
+			break
+		}
+
+		cmd.ui.Say(apiResponse.Message)
+	}
+	return
+}
+
 func (cmd Login) setOrganization(c *cli.Context, userChanged bool) (err error) {
 	orgName := c.String("o")
 
@@ -155,7 +221,7 @@ func (cmd Login) setOrganization(c *cli.Context, userChanged bool) (err error) {
 		})
 
 		if apiResponse.IsNotSuccessful() {
-			err = errors.New(fmt.Sprintf("Error finding avilable orgs\n%s", apiResponse.Message))
+			err = errors.FromApiResponseWithMessage(apiResponse, "Error finding avilable orgs\n%s", apiResponse.Message)
 			return
 		}
 
@@ -163,6 +229,10 @@ func (cmd Login) setOrganization(c *cli.Context, userChanged bool) (err error) {
 			return cmd.targetOrganization(availableOrgs[0])
 		}
 
+		if !cmd.ui.IsInteractive() {
+			err = errors.NewMissingInputError("an org", "-o")
+			return
+		}
 		orgName = cmd.promptForOrgName(availableOrgs)
 	}
 
@@ -176,8 +246,7 @@ func (cmd Login) setOrganization(c *cli.Context, userChanged bool) (err error) {
 	var apiResponse net.ApiResponse
 	org, apiResponse = cmd.orgRepo.FindByName(orgName)
 	if apiResponse.IsNotSuccessful() {
-		err = errors.New(apiResponse.Message)
-		cmd.ui.Failed("Error finding org %s\n%s", terminal.EntityNameColor(orgName), err)
+		err = errors.FromApiResponseWithMessage(apiResponse, "Error finding org %s\n%s", terminal.EntityNameColor(orgName), apiResponse.Message)
 		return
 	}
 
@@ -219,8 +288,7 @@ func (cmd Login) setSpace(c *cli.Context, userChanged bool) (err error) {
 		})
 
 		if apiResponse.IsNotSuccessful() {
-			err = errors.New(fmt.Sprintf("Error finding available spaces\n%s", apiResponse.Message))
-			cmd.ui.Failed(err.Error())
+			err = errors.FromApiResponseWithMessage(apiResponse, "Error finding available spaces\n%s", apiResponse.Message)
 			return
 		}
 
@@ -229,6 +297,10 @@ func (cmd Login) setSpace(c *cli.Context, userChanged bool) (err error) {
 			return cmd.targetSpace(availableSpaces[0])
 		}
 
+		if !cmd.ui.IsInteractive() {
+			err = errors.NewMissingInputError("a space", "-s")
+			return
+		}
 		spaceName = cmd.promptForSpaceName(availableSpaces)
 	}
 
@@ -242,8 +314,7 @@ func (cmd Login) setSpace(c *cli.Context, userChanged bool) (err error) {
 	var apiResponse net.ApiResponse
 	space, apiResponse = cmd.spaceRepo.FindByName(spaceName)
 	if apiResponse.IsNotSuccessful() {
-		err = errors.New(fmt.Sprintf("Error finding space %s\n%s", terminal.EntityNameColor(spaceName), apiResponse.Message))
-		cmd.ui.Failed(err.Error())
+		err = errors.FromApiResponseWithMessage(apiResponse, "Error finding space %s\n%s", terminal.EntityNameColor(spaceName), apiResponse.Message)
 		return
 	}
 
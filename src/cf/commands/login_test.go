@@ -3,8 +3,10 @@ package commands_test
 import (
 	. "cf/commands"
 	"cf/configuration"
+	"cf/errors"
 	"cf/models"
 	"cf/net"
+	"cf/terminal"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	"strconv"
@@ -318,8 +320,9 @@ var _ = Describe("Testing with ginkgo", func() {
 		c.ui.Inputs = []string{"api.example.com", "password", "password2", "password3"}
 		c.authRepo.AuthError = true
 
-		callLogin(c)
+		err := callLogin(c)
 
+		Expect(errors.ExitCode(err)).To(Equal(errors.ExitCodeAuth))
 		Expect(c.Config.ApiEndpoint()).To(Equal("api.example.com"))
 		Expect(c.Config.OrganizationFields().Guid).To(BeEmpty())
 		Expect(c.Config.SpaceFields().Guid).To(BeEmpty())
@@ -331,6 +334,111 @@ var _ = Describe("Testing with ginkgo", func() {
 		})
 	})
 
+	It("fails instead of prompting for the password in non-interactive mode", func() {
+		c := setUpLoginTestContext()
+
+		c.Flags = []string{"-a", "api.example.com", "-u", "user@example.com"}
+		c.ui.SetOutputOptions(terminal.OutputOptions{NonInteractive: true})
+
+		callLogin(c)
+
+		Expect(c.ui.PasswordPrompts).To(BeEmpty())
+		Expect(c.authRepo.Email).To(BeEmpty())
+		testassert.SliceContains(c.ui.Outputs, testassert.Lines{
+			{"FAILED"},
+			{"Cannot prompt for the password in non-interactive mode", "-p"},
+		})
+	})
+
+	It("tries the password only once in non-interactive mode", func() {
+		c := setUpLoginTestContext()
+
+		c.Flags = []string{"-a", "api.example.com", "-u", "user@example.com", "-p", "wrong"}
+		c.ui.SetOutputOptions(terminal.OutputOptions{NonInteractive: true})
+		c.authRepo.AuthError = true
+
+		callLogin(c)
+
+		Expect(c.ui.PasswordPrompts).To(BeEmpty())
+		Expect(c.Config.AccessToken()).To(BeEmpty())
+		testassert.SliceContains(c.ui.Outputs, testassert.Lines{
+			{"FAILED"},
+			{"Unable to authenticate"},
+		})
+	})
+
+	It("fails instead of prompting for an org in non-interactive mode", func() {
+		c := setUpLoginTestContext()
+
+		org := models.Organization{}
+		org.Guid = "some-org-guid"
+		org.Name = "some-org"
+		c.orgRepo.Organizations = append(c.orgRepo.Organizations, org)
+
+		c.Flags = []string{"-a", "api.example.com", "-u", "user@example.com", "-p", "password"}
+		c.ui.SetOutputOptions(terminal.OutputOptions{NonInteractive: true})
+
+		callLogin(c)
+
+		Expect(c.Config.AccessToken()).To(Equal("my_access_token"))
+		Expect(c.Config.OrganizationFields().Guid).To(BeEmpty())
+		testassert.SliceContains(c.ui.Outputs, testassert.Lines{
+			{"FAILED"},
+			{"Cannot prompt for an org in non-interactive mode", "-o"},
+		})
+	})
+
+	It("logs in with a one-time passcode when --sso is given", func() {
+		c := setUpLoginTestContext()
+
+		c.Flags = []string{"-a", "api.example.com", "--sso", "-o", "my-org", "-s", "my-space"}
+		c.ui.Inputs = []string{"my-passcode"}
+		c.authRepo.PasscodeURL = "https://login.example.com/passcode"
+
+		callLogin(c)
+
+		testassert.SliceContains(c.ui.Outputs, testassert.Lines{
+			{"https://login.example.com/passcode"},
+			{"Authenticating..."},
+			{"OK"},
+		})
+		testassert.SliceContains(c.ui.PasswordPrompts, testassert.Lines{
+			{"One-time passcode"},
+		})
+		Expect(c.authRepo.Passcode).To(Equal("my-passcode"))
+		Expect(c.authRepo.Email).To(BeEmpty())
+
+		Expect(c.Config.AccessToken()).To(Equal("my_access_token"))
+		Expect(c.Config.OrganizationFields().Guid).To(Equal("my-org-guid"))
+		Expect(c.Config.SpaceFields().Guid).To(Equal("my-space-guid"))
+		Expect(c.ui.ShowConfigurationCalled).To(BeTrue())
+	})
+
+	It("fails when the authentication server does not offer passcodes", func() {
+		c := setUpLoginTestContext()
+
+		c.Flags = []string{"-a", "api.example.com", "--sso"}
+		c.authRepo.PasscodeURLMissing = true
+
+		callLogin(c)
+
+		testassert.SliceContains(c.ui.Outputs, testassert.Lines{
+			{"does not support one-time passcodes"},
+			{"FAILED"},
+		})
+		Expect(c.Config.AccessToken()).To(BeEmpty())
+	})
+
+	It("fails with usage when --sso is combined with a username or password", func() {
+		c := setUpLoginTestContext()
+
+		c.Flags = []string{"--sso", "-u", "user@example.com"}
+
+		callLogin(c)
+
+		Expect(c.ui.FailedWithUsage).To(BeTrue())
+	})
+
 	It("TestUnsuccessfullyLoggingInWithUpdateEndpointError", func() {
 		c := setUpLoginTestContext()
 
@@ -390,6 +498,33 @@ var _ = Describe("Testing with ginkgo", func() {
 		})
 	})
 
+	It("exits with the not found code when the org does not exist", func() {
+		c := setUpLoginTestContext()
+
+		c.Flags = []string{"-u", "user@example.com", "-o", "my-org", "-s", "my-space"}
+		c.ui.Inputs = []string{"api.example.com", "user@example.com", "password"}
+		c.orgRepo.FindByNameNotFound = true
+
+		err := callLogin(c)
+
+		Expect(errors.ExitCode(err)).To(Equal(errors.ExitCodeNotFound))
+		Expect(err.Error()).To(ContainSubstring("Error finding org"))
+		Expect(err.Error()).To(ContainSubstring("Org my-org not found"))
+	})
+
+	It("exits with the not found code when the space does not exist", func() {
+		c := setUpLoginTestContext()
+
+		c.Flags = []string{"-u", "user@example.com", "-o", "my-org", "-s", "my-space"}
+		c.ui.Inputs = []string{"api.example.com", "user@example.com", "password"}
+		c.spaceRepo.FindByNameNotFound = true
+
+		err := callLogin(c)
+
+		Expect(errors.ExitCode(err)).To(Equal(errors.ExitCodeNotFound))
+		Expect(err.Error()).To(ContainSubstring("Error finding space"))
+	})
+
 	It("TestSuccessfullyLoggingInWithoutTargetOrg", func() {
 		c := setUpLoginTestContext()
 
@@ -489,7 +624,7 @@ func setUpLoginTestContext() (c *LoginTestContext) {
 	return
 }
 
-func callLogin(c *LoginTestContext) {
+func callLogin(c *LoginTestContext) error {
 	l := NewLogin(c.ui, c.Config, c.authRepo, c.endpointRepo, c.orgRepo, c.spaceRepo)
-	testcmd.RunCommand(l, testcmd.NewContext("login", c.Flags), nil)
+	return testcmd.RunCommand(c.ui, l, testcmd.NewContext("login", c.Flags), nil)
 }
@@ -22,8 +22,9 @@ func (cmd Logout) GetRequirements(reqFactory requirements.Factory, c *cli.Contex
 	return
 }
 
-func (cmd Logout) Run(c *cli.Context) {
+func (cmd Logout) Run(c *cli.Context) (err error) {
 	cmd.ui.Say("Logging out...")
 	cmd.config.ClearSession()
 	cmd.ui.Ok()
+	return
 }
@@ -31,7 +31,7 @@ func callCreateOrg(args []string, reqFactory *testreq.FakeReqFactory, orgRepo *t
 
 	cmd := NewCreateOrg(fakeUI, config, orgRepo)
 
-	testcmd.RunCommand(cmd, ctxt, reqFactory)
+	testcmd.RunCommand(fakeUI, cmd, ctxt, reqFactory)
 	return
 }
 
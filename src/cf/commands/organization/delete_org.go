@@ -3,10 +3,10 @@ package organization
 import (
 	"cf/api"
 	"cf/configuration"
+	"cf/errors"
 	"cf/models"
 	"cf/requirements"
 	"cf/terminal"
-	"errors"
 	"github.com/codegangsta/cli"
 )
 
@@ -35,11 +35,16 @@ func (cmd *DeleteOrg) GetRequirements(reqFactory requirements.Factory, c *cli.Co
 	return
 }
 
-func (cmd *DeleteOrg) Run(c *cli.Context) {
+func (cmd *DeleteOrg) Run(c *cli.Context) (err error) {
 	orgName := c.Args()[0]
 
 	force := c.Bool("f")
 
+	if !force && !cmd.ui.IsInteractive() {
+		err = errors.NewMissingInputError("confirmation", "-f")
+		return
+	}
+
 	if !force {
 		response := cmd.ui.Confirm(
 			"Really delete org %s and everything associated with it?%s",
@@ -60,7 +65,7 @@ func (cmd *DeleteOrg) Run(c *cli.Context) {
 	org, apiResponse := cmd.orgRepo.FindByName(orgName)
 
 	if apiResponse.IsError() {
-		cmd.ui.Failed(apiResponse.Message)
+		err = errors.FromApiResponse(apiResponse)
 		return
 	}
 
@@ -72,7 +77,7 @@ func (cmd *DeleteOrg) Run(c *cli.Context) {
 
 	apiResponse = cmd.orgRepo.Delete(org.Guid)
 	if apiResponse.IsNotSuccessful() {
-		cmd.ui.Failed(apiResponse.Message)
+		err = errors.FromApiResponse(apiResponse)
 		return
 	}
 
@@ -45,7 +45,7 @@ var _ = Describe("Testing with ginkgo", func() {
 	It("TestDeleteOrgConfirmingWithY", func() {
 		ui.Inputs = []string{"y"}
 		cmd := NewDeleteOrg(ui, config, orgRepo)
-		testcmd.RunCommand(cmd, testcmd.NewContext("delete-org", []string{"org-to-delete"}), reqFactory)
+		testcmd.RunCommand(ui, cmd, testcmd.NewContext("delete-org", []string{"org-to-delete"}), reqFactory)
 
 		testassert.SliceContains(ui.Prompts, testassert.Lines{
 			{"Really delete"},
@@ -63,7 +63,7 @@ var _ = Describe("Testing with ginkgo", func() {
 		ui.Inputs = []string{"Yes"}
 
 		cmd := NewDeleteOrg(ui, config, orgRepo)
-		testcmd.RunCommand(cmd, testcmd.NewContext("delete-org", []string{"org-to-delete"}), reqFactory)
+		testcmd.RunCommand(ui, cmd, testcmd.NewContext("delete-org", []string{"org-to-delete"}), reqFactory)
 
 		testassert.SliceContains(ui.Prompts, testassert.Lines{
 			{"Really delete", "org-to-delete"},
@@ -87,7 +87,7 @@ var _ = Describe("Testing with ginkgo", func() {
 		ui.Inputs = []string{"Yes"}
 
 		cmd := NewDeleteOrg(ui, config, orgRepo)
-		testcmd.RunCommand(cmd, testcmd.NewContext("delete-org", []string{"org-to-delete"}), reqFactory)
+		testcmd.RunCommand(ui, cmd, testcmd.NewContext("delete-org", []string{"org-to-delete"}), reqFactory)
 
 		Expect(config.OrganizationFields()).To(Equal(models.OrganizationFields{}))
 		Expect(config.SpaceFields()).To(Equal(models.SpaceFields{}))
@@ -106,7 +106,7 @@ var _ = Describe("Testing with ginkgo", func() {
 		ui.Inputs = []string{"Yes"}
 
 		cmd := NewDeleteOrg(ui, config, orgRepo)
-		testcmd.RunCommand(cmd, testcmd.NewContext("delete-org", []string{"org-to-delete"}), reqFactory)
+		testcmd.RunCommand(ui, cmd, testcmd.NewContext("delete-org", []string{"org-to-delete"}), reqFactory)
 
 		Expect(config.OrganizationFields().Name).To(Equal("some-other-org"))
 		Expect(config.SpaceFields().Name).To(Equal("some-other-space"))
@@ -115,7 +115,7 @@ var _ = Describe("Testing with ginkgo", func() {
 	It("TestDeleteOrgWithForceOption", func() {
 		ui.Inputs = []string{"Yes"}
 		cmd := NewDeleteOrg(ui, config, orgRepo)
-		testcmd.RunCommand(cmd, testcmd.NewContext("delete-org", []string{"-f", "org-to-delete"}), reqFactory)
+		testcmd.RunCommand(ui, cmd, testcmd.NewContext("delete-org", []string{"-f", "org-to-delete"}), reqFactory)
 
 		Expect(len(ui.Prompts)).To(Equal(0))
 		testassert.SliceContains(ui.Outputs, testassert.Lines{
@@ -129,7 +129,7 @@ var _ = Describe("Testing with ginkgo", func() {
 	It("FailsWithUsage when 1st argument is omitted", func() {
 		ui.Inputs = []string{"Yes"}
 		cmd := NewDeleteOrg(ui, config, orgRepo)
-		testcmd.RunCommand(cmd, testcmd.NewContext("delete-org", []string{}), reqFactory)
+		testcmd.RunCommand(ui, cmd, testcmd.NewContext("delete-org", []string{}), reqFactory)
 		Expect(ui.FailedWithUsage).To(BeTrue())
 	})
 
@@ -138,7 +138,7 @@ var _ = Describe("Testing with ginkgo", func() {
 
 		ui.Inputs = []string{"y"}
 		cmd := NewDeleteOrg(ui, config, orgRepo)
-		testcmd.RunCommand(cmd, testcmd.NewContext("delete-org", []string{"org-to-delete"}), reqFactory)
+		testcmd.RunCommand(ui, cmd, testcmd.NewContext("delete-org", []string{"org-to-delete"}), reqFactory)
 
 		Expect(len(ui.Outputs)).To(Equal(3))
 		testassert.SliceContains(ui.Outputs, testassert.Lines{
@@ -3,6 +3,7 @@ package organization
 import (
 	"cf/api"
 	"cf/configuration"
+	"cf/errors"
 	"cf/models"
 	"cf/requirements"
 	"cf/terminal"
@@ -29,24 +30,30 @@ func (cmd ListOrgs) GetRequirements(reqFactory requirements.Factory, c *cli.Cont
 	return
 }
 
-func (cmd ListOrgs) Run(c *cli.Context) {
+func (cmd ListOrgs) Run(c *cli.Context) (err error) {
 	cmd.ui.Say("Getting orgs as %s...\n", terminal.EntityNameColor(cmd.config.Username()))
 
 	noOrgs := true
 	table := cmd.ui.Table([]string{"name"})
 
 	apiStatus := cmd.orgRepo.ListOrgs(func(org models.Organization) bool {
-		table.Print([][]string{{org.Name}})
+		table.Add(newOrgOutput(org.OrganizationFields), org.Name)
 		noOrgs = false
 		return true
 	})
 
 	if apiStatus.IsNotSuccessful() {
-		cmd.ui.Failed("Failed fetching orgs.\n%s", apiStatus.Message)
+		err = errors.FromApiResponseWithMessage(apiStatus, "Failed fetching orgs.\n%s", apiStatus.Message)
+		return
+	}
+
+	err = table.Print()
+	if err != nil {
 		return
 	}
 
 	if noOrgs {
 		cmd.ui.Say("No orgs found")
 	}
+	return
 }
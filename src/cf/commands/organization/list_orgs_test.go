@@ -18,7 +18,7 @@ func callListOrgs(config configuration.Reader, reqFactory *testreq.FakeReqFactor
 	fakeUI = &testterm.FakeUI{}
 	ctxt := testcmd.NewContext("orgs", []string{})
 	cmd := organization.NewListOrgs(fakeUI, config, orgRepo)
-	testcmd.RunCommand(cmd, ctxt, reqFactory)
+	testcmd.RunCommand(fakeUI, cmd, ctxt, reqFactory)
 	return
 }
 
@@ -3,6 +3,7 @@ package organization
 import (
 	"cf/api"
 	"cf/configuration"
+	"cf/errors"
 	"cf/formatters"
 	"cf/requirements"
 	"cf/terminal"
@@ -30,28 +31,26 @@ func (cmd *ListQuotas) GetRequirements(reqFactory requirements.Factory, c *cli.C
 	return
 }
 
-func (cmd *ListQuotas) Run(c *cli.Context) {
+func (cmd *ListQuotas) Run(c *cli.Context) (err error) {
 	cmd.ui.Say("Getting quotas as %s...", terminal.EntityNameColor(cmd.config.Username()))
 
 	quotas, apiResponse := cmd.quotaRepo.FindAll()
 
 	if apiResponse.IsNotSuccessful() {
-		cmd.ui.Failed(apiResponse.Message)
+		err = errors.FromApiResponse(apiResponse)
 		return
 	}
 	cmd.ui.Ok()
 	cmd.ui.Say("")
 
-	table := [][]string{
-		[]string{"name", "memory limit"},
-	}
-
+	table := cmd.ui.Table([]string{"name", "memory limit"})
 	for _, quota := range quotas {
-		table = append(table, []string{
+		table.Add(newQuotaOutput(quota),
 			quota.Name,
-			formatters.ByteSize(quota.MemoryLimit * formatters.MEGABYTE),
-		})
+			formatters.ByteSize(quota.MemoryLimit*formatters.MEGABYTE),
+		)
 	}
 
-	cmd.ui.DisplayTable(table)
+	err = table.Print()
+	return
 }
@@ -4,6 +4,7 @@ import (
 	"cf/commands/organization"
 	"cf/configuration"
 	"cf/models"
+	"cf/terminal"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	testapi "testhelpers/api"
@@ -15,7 +16,10 @@ import (
 )
 
 func callListQuotas(reqFactory *testreq.FakeReqFactory, quotaRepo *testapi.FakeQuotaRepository) (fakeUI *testterm.FakeUI) {
-	fakeUI = &testterm.FakeUI{}
+	return callListQuotasWithUI(&testterm.FakeUI{}, reqFactory, quotaRepo)
+}
+
+func callListQuotasWithUI(fakeUI *testterm.FakeUI, reqFactory *testreq.FakeReqFactory, quotaRepo *testapi.FakeQuotaRepository) *testterm.FakeUI {
 	ctxt := testcmd.NewContext("quotas", []string{})
 
 	spaceFields := models.SpaceFields{}
@@ -30,8 +34,8 @@ func callListQuotas(reqFactory *testreq.FakeReqFactory, quotaRepo *testapi.FakeQ
 	config.SetOrganizationFields(orgFields)
 
 	cmd := organization.NewListQuotas(fakeUI, config, quotaRepo)
-	testcmd.RunCommand(cmd, ctxt, reqFactory)
-	return
+	testcmd.RunCommand(fakeUI, cmd, ctxt, reqFactory)
+	return fakeUI
 }
 
 var _ = Describe("Testing with ginkgo", func() {
@@ -63,4 +67,19 @@ var _ = Describe("Testing with ginkgo", func() {
 			{"quota-name", "1g"},
 		})
 	})
+
+	It("prints the quotas when a structured output format is used", func() {
+		quota := models.QuotaFields{Guid: "quota-guid", Name: "quota-name", MemoryLimit: 1024}
+		quotaRepo := &testapi.FakeQuotaRepository{FindAllQuotas: []models.QuotaFields{quota}}
+
+		ui := &testterm.FakeUI{}
+		ui.SetOutputOptions(terminal.OutputOptions{Format: terminal.OutputJSON})
+		callListQuotasWithUI(ui, &testreq.FakeReqFactory{LoginSuccess: true}, quotaRepo)
+
+		testassert.SliceContains(ui.Outputs, testassert.Lines{
+			{`"guid": "quota-guid"`},
+			{`"name": "quota-name"`},
+			{`"memory_limit": 1024`},
+		})
+	})
 })
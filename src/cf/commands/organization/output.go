@@ -0,0 +1,51 @@
+package organization
+
+import "cf/models"
+
+// orgOutput is what structured output prints for each org listed by orgs
+type orgOutput struct {
+	Guid string `json:"guid"`
+	Name string `json:"name"`
+}
+
+// orgDetailsOutput adds what org shows about an org
+type orgDetailsOutput struct {
+	orgOutput
+	Quota       string   `json:"quota"`
+	MemoryLimit uint64   `json:"memory_limit"` // in megabytes
+	Spaces      []string `json:"spaces"`
+	Domains     []string `json:"domains"`
+}
+
+// quotaOutput is what structured output prints for each quota listed by quotas
+type quotaOutput struct {
+	Guid        string `json:"guid"`
+	Name        string `json:"name"`
+	MemoryLimit uint64 `json:"memory_limit"` // in megabytes
+}
+
+func newOrgOutput(org models.OrganizationFields) orgOutput {
+	return orgOutput{Guid: org.Guid, Name: org.Name}
+}
+
+func newOrgDetailsOutput(org models.Organization) (output orgDetailsOutput) {
+	output = orgDetailsOutput{
+		orgOutput:   newOrgOutput(org.OrganizationFields),
+		Quota:       org.QuotaDefinition.Name,
+		MemoryLimit: org.QuotaDefinition.MemoryLimit,
+		Spaces:      []string{},
+		Domains:     []string{},
+	}
+
+	for _, space := range org.Spaces {
+		output.Spaces = append(output.Spaces, space.Name)
+	}
+	for _, domain := range org.Domains {
+		output.Domains = append(output.Domains, domain.Name)
+	}
+	return
+}
+
+func newQuotaOutput(quota models.QuotaFields) quotaOutput {
+	return quotaOutput{Guid: quota.Guid, Name: quota.Name, MemoryLimit: quota.MemoryLimit}
+}
@@ -3,9 +3,9 @@ package organization
 import (
 	"cf/api"
 	"cf/configuration"
+	"cf/errors"
 	"cf/requirements"
 	"cf/terminal"
-	"errors"
 	"github.com/codegangsta/cli"
 )
 
@@ -38,7 +38,7 @@ func (cmd *RenameOrg) GetRequirements(reqFactory requirements.Factory, c *cli.Co
 	return
 }
 
-func (cmd *RenameOrg) Run(c *cli.Context) {
+func (cmd *RenameOrg) Run(c *cli.Context) (err error) {
 	org := cmd.orgReq.GetOrganization()
 	newName := c.Args()[1]
 
@@ -50,8 +50,9 @@ func (cmd *RenameOrg) Run(c *cli.Context) {
 
 	apiResponse := cmd.orgRepo.Rename(org.Guid, newName)
 	if apiResponse.IsNotSuccessful() {
-		cmd.ui.Failed(apiResponse.Message)
+		err = errors.FromApiResponse(apiResponse)
 		return
 	}
 	cmd.ui.Ok()
+	return
 }
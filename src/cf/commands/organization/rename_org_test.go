@@ -69,6 +69,6 @@ func callRenameOrg(args []string, reqFactory *testreq.FakeReqFactory, orgRepo *t
 	ctxt := testcmd.NewContext("rename-org", args)
 	configRepo := testconfig.NewRepositoryWithDefaults()
 	cmd := organization.NewRenameOrg(ui, configRepo, orgRepo)
-	testcmd.RunCommand(cmd, ctxt, reqFactory)
+	testcmd.RunCommand(ui, cmd, ctxt, reqFactory)
 	return
 }
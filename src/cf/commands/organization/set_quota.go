@@ -3,9 +3,9 @@ package organization
 import (
 	"cf/api"
 	"cf/configuration"
+	"cf/errors"
 	"cf/requirements"
 	"cf/terminal"
-	"errors"
 	"github.com/codegangsta/cli"
 )
 
@@ -40,13 +40,13 @@ func (cmd *SetQuota) GetRequirements(reqFactory requirements.Factory, c *cli.Con
 	return
 }
 
-func (cmd *SetQuota) Run(c *cli.Context) {
+func (cmd *SetQuota) Run(c *cli.Context) (err error) {
 	org := cmd.orgReq.GetOrganization()
 	quotaName := c.Args()[1]
 	quota, apiResponse := cmd.quotaRepo.FindByName(quotaName)
 
 	if apiResponse.IsNotSuccessful() {
-		cmd.ui.Failed(apiResponse.Message)
+		err = errors.FromApiResponse(apiResponse)
 		return
 	}
 
@@ -58,9 +58,10 @@ func (cmd *SetQuota) Run(c *cli.Context) {
 
 	apiResponse = cmd.quotaRepo.Update(org.Guid, quota.Guid)
 	if apiResponse.IsNotSuccessful() {
-		cmd.ui.Failed(apiResponse.Message)
+		err = errors.FromApiResponse(apiResponse)
 		return
 	}
 
 	cmd.ui.Ok()
+	return
 }
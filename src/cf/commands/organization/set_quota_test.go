@@ -95,6 +95,6 @@ func callSetQuota(args []string, reqFactory *testreq.FakeReqFactory, quotaRepo *
 	configRepo.SetOrganizationFields(orgFields)
 
 	cmd := organization.NewSetQuota(ui, configRepo, quotaRepo)
-	testcmd.RunCommand(cmd, ctxt, reqFactory)
+	testcmd.RunCommand(ui, cmd, ctxt, reqFactory)
 	return
 }
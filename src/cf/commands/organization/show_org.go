@@ -2,9 +2,9 @@ package organization
 
 import (
 	"cf/configuration"
+	"cf/errors"
 	"cf/requirements"
 	"cf/terminal"
-	"errors"
 	"fmt"
 	"github.com/codegangsta/cli"
 	"strings"
@@ -39,13 +39,19 @@ func (cmd *ShowOrg) GetRequirements(reqFactory requirements.Factory, c *cli.Cont
 	return
 }
 
-func (cmd *ShowOrg) Run(c *cli.Context) {
+func (cmd *ShowOrg) Run(c *cli.Context) (err error) {
 	org := cmd.orgReq.GetOrganization()
 	cmd.ui.Say("Getting info for org %s as %s...",
 		terminal.EntityNameColor(org.Name),
 		terminal.EntityNameColor(cmd.config.Username()),
 	)
 	cmd.ui.Ok()
+
+	if cmd.ui.OutputOptions().IsStructured() {
+		err = cmd.ui.PrintValue(newOrgDetailsOutput(org))
+		return
+	}
+
 	cmd.ui.Say("\n%s:", terminal.EntityNameColor(org.Name))
 
 	domains := []string{}
@@ -63,4 +69,5 @@ func (cmd *ShowOrg) Run(c *cli.Context) {
 	cmd.ui.Say("  domains: %s", terminal.EntityNameColor(strings.Join(domains, ", ")))
 	cmd.ui.Say("  quota:   %s", terminal.EntityNameColor(orgMemoryLimit))
 	cmd.ui.Say("  spaces:  %s", terminal.EntityNameColor(strings.Join(spaces, ", ")))
+	return
 }
@@ -92,6 +92,6 @@ func callShowOrg(args []string, reqFactory *testreq.FakeReqFactory) (ui *testter
 	configRepo.SetOrganizationFields(orgFields)
 
 	cmd := NewShowOrg(ui, configRepo)
-	testcmd.RunCommand(cmd, ctxt, reqFactory)
+	testcmd.RunCommand(ui, cmd, ctxt, reqFactory)
 	return
 }
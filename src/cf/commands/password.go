@@ -3,6 +3,7 @@ package commands
 import (
 	"cf/api"
 	"cf/configuration"
+	"cf/errors"
 	"cf/requirements"
 	"cf/terminal"
 	"github.com/codegangsta/cli"
@@ -28,13 +29,18 @@ func (cmd Password) GetRequirements(reqFactory requirements.Factory, c *cli.Cont
 	return
 }
 
-func (cmd Password) Run(c *cli.Context) {
+func (cmd Password) Run(c *cli.Context) (err error) {
+	if !cmd.ui.IsInteractive() {
+		err = errors.New("Cannot change the password in non-interactive mode, as it can only be entered at a prompt.")
+		return
+	}
+
 	oldPassword := cmd.ui.AskForPassword("Current Password%s", terminal.PromptColor(">"))
 	newPassword := cmd.ui.AskForPassword("New Password%s", terminal.PromptColor(">"))
 	verifiedPassword := cmd.ui.AskForPassword("Verify Password%s", terminal.PromptColor(">"))
 
 	if verifiedPassword != newPassword {
-		cmd.ui.Failed("Password verification does not match")
+		err = errors.New("Password verification does not match")
 		return
 	}
 
@@ -43,9 +49,9 @@ func (cmd Password) Run(c *cli.Context) {
 
 	if apiResponse.IsNotSuccessful() {
 		if apiResponse.StatusCode == 401 {
-			cmd.ui.Failed("Current password did not match")
+			err = errors.NewAuthError("Current password did not match")
 		} else {
-			cmd.ui.Failed(apiResponse.Message)
+			err = errors.FromApiResponse(apiResponse)
 		}
 		return
 	}
@@ -54,4 +60,5 @@ func (cmd Password) Run(c *cli.Context) {
 
 	cmd.config.ClearSession()
 	cmd.ui.Say("Please log in again")
+	return
 }
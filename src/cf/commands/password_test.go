@@ -119,7 +119,7 @@ func callPassword(inputs []string, deps passwordDeps) (ui *testterm.FakeUI) {
 
 	ctxt := testcmd.NewContext("passwd", []string{})
 	cmd := NewPassword(ui, deps.PwdRepo, deps.Config)
-	testcmd.RunCommand(cmd, ctxt, deps.ReqFactory)
+	testcmd.RunCommand(ui, cmd, ctxt, deps.ReqFactory)
 
 	return
 }
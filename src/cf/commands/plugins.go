@@ -0,0 +1,44 @@
+package commands
+
+import (
+	"cf/plugin"
+	"cf/requirements"
+	"cf/terminal"
+	"github.com/codegangsta/cli"
+)
+
+type ListPlugins struct {
+	ui         terminal.UI
+	pluginDirs []string
+}
+
+func NewListPlugins(ui terminal.UI, pluginDirs []string) (cmd ListPlugins) {
+	cmd.ui = ui
+	cmd.pluginDirs = pluginDirs
+	return
+}
+
+func (cmd ListPlugins) GetRequirements(reqFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
+	return
+}
+
+func (cmd ListPlugins) Run(c *cli.Context) (err error) {
+	cmd.ui.Say("Getting installed plugins...")
+
+	plugins := plugin.Find(cmd.pluginDirs)
+
+	cmd.ui.Ok()
+	cmd.ui.Say("")
+
+	if len(plugins) == 0 {
+		cmd.ui.Say("No plugins found")
+	}
+
+	table := cmd.ui.Table([]string{"name", "path"})
+	for _, installedPlugin := range plugins {
+		table.Add(installedPlugin, installedPlugin.Name, installedPlugin.Path)
+	}
+
+	err = table.Print()
+	return
+}
@@ -0,0 +1,81 @@
+package commands_test
+
+import (
+	. "cf/commands"
+	"cf/terminal"
+	"fileutils"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"io/ioutil"
+	"path/filepath"
+	"runtime"
+	testassert "testhelpers/assert"
+	testcmd "testhelpers/commands"
+	testreq "testhelpers/requirements"
+	testterm "testhelpers/terminal"
+)
+
+var _ = Describe("plugins command", func() {
+	It("lists the installed plugins", func() {
+		fileutils.TempDir("plugins", func(dir string, err error) {
+			Expect(err).NotTo(HaveOccurred())
+
+			name := "cf-foo"
+			if runtime.GOOS == "windows" {
+				name = name + ".exe"
+			}
+			err = ioutil.WriteFile(filepath.Join(dir, name), []byte("#!/bin/sh\n"), 0755)
+			Expect(err).NotTo(HaveOccurred())
+
+			ui := &testterm.FakeUI{}
+			cmd := NewListPlugins(ui, []string{dir})
+			testcmd.RunCommand(ui, cmd, testcmd.NewContext("plugins", []string{}), &testreq.FakeReqFactory{})
+
+			testassert.SliceContains(ui.Outputs, testassert.Lines{
+				{"Getting installed plugins"},
+				{"OK"},
+				{"name", "path"},
+				{"foo", filepath.Join(dir, name)},
+			})
+		})
+	})
+
+	It("prints the plugins when a structured output format is used", func() {
+		fileutils.TempDir("plugins", func(dir string, err error) {
+			Expect(err).NotTo(HaveOccurred())
+
+			name := "cf-foo"
+			if runtime.GOOS == "windows" {
+				name = name + ".exe"
+			}
+			err = ioutil.WriteFile(filepath.Join(dir, name), []byte("#!/bin/sh\n"), 0755)
+			Expect(err).NotTo(HaveOccurred())
+
+			ui := &testterm.FakeUI{}
+			ui.SetOutputOptions(terminal.OutputOptions{Format: terminal.OutputJSON})
+			cmd := NewListPlugins(ui, []string{dir})
+			testcmd.RunCommand(ui, cmd, testcmd.NewContext("plugins", []string{}), &testreq.FakeReqFactory{})
+
+			testassert.SliceContains(ui.Outputs, testassert.Lines{
+				{"["},
+				{`"name": "foo"`},
+				{`"path": "`, name},
+				{"]"},
+			})
+		})
+	})
+
+	It("says when no plugins are installed", func() {
+		fileutils.TempDir("plugins", func(dir string, err error) {
+			Expect(err).NotTo(HaveOccurred())
+
+			ui := &testterm.FakeUI{}
+			cmd := NewListPlugins(ui, []string{dir})
+			testcmd.RunCommand(ui, cmd, testcmd.NewContext("plugins", []string{}), &testreq.FakeReqFactory{})
+
+			testassert.SliceContains(ui.Outputs, testassert.Lines{
+				{"No plugins found"},
+			})
+		})
+	})
+})
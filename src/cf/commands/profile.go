@@ -0,0 +1,127 @@
+package commands
+
+import (
+	"cf"
+	"cf/configuration"
+	"cf/errors"
+	"cf/requirements"
+	"cf/terminal"
+	"github.com/codegangsta/cli"
+	"os"
+)
+
+type Profile struct {
+	ui     terminal.UI
+	config configuration.ReadWriter
+}
+
+func NewProfile(ui terminal.UI, config configuration.ReadWriter) (cmd Profile) {
+	cmd.ui = ui
+	cmd.config = config
+	return
+}
+
+func (cmd Profile) GetRequirements(reqFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
+	if len(c.Args()) != 2 {
+		err = errors.New("incorrect usage")
+		cmd.ui.FailWithUsage(c, "profile")
+		return
+	}
+
+	switch c.Args()[0] {
+	case "use", "create", "delete":
+	default:
+		err = errors.New("incorrect usage")
+		cmd.ui.FailWithUsage(c, "profile")
+	}
+	return
+}
+
+func (cmd Profile) Run(c *cli.Context) (err error) {
+	name := c.Args()[1]
+
+	switch c.Args()[0] {
+	case "create":
+		return cmd.create(name)
+	case "delete":
+		return cmd.delete(name, c.Bool("f"))
+	}
+	return cmd.use(name)
+}
+
+func (cmd Profile) exists(name string) bool {
+	_, found := cmd.config.Profiles()[name]
+	return found
+}
+
+func (cmd Profile) create(name string) (err error) {
+	cmd.ui.Say("Creating profile %s...", terminal.EntityNameColor(name))
+
+	if cmd.exists(name) {
+		cmd.ui.Ok()
+		cmd.ui.Warn("Profile %s already exists", name)
+	} else {
+		cmd.config.CreateProfile(name)
+		cmd.ui.Ok()
+	}
+
+	cmd.ui.Say("")
+	return cmd.use(name)
+}
+
+func (cmd Profile) delete(name string, force bool) (err error) {
+	if name == configuration.DefaultProfileName {
+		err = errors.New("The default profile cannot be deleted")
+		return
+	}
+
+	if !force && !cmd.ui.IsInteractive() {
+		err = errors.NewMissingInputError("confirmation", "-f")
+		return
+	}
+
+	if !force && !cmd.ui.Confirm("Really delete profile %s and the target and login it holds?%s",
+		terminal.EntityNameColor(name),
+		terminal.PromptColor(">"),
+	) {
+		return
+	}
+
+	cmd.ui.Say("Deleting profile %s...", terminal.EntityNameColor(name))
+
+	if !cmd.exists(name) {
+		cmd.ui.Ok()
+		cmd.ui.Warn("Profile %s does not exist.", name)
+		return
+	}
+
+	current := cmd.config.ProfileName() == name
+	cmd.config.DeleteProfile(name)
+	cmd.ui.Ok()
+
+	if current {
+		cmd.ui.Say("Switched back to the %s profile", terminal.EntityNameColor(configuration.DefaultProfileName))
+	}
+	return
+}
+
+func (cmd Profile) use(name string) (err error) {
+	cmd.ui.Say("Switching to profile %s...", terminal.EntityNameColor(name))
+
+	if !cmd.exists(name) {
+		err = errors.NewNotFoundError("Profile %s does not exist\nTIP: Use '%s' to create it",
+			name, terminal.CommandColor(cf.Name()+" profile create "+name))
+		return
+	}
+
+	cmd.config.SetCurrentProfile(name)
+	cmd.ui.Ok()
+
+	if envProfile := os.Getenv(configuration.CF_PROFILE); envProfile != "" && envProfile != name {
+		cmd.ui.Warn("%s is set to %s and overrides the current profile in this shell", configuration.CF_PROFILE, envProfile)
+	}
+
+	cmd.ui.Say("")
+	cmd.ui.ShowConfiguration(cmd.config)
+	return
+}
@@ -0,0 +1,127 @@
+package commands_test
+
+import (
+	. "cf/commands"
+	"cf/configuration"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	testassert "testhelpers/assert"
+	testcmd "testhelpers/commands"
+	testconfig "testhelpers/configuration"
+	testreq "testhelpers/requirements"
+	testterm "testhelpers/terminal"
+)
+
+func callProfile(args []string, config configuration.ReadWriter) (ui *testterm.FakeUI) {
+	ui = new(testterm.FakeUI)
+	cmd := NewProfile(ui, config)
+	testcmd.RunCommand(ui, cmd, testcmd.NewContext("profile", args), &testreq.FakeReqFactory{})
+	return
+}
+
+func callProfiles(config configuration.Reader) (ui *testterm.FakeUI) {
+	ui = new(testterm.FakeUI)
+	cmd := NewListProfiles(ui, config)
+	testcmd.RunCommand(ui, cmd, testcmd.NewContext("profiles", []string{}), &testreq.FakeReqFactory{})
+	return
+}
+
+var _ = Describe("profile commands", func() {
+	var config configuration.Repository
+
+	BeforeEach(func() {
+		config = testconfig.NewRepositoryWithDefaults()
+		config.SetApiEndpoint("https://api.dev.example.com")
+	})
+
+	It("fails with usage when not given 'use NAME', 'create NAME' or 'delete NAME'", func() {
+		ui := callProfile([]string{}, config)
+		Expect(ui.FailedWithUsage).To(BeTrue())
+
+		ui = callProfile([]string{"prod"}, config)
+		Expect(ui.FailedWithUsage).To(BeTrue())
+
+		ui = callProfile([]string{"remove", "prod"}, config)
+		Expect(ui.FailedWithUsage).To(BeTrue())
+	})
+
+	It("switches to the named profile", func() {
+		config.CreateProfile("prod")
+		ui := callProfile([]string{"use", "prod"}, config)
+
+		Expect(config.ProfileName()).To(Equal("prod"))
+		Expect(config.ApiEndpoint()).To(Equal(""))
+		Expect(ui.ShowConfigurationCalled).To(BeTrue())
+		testassert.SliceContains(ui.Outputs, testassert.Lines{
+			{"Switching to profile", "prod"},
+			{"OK"},
+		})
+	})
+
+	It("fails to switch to a profile that does not exist", func() {
+		ui := callProfile([]string{"use", "prdo"}, config)
+
+		Expect(config.ProfileName()).To(Equal(configuration.DefaultProfileName))
+		Expect(len(config.Profiles())).To(Equal(1))
+		testassert.SliceContains(ui.Outputs, testassert.Lines{
+			{"FAILED"},
+			{"Profile prdo does not exist"},
+			{"profile create prdo"},
+		})
+	})
+
+	It("creates a profile and switches to it", func() {
+		ui := callProfile([]string{"create", "prod"}, config)
+
+		Expect(config.ProfileName()).To(Equal("prod"))
+		Expect(config.ApiEndpoint()).To(Equal(""))
+		testassert.SliceContains(ui.Outputs, testassert.Lines{
+			{"Creating profile", "prod"},
+			{"OK"},
+			{"Switching to profile", "prod"},
+			{"OK"},
+		})
+	})
+
+	It("deletes a profile after confirmation, switching back to the default profile", func() {
+		callProfile([]string{"create", "prod"}, config)
+
+		ui := new(testterm.FakeUI)
+		ui.Inputs = []string{"y"}
+		testcmd.RunCommand(ui, NewProfile(ui, config), testcmd.NewContext("profile", []string{"delete", "prod"}), &testreq.FakeReqFactory{})
+
+		testassert.SliceContains(ui.Prompts, testassert.Lines{
+			{"Really delete profile", "prod"},
+		})
+		Expect(config.ProfileName()).To(Equal(configuration.DefaultProfileName))
+		Expect(len(config.Profiles())).To(Equal(1))
+		testassert.SliceContains(ui.Outputs, testassert.Lines{
+			{"Deleting profile", "prod"},
+			{"OK"},
+			{"Switched back to the", "default", "profile"},
+		})
+	})
+
+	It("does not delete the default profile", func() {
+		ui := callProfile([]string{"-f", "delete", configuration.DefaultProfileName}, config)
+
+		Expect(config.ApiEndpoint()).To(Equal("https://api.dev.example.com"))
+		testassert.SliceContains(ui.Outputs, testassert.Lines{
+			{"FAILED"},
+			{"default profile cannot be deleted"},
+		})
+	})
+
+	It("lists every profile and marks the current one", func() {
+		callProfile([]string{"create", "prod"}, config)
+		config.SetApiEndpoint("https://api.prod.example.com")
+
+		ui := callProfiles(config)
+
+		testassert.SliceContains(ui.Outputs, testassert.Lines{
+			{"name", "api endpoint", "user", "org", "space"},
+			{"default", "https://api.dev.example.com", "my-user", "my-org", "my-space"},
+			{"*", "prod", "https://api.prod.example.com"},
+		})
+	})
+})
@@ -0,0 +1,79 @@
+package commands
+
+import (
+	"cf/configuration"
+	"cf/requirements"
+	"cf/terminal"
+	"github.com/codegangsta/cli"
+	"sort"
+)
+
+// profiles hold tokens, so only this summary is printed in structured output
+type profileSummary struct {
+	Name        string `json:"name"`
+	Current     bool   `json:"current"`
+	ApiEndpoint string `json:"api_endpoint"`
+	User        string `json:"user"`
+	Org         string `json:"org"`
+	Space       string `json:"space"`
+}
+
+type ListProfiles struct {
+	ui     terminal.UI
+	config configuration.Reader
+}
+
+func NewListProfiles(ui terminal.UI, config configuration.Reader) (cmd ListProfiles) {
+	cmd.ui = ui
+	cmd.config = config
+	return
+}
+
+func (cmd ListProfiles) GetRequirements(reqFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
+	return
+}
+
+func (cmd ListProfiles) Run(c *cli.Context) (err error) {
+	cmd.ui.Say("Getting profiles...")
+	cmd.ui.Ok()
+	cmd.ui.Say("")
+
+	profiles := cmd.config.Profiles()
+	currentProfile := cmd.config.ProfileName()
+
+	names := []string{}
+	for name, _ := range profiles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	table := cmd.ui.Table([]string{"", "name", "api endpoint", "user", "org", "space"})
+	for _, name := range names {
+		profile := profiles[name]
+		summary := profileSummary{
+			Name:        name,
+			Current:     name == currentProfile,
+			ApiEndpoint: profile.Target,
+			User:        configuration.NewTokenInfo(profile.AccessToken).Username,
+			Org:         profile.OrganizationFields.Name,
+			Space:       profile.SpaceFields.Name,
+		}
+
+		marker := ""
+		if summary.Current {
+			marker = "*"
+		}
+
+		table.Add(summary,
+			marker,
+			summary.Name,
+			summary.ApiEndpoint,
+			summary.User,
+			summary.Org,
+			summary.Space,
+		)
+	}
+
+	err = table.Print()
+	return
+}
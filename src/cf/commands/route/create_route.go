@@ -3,11 +3,11 @@ package route
 import (
 	"cf/api"
 	"cf/configuration"
+	"cf/errors"
 	"cf/models"
 	"cf/net"
 	"cf/requirements"
 	"cf/terminal"
-	"errors"
 	"github.com/codegangsta/cli"
 )
 
@@ -54,16 +54,17 @@ func (cmd *CreateRoute) GetRequirements(reqFactory requirements.Factory, c *cli.
 	return
 }
 
-func (cmd *CreateRoute) Run(c *cli.Context) {
+func (cmd *CreateRoute) Run(c *cli.Context) (err error) {
 	hostName := c.String("n")
 	space := cmd.spaceReq.GetSpace()
 	domain := cmd.domainReq.GetDomain()
 
 	_, apiResponse := cmd.CreateRoute(hostName, domain, space.SpaceFields)
 	if apiResponse.IsNotSuccessful() {
-		cmd.ui.Failed(apiResponse.Message)
+		err = errors.FromApiResponse(apiResponse)
 		return
 	}
+	return
 }
 
 func (cmd *CreateRoute) CreateRoute(hostName string, domain models.DomainFields, space models.SpaceFields) (route models.Route, apiResponse net.ApiResponse) {
@@ -179,6 +179,6 @@ func callCreateRoute(args []string, reqFactory *testreq.FakeReqFactory, routeRep
 
 	cmd := NewCreateRoute(fakeUI, configRepo, routeRepo)
 
-	testcmd.RunCommand(cmd, ctxt, reqFactory)
+	testcmd.RunCommand(fakeUI, cmd, ctxt, reqFactory)
 	return
 }
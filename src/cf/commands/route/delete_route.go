@@ -3,9 +3,9 @@ package route
 import (
 	"cf/api"
 	"cf/configuration"
+	"cf/errors"
 	"cf/requirements"
 	"cf/terminal"
-	"errors"
 	"github.com/codegangsta/cli"
 )
 
@@ -37,7 +37,7 @@ func (cmd *DeleteRoute) GetRequirements(reqFactory requirements.Factory, c *cli.
 	return
 }
 
-func (cmd *DeleteRoute) Run(c *cli.Context) {
+func (cmd *DeleteRoute) Run(c *cli.Context) (err error) {
 	host := c.String("n")
 	domainName := c.Args()[0]
 
@@ -46,6 +46,11 @@ func (cmd *DeleteRoute) Run(c *cli.Context) {
 		url = host + "." + domainName
 	}
 	force := c.Bool("f")
+	if !force && !cmd.ui.IsInteractive() {
+		err = errors.NewMissingInputError("confirmation", "-f")
+		return
+	}
+
 	if !force {
 		response := cmd.ui.Confirm(
 			"Really delete route %s?%s",
@@ -62,7 +67,7 @@ func (cmd *DeleteRoute) Run(c *cli.Context) {
 
 	route, apiResponse := cmd.routeRepo.FindByHostAndDomain(host, domainName)
 	if apiResponse.IsError() {
-		cmd.ui.Failed(apiResponse.Message)
+		err = errors.FromApiResponse(apiResponse)
 		return
 	}
 	if apiResponse.IsNotFound() {
@@ -73,9 +78,10 @@ func (cmd *DeleteRoute) Run(c *cli.Context) {
 
 	apiResponse = cmd.routeRepo.Delete(route.Guid)
 	if apiResponse.IsNotSuccessful() {
-		cmd.ui.Failed(apiResponse.Message)
+		err = errors.FromApiResponse(apiResponse)
 		return
 	}
 
 	cmd.ui.Ok()
+	return
 }
@@ -121,6 +121,6 @@ func callDeleteRoute(confirmation string, args []string, reqFactory *testreq.Fak
 
 	cmd := NewDeleteRoute(ui, configRepo, routeRepo)
 
-	testcmd.RunCommand(cmd, ctxt, reqFactory)
+	testcmd.RunCommand(ui, cmd, ctxt, reqFactory)
 	return
 }
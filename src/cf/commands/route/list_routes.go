@@ -3,6 +3,7 @@ package route
 import (
 	"cf/api"
 	"cf/configuration"
+	"cf/errors"
 	"cf/models"
 	"cf/requirements"
 	"cf/terminal"
@@ -10,6 +11,14 @@ import (
 	"strings"
 )
 
+// routeOutput is what structured output prints for a route
+type routeOutput struct {
+	Guid   string   `json:"guid"`
+	Host   string   `json:"host"`
+	Domain string   `json:"domain"`
+	Apps   []string `json:"apps"`
+}
+
 type ListRoutes struct {
 	ui        terminal.UI
 	routeRepo api.RouteRepository
@@ -28,7 +37,7 @@ func (cmd ListRoutes) GetRequirements(reqFactory requirements.Factory, c *cli.Co
 	return
 }
 
-func (cmd ListRoutes) Run(c *cli.Context) {
+func (cmd ListRoutes) Run(c *cli.Context) (err error) {
 	cmd.ui.Say("Getting routes as %s ...\n",
 		terminal.EntityNameColor(cmd.config.Username()),
 	)
@@ -37,26 +46,36 @@ func (cmd ListRoutes) Run(c *cli.Context) {
 
 	noRoutes := true
 	apiResponse := cmd.routeRepo.ListRoutes(func(route models.Route) bool {
-		appNames := ""
+		output := routeOutput{
+			Guid:   route.Guid,
+			Host:   route.Host,
+			Domain: route.Domain.Name,
+			Apps:   []string{},
+		}
 		for _, app := range route.Apps {
-			appNames = appNames + ", " + app.Name
+			output.Apps = append(output.Apps, app.Name)
 		}
-		appNames = strings.TrimPrefix(appNames, ", ")
-		table.Print([][]string{{
+		table.Add(output,
 			route.Host,
 			route.Domain.Name,
-			appNames,
-		}})
+			strings.Join(output.Apps, ", "),
+		)
 		noRoutes = false
 		return true
 	})
 
 	if apiResponse.IsNotSuccessful() {
-		cmd.ui.Failed("Failed fetching routes.\n%s", apiResponse.Message)
+		err = errors.FromApiResponseWithMessage(apiResponse, "Failed fetching routes.\n%s", apiResponse.Message)
+		return
+	}
+
+	err = table.Print()
+	if err != nil {
 		return
 	}
 
 	if noRoutes {
 		cmd.ui.Say("No routes found")
 	}
+	return
 }
@@ -90,7 +90,7 @@ func callListRoutes(args []string, reqFactory *testreq.FakeReqFactory, routeRepo
 	ctxt := testcmd.NewContext("routes", args)
 	configRepo := testconfig.NewRepositoryWithDefaults()
 	cmd := NewListRoutes(ui, configRepo, routeRepo)
-	testcmd.RunCommand(cmd, ctxt, reqFactory)
+	testcmd.RunCommand(ui, cmd, ctxt, reqFactory)
 
 	return
 }
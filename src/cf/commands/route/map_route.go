@@ -3,9 +3,9 @@ package route
 import (
 	"cf/api"
 	"cf/configuration"
+	"cf/errors"
 	"cf/requirements"
 	"cf/terminal"
-	"errors"
 	"github.com/codegangsta/cli"
 )
 
@@ -48,14 +48,15 @@ func (cmd *MapRoute) GetRequirements(reqFactory requirements.Factory, c *cli.Con
 	return
 }
 
-func (cmd *MapRoute) Run(c *cli.Context) {
+func (cmd *MapRoute) Run(c *cli.Context) (err error) {
 	hostName := c.String("n")
 	domain := cmd.domainReq.GetDomain()
 	app := cmd.appReq.GetApplication()
 
 	route, apiResponse := cmd.routeCreator.CreateRoute(hostName, domain, cmd.config.SpaceFields())
 	if apiResponse.IsNotSuccessful() {
-		cmd.ui.Failed("Error resolving route:\n%s", apiResponse.Message)
+		err = errors.FromApiResponseWithMessage(apiResponse, "Error resolving route:\n%s", apiResponse.Message)
+		return
 	}
 	cmd.ui.Say("Adding route %s to app %s in org %s / space %s as %s...",
 		terminal.EntityNameColor(route.URL()),
@@ -67,9 +68,10 @@ func (cmd *MapRoute) Run(c *cli.Context) {
 
 	apiResponse = cmd.routeRepo.Bind(route.Guid, app.Guid)
 	if apiResponse.IsNotSuccessful() {
-		cmd.ui.Failed(apiResponse.Message)
+		err = errors.FromApiResponse(apiResponse)
 		return
 	}
 
 	cmd.ui.Ok()
+	return
 }
@@ -20,7 +20,7 @@ func callMapRoute(args []string, reqFactory *testreq.FakeReqFactory, routeRepo *
 
 	configRepo := testconfig.NewRepositoryWithDefaults()
 	cmd := NewMapRoute(ui, configRepo, routeRepo, createRoute)
-	testcmd.RunCommand(cmd, ctxt, reqFactory)
+	testcmd.RunCommand(ui, cmd, ctxt, reqFactory)
 	return
 }
 
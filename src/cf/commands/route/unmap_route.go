@@ -3,9 +3,9 @@ package route
 import (
 	"cf/api"
 	"cf/configuration"
+	"cf/errors"
 	"cf/requirements"
 	"cf/terminal"
-	"errors"
 	"github.com/codegangsta/cli"
 )
 
@@ -46,14 +46,15 @@ func (cmd *UnmapRoute) GetRequirements(reqFactory requirements.Factory, c *cli.C
 	return
 }
 
-func (cmd *UnmapRoute) Run(c *cli.Context) {
+func (cmd *UnmapRoute) Run(c *cli.Context) (err error) {
 	hostName := c.String("n")
 	domain := cmd.domainReq.GetDomain()
 	app := cmd.appReq.GetApplication()
 
 	route, apiResponse := cmd.routeRepo.FindByHostAndDomain(hostName, domain.Name)
 	if apiResponse.IsNotSuccessful() {
-		cmd.ui.Failed(apiResponse.Message)
+		err = errors.FromApiResponse(apiResponse)
+		return
 	}
 	cmd.ui.Say("Removing route %s from app %s in org %s / space %s as %s...",
 		terminal.EntityNameColor(route.URL()),
@@ -65,9 +66,10 @@ func (cmd *UnmapRoute) Run(c *cli.Context) {
 
 	apiResponse = cmd.routeRepo.Unbind(route.Guid, app.Guid)
 	if apiResponse.IsNotSuccessful() {
-		cmd.ui.Failed(apiResponse.Message)
+		err = errors.FromApiResponse(apiResponse)
 		return
 	}
 
 	cmd.ui.Ok()
+	return
 }
@@ -77,6 +77,6 @@ func callUnmapRoute(args []string, reqFactory *testreq.FakeReqFactory, routeRepo
 
 	configRepo := testconfig.NewRepositoryWithDefaults()
 	cmd := NewUnmapRoute(ui, configRepo, routeRepo)
-	testcmd.RunCommand(cmd, ctxt, reqFactory)
+	testcmd.RunCommand(ui, cmd, ctxt, reqFactory)
 	return
 }
@@ -0,0 +1,97 @@
+package commands
+
+import (
+	"cf/api"
+	"cf/configuration"
+	"cf/errors"
+	"cf/net"
+	"cf/plugin"
+	"cf/requirements"
+	"cf/terminal"
+	"github.com/codegangsta/cli"
+	"os"
+	"os/exec"
+	"syscall"
+)
+
+// RunPlugin runs a cf-NAME plugin, telling it where cf is targeted through
+// the environment
+type RunPlugin struct {
+	ui       terminal.UI
+	config   configuration.Reader
+	authRepo api.AuthenticationRepository
+	plugin   plugin.Plugin
+}
+
+func NewRunPlugin(ui terminal.UI, config configuration.Reader, authRepo api.AuthenticationRepository, plugin plugin.Plugin) (cmd RunPlugin) {
+	cmd.ui = ui
+	cmd.config = config
+	cmd.authRepo = authRepo
+	cmd.plugin = plugin
+	return
+}
+
+func (cmd RunPlugin) GetRequirements(reqFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
+	return
+}
+
+func (cmd RunPlugin) Run(c *cli.Context) (err error) {
+	env, err := cmd.pluginEnv()
+	if err != nil {
+		return
+	}
+
+	// plugins run as unknown commands, whose arguments start with their name
+	args := c.Args()
+	if len(args) > 0 {
+		args = args[1:]
+	}
+
+	process := exec.Command(cmd.plugin.Path, args...)
+	process.Env = append(os.Environ(), env...)
+	process.Stdin = os.Stdin
+	process.Stdout = os.Stdout
+	process.Stderr = os.Stderr
+
+	runErr := process.Run()
+	if runErr == nil {
+		return
+	}
+
+	exitErr, ok := runErr.(*exec.ExitError)
+	if !ok {
+		err = errors.New("Error running plugin %s: %s", cmd.plugin.Path, runErr.Error())
+		return
+	}
+
+	code := errors.ExitCodeGeneral
+	if status, ok := exitErr.Sys().(syscall.WaitStatus); ok {
+		code = status.ExitStatus()
+	}
+	err = errors.NewPluginError(code, "Plugin %s exited with code %d", cmd.plugin.Name, code)
+	return
+}
+
+func (cmd RunPlugin) pluginEnv() (env []string, err error) {
+	accessToken := ""
+	if cmd.config.IsLoggedIn() {
+		var apiResponse net.ApiResponse
+		accessToken, apiResponse = cmd.authRepo.RefreshAuthTokenIfExpiring(cmd.config.AccessToken())
+		if apiResponse.IsNotSuccessful() {
+			err = errors.FromApiResponseWithMessage(apiResponse, "Error refreshing the access token for plugin %s: %s", cmd.plugin.Name, apiResponse.Message)
+			return
+		}
+	}
+
+	env = []string{
+		"CF_PLUGIN_CLI=" + os.Args[0],
+		"CF_PLUGIN_API_ENDPOINT=" + cmd.config.ApiEndpoint(),
+		"CF_PLUGIN_ACCESS_TOKEN=" + accessToken,
+		"CF_PLUGIN_USERNAME=" + cmd.config.Username(),
+		"CF_PLUGIN_ORG=" + cmd.config.OrganizationFields().Name,
+		"CF_PLUGIN_ORG_GUID=" + cmd.config.OrganizationFields().Guid,
+		"CF_PLUGIN_SPACE=" + cmd.config.SpaceFields().Name,
+		"CF_PLUGIN_SPACE_GUID=" + cmd.config.SpaceFields().Guid,
+	}
+	return
+}
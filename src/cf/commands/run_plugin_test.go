@@ -0,0 +1,77 @@
+package commands_test
+
+import (
+	. "cf/commands"
+	"cf/errors"
+	"cf/plugin"
+	"fileutils"
+	"flag"
+	"github.com/codegangsta/cli"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"io/ioutil"
+	"path/filepath"
+	"runtime"
+	testapi "testhelpers/api"
+	testcmd "testhelpers/commands"
+	testconfig "testhelpers/configuration"
+	testreq "testhelpers/requirements"
+	testterm "testhelpers/terminal"
+)
+
+// runPluginScript runs a shell script as the foo plugin, the way unknown
+// commands are run, and returns what the script wrote to $OUT
+func runPluginScript(script string, args ...string) (output string, err error) {
+	fileutils.TempDir("run_plugin", func(dir string, tmpErr error) {
+		Expect(tmpErr).NotTo(HaveOccurred())
+
+		outPath := filepath.Join(dir, "out")
+		pluginPath := filepath.Join(dir, "cf-foo")
+		tmpErr = ioutil.WriteFile(pluginPath, []byte("#!/bin/sh\nOUT="+outPath+"\n"+script), 0755)
+		Expect(tmpErr).NotTo(HaveOccurred())
+
+		flagSet := new(flag.FlagSet)
+		flagSet.Parse(append([]string{"foo"}, args...))
+		ctxt := cli.NewContext(cli.NewApp(), flagSet, flagSet)
+
+		ui := &testterm.FakeUI{}
+		config := testconfig.NewRepositoryWithDefaults()
+		authRepo := &testapi.FakeAuthenticationRepository{}
+		cmd := NewRunPlugin(ui, config, authRepo, plugin.Plugin{Name: "foo", Path: pluginPath})
+		err = testcmd.RunCommand(ui, cmd, ctxt, &testreq.FakeReqFactory{})
+		Expect(authRepo.RefreshTokenIfExpiringCalled).To(BeTrue())
+
+		data, _ := ioutil.ReadFile(outPath)
+		output = string(data)
+	})
+	return
+}
+
+var _ = Describe("running plugins", func() {
+	if runtime.GOOS == "windows" {
+		return
+	}
+
+	It("passes the arguments following the command name", func() {
+		output, err := runPluginScript(`echo "$@" > $OUT`, "bar", "--baz")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(output).To(Equal("bar --baz\n"))
+	})
+
+	It("passes the target of cf in the environment", func() {
+		output, err := runPluginScript(`echo "$CF_PLUGIN_ORG $CF_PLUGIN_SPACE_GUID $CF_PLUGIN_USERNAME" > $OUT`)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(output).To(Equal("my-org my-space-guid my-user\n"))
+	})
+
+	It("passes a fresh access token", func() {
+		output, err := runPluginScript(`echo "$CF_PLUGIN_ACCESS_TOKEN" > $OUT`)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(output).To(ContainSubstring("BEARER my_access_token"))
+	})
+
+	It("exits with the exit code of the plugin", func() {
+		_, err := runPluginScript(`exit 7`)
+		Expect(errors.ExitCode(err)).To(Equal(7))
+	})
+})
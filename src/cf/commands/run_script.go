@@ -0,0 +1,153 @@
+package commands
+
+import (
+	"bufio"
+	"cf/configuration"
+	"cf/errors"
+	"cf/requirements"
+	"cf/terminal"
+	"fmt"
+	"github.com/codegangsta/cli"
+	"io"
+	"os"
+	"strings"
+)
+
+type RunScript struct {
+	ui         terminal.UI
+	config     configuration.Reader
+	cmdFactory Factory
+	reqFactory requirements.Factory
+}
+
+type scriptLine struct {
+	Number int
+	Text   string
+}
+
+type scriptFailure struct {
+	Line scriptLine
+	Err  error
+}
+
+func NewRunScript(ui terminal.UI, config configuration.Reader, cmdFactory Factory) (cmd *RunScript) {
+	cmd = new(RunScript)
+	cmd.ui = ui
+	cmd.config = config
+	cmd.cmdFactory = cmdFactory
+	return
+}
+
+func (cmd *RunScript) GetRequirements(reqFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
+	if len(c.Args()) > 1 {
+		err = errors.New("Incorrect Usage")
+		cmd.ui.FailWithUsage(c, "run-script")
+		return
+	}
+
+	// the commands of the script check their own requirements
+	cmd.reqFactory = reqFactory
+	return
+}
+
+func (cmd *RunScript) Run(c *cli.Context) (err error) {
+	source := "stdin"
+	var reader io.Reader = os.Stdin
+	if len(c.Args()) == 1 && c.Args()[0] != "-" {
+		source = c.Args()[0]
+		file, openErr := os.Open(source)
+		if openErr != nil {
+			err = errors.New("Error opening script %s\n%s", source, openErr.Error())
+			return
+		}
+		defer file.Close()
+		reader = file
+	}
+
+	lines, err := readScriptLines(reader)
+	if err != nil {
+		err = errors.New("Error reading script %s\n%s", source, err.Error())
+		return
+	}
+
+	runner := newCommandLineRunner(cmd.ui, cmd.config, NewRunner(cmd.ui, cmd.cmdFactory, cmd.reqFactory), c, "scripts", map[string]string{
+		"run-script": "Scripts cannot run other scripts",
+		"shell":      "Scripts cannot start a shell",
+	})
+	continueOnError := c.Bool("continue-on-error")
+
+	ran := 0
+	failures := []scriptFailure{}
+	for _, line := range lines {
+		cmd.ui.Say("")
+		cmd.ui.Say("%s", terminal.HeaderColor(fmt.Sprintf("%s:%d: %s", source, line.Number, line.Text)))
+
+		ran++
+		lineErr := runner.Run(line.Text)
+		if lineErr == nil {
+			continue
+		}
+
+		failures = append(failures, scriptFailure{Line: line, Err: lineErr})
+		if !continueOnError {
+			break
+		}
+	}
+
+	cmd.ui.Say("")
+	if len(failures) == 0 {
+		cmd.ui.Ok()
+		cmd.ui.Say("Ran %d commands from %s", ran, source)
+		return
+	}
+
+	cmd.ui.Say("Ran %d of %d commands from %s, %d failed:", ran, len(lines), source, len(failures))
+	for _, failure := range failures {
+		cmd.ui.Say("   line %d: %s", failure.Line.Number, failure.Line.Text)
+		cmd.ui.Say("      %s", firstLine(failure.Err.Error()))
+	}
+
+	err = errors.New("%d of %d commands in %s failed", len(failures), ran, source)
+	return
+}
+
+// readScriptLines skips blank lines and # comments, and joins lines ending
+// in a backslash with the next one
+func readScriptLines(reader io.Reader) (lines []scriptLine, err error) {
+	scanner := bufio.NewScanner(reader)
+
+	number := 0
+	var current *scriptLine
+	for scanner.Scan() {
+		number++
+		text := strings.TrimSpace(scanner.Text())
+
+		if current == nil {
+			if text == "" || strings.HasPrefix(text, "#") {
+				continue
+			}
+			current = &scriptLine{Number: number}
+		}
+
+		if strings.HasSuffix(text, "\\") {
+			current.Text += strings.TrimSpace(strings.TrimSuffix(text, "\\")) + " "
+			continue
+		}
+
+		current.Text += text
+		lines = append(lines, *current)
+		current = nil
+	}
+
+	if current != nil {
+		current.Text = strings.TrimSpace(current.Text)
+		lines = append(lines, *current)
+	}
+
+	err = scanner.Err()
+	return
+}
+
+func firstLine(message string) string {
+	return strings.SplitN(message, "\n", 2)[0]
+}
@@ -0,0 +1,140 @@
+package commands_test
+
+import (
+	"cf/app"
+	. "cf/commands"
+	"cf/errors"
+	"cf/requirements"
+	"fileutils"
+	"flag"
+	"github.com/codegangsta/cli"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"os"
+	testassert "testhelpers/assert"
+	testcmd "testhelpers/commands"
+	testconfig "testhelpers/configuration"
+	testreq "testhelpers/requirements"
+	testterm "testhelpers/terminal"
+)
+
+type scriptCommand struct {
+	name    string
+	factory *scriptCommandFactory
+}
+
+func (cmd scriptCommand) GetRequirements(reqFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
+	return
+}
+
+func (cmd scriptCommand) Run(c *cli.Context) (err error) {
+	cmd.factory.Ran = append(cmd.factory.Ran, append([]string{cmd.name}, c.Args()...))
+	if c.IsSet("i") {
+		cmd.factory.Instances = c.Int("i")
+	}
+	return cmd.factory.Errors[cmd.name]
+}
+
+// scriptCommandFactory records the commands run by scripts
+type scriptCommandFactory struct {
+	Ran       [][]string
+	Instances int
+	Errors    map[string]error
+}
+
+func (f *scriptCommandFactory) GetByCmdName(cmdName string) (cmd Command, err error) {
+	return scriptCommand{name: cmdName, factory: f}, nil
+}
+
+func (f *scriptCommandFactory) CommandNames() []string {
+	return nil
+}
+
+var _ = Describe("run-script command", func() {
+	var (
+		ui         *testterm.FakeUI
+		cmdFactory *scriptCommandFactory
+	)
+
+	BeforeEach(func() {
+		ui = &testterm.FakeUI{}
+		cmdFactory = &scriptCommandFactory{Errors: map[string]error{}}
+	})
+
+	runScript := func(script string, args ...string) (err error) {
+		fileutils.TempFile("run_script", func(file *os.File, tmpErr error) {
+			Expect(tmpErr).NotTo(HaveOccurred())
+			file.WriteString(script)
+
+			cfApp, tmpErr := app.NewApp(NewRunner(ui, ConcreteFactory{}, &testreq.FakeReqFactory{}))
+			Expect(tmpErr).NotTo(HaveOccurred())
+
+			flagSet := new(flag.FlagSet)
+			flagSet.Bool("continue-on-error", false, "")
+			flagSet.Parse(append(args, file.Name()))
+			ctxt := cli.NewContext(cfApp, flagSet, new(flag.FlagSet))
+
+			config := testconfig.NewRepository()
+			config.SetAlias("lp", "logs --recent")
+
+			cmd := NewRunScript(ui, config, cmdFactory)
+			err = testcmd.RunCommand(ui, cmd, ctxt, &testreq.FakeReqFactory{})
+		})
+		return
+	}
+
+	It("runs each command of the script", func() {
+		err := runScript(`
+# bootstrap
+cf create-space dev
+create-service cleardb spark "my db"
+push my-app \
+  -i 3
+`)
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cmdFactory.Ran).To(Equal([][]string{
+			{"create-space", "dev"},
+			{"create-service", "cleardb", "spark", "my db"},
+			{"push", "my-app"},
+		}))
+		Expect(cmdFactory.Instances).To(Equal(3))
+		testassert.SliceContains(ui.Outputs, testassert.Lines{
+			{"Ran 3 commands"},
+		})
+	})
+
+	It("expands aliases", func() {
+		err := runScript("lp my-app\n")
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cmdFactory.Ran).To(Equal([][]string{{"logs", "my-app"}}))
+	})
+
+	It("stops at the first failure", func() {
+		cmdFactory.Errors["create-space"] = errors.New("Space dev already exists")
+		err := runScript("create-space dev\ncreate-service cleardb spark my-db\n")
+
+		Expect(err).To(HaveOccurred())
+		Expect(len(cmdFactory.Ran)).To(Equal(1))
+		testassert.SliceContains(ui.Outputs, testassert.Lines{
+			{"Ran 1 of 2 commands", "1 failed"},
+			{"line 1: create-space dev"},
+			{"Space dev already exists"},
+		})
+	})
+
+	It("runs the remaining commands with --continue-on-error", func() {
+		cmdFactory.Errors["create-space"] = errors.New("Space dev already exists")
+		err := runScript("create-space dev\ncreate-service cleardb spark my-db\npush my-app --bogus-flag\n", "--continue-on-error")
+
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("2 of 3 commands"))
+		Expect(len(cmdFactory.Ran)).To(Equal(2))
+		testassert.SliceContains(ui.Outputs, testassert.Lines{
+			{"Ran 3 of 3 commands", "2 failed"},
+			{"line 1: create-space dev"},
+			{"line 3: push my-app --bogus-flag"},
+		})
+	})
+})
@@ -1,16 +1,15 @@
 package commands
 
 import (
+	"cf/errors"
 	"cf/requirements"
-	"errors"
-	"fmt"
+	"cf/terminal"
 	"github.com/codegangsta/cli"
-	"os"
 )
 
 type Command interface {
 	GetRequirements(reqFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error)
-	Run(c *cli.Context)
+	Run(c *cli.Context) (err error)
 }
 
 type Runner interface {
@@ -18,37 +17,58 @@ type Runner interface {
 }
 
 type ConcreteRunner struct {
+	ui         terminal.UI
 	cmdFactory Factory
 	reqFactory requirements.Factory
 }
 
-func NewRunner(cmdFactory Factory, reqFactory requirements.Factory) (runner ConcreteRunner) {
+func NewRunner(ui terminal.UI, cmdFactory Factory, reqFactory requirements.Factory) (runner ConcreteRunner) {
+	runner.ui = ui
 	runner.cmdFactory = cmdFactory
 	runner.reqFactory = reqFactory
 	return
 }
 
+// RunCmdByName runs a command and reports its failure, if any. The returned
+// error decides the exit code of cf.
 func (runner ConcreteRunner) RunCmdByName(cmdName string, c *cli.Context) (err error) {
 	cmd, err := runner.cmdFactory.GetByCmdName(cmdName)
 	if err != nil {
-		fmt.Printf("Error finding command %s\n", cmdName)
-		os.Exit(1)
+		knownNames := append(runner.cmdFactory.CommandNames(), appCommandNames(c.App)...)
+		err = NewUnknownCommandError(cmdName, knownNames)
+		runner.ui.Failed(err.Error())
 		return
 	}
 
+	outputOptions, err := terminal.NewOutputOptions(c)
+	if err != nil {
+		err = errors.NewUsageError(err.Error())
+		runner.ui.Failed(err.Error())
+		return
+	}
+	runner.ui.SetOutputOptions(outputOptions)
+
 	requirements, err := cmd.GetRequirements(runner.reqFactory, c)
 	if err != nil {
+		// commands show their usage when they return an error here
+		err = errors.NewUsageError(err.Error())
 		return
 	}
 
 	for _, requirement := range requirements {
-		success := requirement.Execute()
-		if !success {
-			err = errors.New("Error in requirement")
+		err = requirement.Execute()
+		if err != nil {
+			runner.ui.Failed(err.Error())
 			return
 		}
 	}
 
-	cmd.Run(c)
+	err = cmd.Run(c)
+	switch err.(type) {
+	case nil, *errors.UsageError, *errors.PluginError:
+		// usage was shown already, and plugins report their own failures
+	default:
+		runner.ui.Failed(err.Error())
+	}
 	return
 }
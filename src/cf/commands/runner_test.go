@@ -1,27 +1,42 @@
 package commands_test
 
 import (
+	"cf/app"
 	. "cf/commands"
+	"cf/errors"
 	"cf/requirements"
+	"flag"
 	"github.com/codegangsta/cli"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	"strings"
+	testassert "testhelpers/assert"
 	testcmd "testhelpers/commands"
+	testterm "testhelpers/terminal"
 )
 
 type TestCommandFactory struct {
 	Cmd     Command
 	CmdName string
+	Names   []string
 }
 
 func (f *TestCommandFactory) GetByCmdName(cmdName string) (cmd Command, err error) {
 	f.CmdName = cmdName
 	cmd = f.Cmd
+	if cmd == nil {
+		err = errors.New("Command not found")
+	}
 	return
 }
 
+func (f *TestCommandFactory) CommandNames() []string {
+	return f.Names
+}
+
 type TestCommand struct {
 	Reqs       []requirements.Requirement
+	RunErr     error
 	WasRunWith *cli.Context
 }
 
@@ -30,8 +45,9 @@ func (cmd *TestCommand) GetRequirements(factory requirements.Factory, c *cli.Con
 	return
 }
 
-func (cmd *TestCommand) Run(c *cli.Context) {
+func (cmd *TestCommand) Run(c *cli.Context) (err error) {
 	cmd.WasRunWith = c
+	return cmd.RunErr
 }
 
 type TestRequirement struct {
@@ -39,14 +55,13 @@ type TestRequirement struct {
 	WasExecuted bool
 }
 
-func (r *TestRequirement) Execute() (success bool) {
+func (r *TestRequirement) Execute() (err error) {
 	r.WasExecuted = true
 
 	if !r.Passes {
-		return false
+		err = errors.NewAuthError("Not logged in.")
 	}
-
-	return true
+	return
 }
 
 var _ = Describe("Requirements runner", func() {
@@ -60,7 +75,7 @@ var _ = Describe("Requirements runner", func() {
 		}
 
 		cmdFactory := &TestCommandFactory{Cmd: &cmd}
-		runner := NewRunner(cmdFactory, nil)
+		runner := NewRunner(&testterm.FakeUI{}, cmdFactory, nil)
 
 		ctxt := testcmd.NewContext("login", []string{})
 		err := runner.RunCmdByName("some-cmd", ctxt)
@@ -74,5 +89,98 @@ var _ = Describe("Requirements runner", func() {
 		Expect(cmd.WasRunWith).To(BeNil())
 
 		Expect(err).To(HaveOccurred())
+		Expect(errors.ExitCode(err)).To(Equal(errors.ExitCodeAuth))
+	})
+
+	It("reports errors returned by the command", func() {
+		ui := &testterm.FakeUI{}
+		cmd := TestCommand{RunErr: errors.NewNotFoundError("App my-app not found")}
+		runner := NewRunner(ui, &TestCommandFactory{Cmd: &cmd}, nil)
+
+		err := runner.RunCmdByName("some-cmd", testcmd.NewContext("app", []string{}))
+
+		Expect(errors.ExitCode(err)).To(Equal(errors.ExitCodeNotFound))
+		testassert.SliceContains(ui.Outputs, testassert.Lines{
+			{"FAILED"},
+			{"App my-app not found"},
+		})
+	})
+
+	It("does not report usage errors twice", func() {
+		ui := &testterm.FakeUI{}
+		cmd := TestCommand{RunErr: errors.NewUsageError("Incorrect Usage")}
+		runner := NewRunner(ui, &TestCommandFactory{Cmd: &cmd}, nil)
+
+		err := runner.RunCmdByName("some-cmd", testcmd.NewContext("app", []string{}))
+
+		Expect(errors.ExitCode(err)).To(Equal(errors.ExitCodeUsage))
+		Expect(ui.Outputs).To(BeEmpty())
+	})
+
+	It("passes the exit code of plugins through without reporting a failure", func() {
+		ui := &testterm.FakeUI{}
+		cmd := TestCommand{RunErr: errors.NewPluginError(42, "Plugin my-plugin exited with code 42")}
+		runner := NewRunner(ui, &TestCommandFactory{Cmd: &cmd}, nil)
+
+		err := runner.RunCmdByName("some-cmd", testcmd.NewContext("app", []string{}))
+
+		Expect(errors.ExitCode(err)).To(Equal(42))
+		Expect(ui.Outputs).To(BeEmpty())
+	})
+
+	Describe("unknown commands", func() {
+		var (
+			ui     *testterm.FakeUI
+			runner ConcreteRunner
+		)
+
+		BeforeEach(func() {
+			ui = &testterm.FakeUI{}
+			cmdFactory := &TestCommandFactory{Names: []string{"push", "map-route", "marketplace", "start"}}
+			runner = NewRunner(ui, cmdFactory, nil)
+		})
+
+		It("suggests the closest commands", func() {
+			err := runner.RunCmdByName("pussh", testcmd.NewContext("app", []string{}))
+
+			Expect(errors.ExitCode(err)).To(Equal(errors.ExitCodeUsage))
+			testassert.SliceContains(ui.Outputs, testassert.Lines{
+				{"FAILED"},
+				{"'pussh' is not a registered command"},
+				{"Did you mean?"},
+				{"push"},
+			})
+			testassert.SliceDoesNotContain(ui.Outputs, testassert.Lines{
+				{"marketplace"},
+			})
+		})
+
+		It("suggests each command once when the factory and the app both know it", func() {
+			cmdFactory := &TestCommandFactory{Names: []string{"push", "app", "apps", "start"}}
+			runner = NewRunner(ui, cmdFactory, nil)
+
+			cfApp, err := app.NewApp(runner)
+			Expect(err).NotTo(HaveOccurred())
+
+			err = runner.RunCmdByName("aps", cli.NewContext(cfApp, new(flag.FlagSet), new(flag.FlagSet)))
+
+			Expect(errors.ExitCode(err)).To(Equal(errors.ExitCodeUsage))
+			suggested := map[string]int{}
+			for _, line := range ui.Outputs {
+				suggested[strings.TrimSpace(line)]++
+			}
+			Expect(suggested["app"]).To(Equal(1))
+			Expect(suggested["apps"]).To(Equal(1))
+		})
+
+		It("points v5 commands to the commands replacing them", func() {
+			err := runner.RunCmdByName("map", testcmd.NewContext("app", []string{}))
+
+			Expect(errors.ExitCode(err)).To(Equal(errors.ExitCodeUsage))
+			testassert.SliceContains(ui.Outputs, testassert.Lines{
+				{"FAILED"},
+				{"'map' is a cf v5 command", "map-route"},
+			})
+		})
 	})
 })
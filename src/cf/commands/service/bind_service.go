@@ -4,11 +4,11 @@ import (
 	"cf"
 	"cf/api"
 	"cf/configuration"
+	"cf/errors"
 	"cf/models"
 	"cf/net"
 	"cf/requirements"
 	"cf/terminal"
-	"errors"
 	"github.com/codegangsta/cli"
 )
 
@@ -51,7 +51,7 @@ func (cmd *BindService) GetRequirements(reqFactory requirements.Factory, c *cli.
 	return
 }
 
-func (cmd *BindService) Run(c *cli.Context) {
+func (cmd *BindService) Run(c *cli.Context) (err error) {
 	app := cmd.appReq.GetApplication()
 	serviceInstance := cmd.serviceInstanceReq.GetServiceInstance()
 
@@ -65,7 +65,8 @@ func (cmd *BindService) Run(c *cli.Context) {
 
 	apiResponse := cmd.BindApplication(app, serviceInstance)
 	if apiResponse.IsNotSuccessful() && apiResponse.ErrorCode != AppAlreadyBoundErrorCode {
-		cmd.ui.Failed(apiResponse.Message)
+		err = errors.FromApiResponse(apiResponse)
+		return
 	}
 
 	cmd.ui.Ok()
@@ -76,6 +77,7 @@ func (cmd *BindService) Run(c *cli.Context) {
 	}
 
 	cmd.ui.Say("TIP: Use '%s push' to ensure your env variable changes take effect", cf.Name())
+	return
 }
 
 func (cmd *BindService) BindApplication(app models.Application, serviceInstance models.ServiceInstance) (apiResponse net.ApiResponse) {
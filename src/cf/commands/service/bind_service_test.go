@@ -21,7 +21,7 @@ func callBindService(args []string, reqFactory *testreq.FakeReqFactory, serviceB
 	config := testconfig.NewRepositoryWithDefaults()
 
 	cmd := NewBindService(fakeUI, config, serviceBindingRepo)
-	testcmd.RunCommand(cmd, ctxt, reqFactory)
+	testcmd.RunCommand(fakeUI, cmd, ctxt, reqFactory)
 	return
 }
 
@@ -3,24 +3,28 @@ package service
 import (
 	"cf/api"
 	"cf/configuration"
+	"cf/errors"
 	"cf/models"
 	"cf/requirements"
 	"cf/terminal"
-	"errors"
 	"fmt"
 	"github.com/codegangsta/cli"
+	"time"
 )
 
 type CreateService struct {
 	ui          terminal.UI
 	config      configuration.Reader
 	serviceRepo api.ServiceRepository
+
+	PollInterval time.Duration
 }
 
 func NewCreateService(ui terminal.UI, config configuration.Reader, serviceRepo api.ServiceRepository) (cmd CreateService) {
 	cmd.ui = ui
 	cmd.config = config
 	cmd.serviceRepo = serviceRepo
+	cmd.PollInterval = DefaultServicePollInterval
 	return
 }
 
@@ -31,10 +35,15 @@ func (cmd CreateService) GetRequirements(reqFactory requirements.Factory, c *cli
 		return
 	}
 
+	if err = validateServiceTimeout(c); err != nil {
+		cmd.ui.Failed(err.Error())
+		return
+	}
+
 	return
 }
 
-func (cmd CreateService) Run(c *cli.Context) {
+func (cmd CreateService) Run(c *cli.Context) (err error) {
 	offeringName := c.Args()[0]
 	planName := c.Args()[1]
 	name := c.Args()[2]
@@ -48,26 +57,24 @@ func (cmd CreateService) Run(c *cli.Context) {
 
 	offerings, apiResponse := cmd.serviceRepo.GetServiceOfferings()
 	if apiResponse.IsNotSuccessful() {
-		cmd.ui.Failed(apiResponse.Message)
+		err = errors.FromApiResponse(apiResponse)
 		return
 	}
 
 	offering, err := findOffering(offerings, offeringName)
 	if err != nil {
-		cmd.ui.Failed(err.Error())
 		return
 	}
 
 	plan, err := findPlan(offering.Plans, planName)
 	if err != nil {
-		cmd.ui.Failed(err.Error())
 		return
 	}
 
 	var identicalAlreadyExists bool
 	identicalAlreadyExists, apiResponse = cmd.serviceRepo.CreateServiceInstance(name, plan.Guid)
 	if apiResponse.IsNotSuccessful() {
-		cmd.ui.Failed(apiResponse.Message)
+		err = errors.FromApiResponse(apiResponse)
 		return
 	}
 
@@ -76,6 +83,11 @@ func (cmd CreateService) Run(c *cli.Context) {
 	if identicalAlreadyExists {
 		cmd.ui.Warn("Service %s already exists", name)
 	}
+
+	if c.Bool("wait") {
+		err = waitForServiceInstance(cmd.ui, cmd.serviceRepo, name, serviceTimeout(c), cmd.PollInterval)
+	}
+	return
 }
 
 func findOffering(offerings []models.ServiceOffering, name string) (offering models.ServiceOffering, err error) {
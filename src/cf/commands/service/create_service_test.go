@@ -23,7 +23,7 @@ func callCreateService(args []string, inputs []string, serviceRepo api.ServiceRe
 	cmd := NewCreateService(fakeUI, config, serviceRepo)
 	reqFactory := &testreq.FakeReqFactory{}
 
-	testcmd.RunCommand(cmd, ctxt, reqFactory)
+	testcmd.RunCommand(fakeUI, cmd, ctxt, reqFactory)
 	return
 }
 
@@ -76,4 +76,32 @@ var _ = Describe("Testing with ginkgo", func() {
 		Expect(serviceRepo.CreateServiceInstanceName).To(Equal("my-cleardb-service"))
 		Expect(serviceRepo.CreateServiceInstancePlanGuid).To(Equal("cleardb-spark-guid"))
 	})
+	It("waits for the service to be ready with --wait", func() {
+		offering := models.ServiceOffering{}
+		offering.Label = "cleardb"
+		offering.Plans = []models.ServicePlanFields{{Name: "spark", Guid: "cleardb-spark-guid"}}
+		serviceRepo := &testapi.FakeServiceRepo{
+			ServiceOfferings: []models.ServiceOffering{offering},
+			FindInstanceByNameLastOperations: []models.LastOperationFields{
+				{Type: "create", State: models.ServiceOperationInProgress},
+				{Type: "create", State: models.ServiceOperationSucceeded},
+			},
+		}
+
+		ui := &testterm.FakeUI{}
+		cmd := NewCreateService(ui, testconfig.NewRepositoryWithDefaults(), serviceRepo)
+		cmd.PollInterval = 0
+		ctxt := testcmd.NewContext("create-service", []string{"--wait", "cleardb", "spark", "my-cleardb-service"})
+		err := testcmd.RunCommand(ui, cmd, ctxt, &testreq.FakeReqFactory{})
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(serviceRepo.FindInstanceByNameName).To(Equal("my-cleardb-service"))
+		Expect(serviceRepo.FindInstanceByNameCount).To(Equal(2))
+		testassert.SliceContains(ui.Outputs, testassert.Lines{
+			{"Creating service", "my-cleardb-service"},
+			{"OK"},
+			{"Waiting for service", "my-cleardb-service"},
+			{"OK"},
+		})
+	})
 })
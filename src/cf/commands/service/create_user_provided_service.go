@@ -3,10 +3,9 @@ package service
 import (
 	"cf/api"
 	"cf/configuration"
+	"cf/errors"
 	"cf/requirements"
 	"cf/terminal"
-	"encoding/json"
-	"errors"
 	"github.com/codegangsta/cli"
 	"strings"
 )
@@ -34,17 +33,28 @@ func (cmd CreateUserProvidedService) GetRequirements(reqFactory requirements.Fac
 	return
 }
 
-func (cmd CreateUserProvidedService) Run(c *cli.Context) {
+func (cmd CreateUserProvidedService) Run(c *cli.Context) (err error) {
 	name := c.Args()[0]
 	drainUrl := c.String("l")
 
 	params := c.String("p")
 	params = strings.Trim(params, `"`)
-	paramsMap := make(map[string]string)
+	paramsMap := make(map[string]interface{})
 
-	err := json.Unmarshal([]byte(params), &paramsMap)
-	if err != nil && params != "" {
-		paramsMap = cmd.mapValuesFromPrompt(params, paramsMap)
+	if params != "" {
+		paramsMap, err = parseCredentials(params)
+		switch {
+		case err == nil:
+		case isCredentialsFile(params):
+			return
+		case !cmd.ui.IsInteractive():
+			err = errors.NewMissingInputError("the values of "+params, "-p with a JSON object or @FILE")
+			return
+		default:
+			// anything but JSON names the credentials to prompt for
+			err = nil
+			paramsMap = cmd.mapValuesFromPrompt(params, make(map[string]interface{}))
+		}
 	}
 
 	cmd.ui.Say("Creating user provided service %s in org %s / space %s as %s...",
@@ -56,14 +66,15 @@ func (cmd CreateUserProvidedService) Run(c *cli.Context) {
 
 	apiResponse := cmd.userProvidedServiceInstanceRepo.Create(name, drainUrl, paramsMap)
 	if apiResponse.IsNotSuccessful() {
-		cmd.ui.Failed(apiResponse.Message)
+		err = errors.FromApiResponse(apiResponse)
 		return
 	}
 
 	cmd.ui.Ok()
+	return
 }
 
-func (cmd CreateUserProvidedService) mapValuesFromPrompt(params string, paramsMap map[string]string) map[string]string {
+func (cmd CreateUserProvidedService) mapValuesFromPrompt(params string, paramsMap map[string]interface{}) map[string]interface{} {
 	for _, param := range strings.Split(params, ",") {
 		param = strings.Trim(param, " ")
 		paramsMap[param] = cmd.ui.Ask("%s%s", param, terminal.PromptColor(">"))
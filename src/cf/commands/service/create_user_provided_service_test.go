@@ -3,8 +3,10 @@ package service_test
 import (
 	"cf/api"
 	. "cf/commands/service"
+	"fileutils"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	"os"
 	testapi "testhelpers/api"
 	testassert "testhelpers/assert"
 	testcmd "testhelpers/commands"
@@ -21,7 +23,7 @@ func callCreateUserProvidedService(args []string, inputs []string, userProvidedS
 	config := testconfig.NewRepositoryWithDefaults()
 
 	cmd := NewCreateUserProvidedService(fakeUI, config, userProvidedServiceInstanceRepo)
-	testcmd.RunCommand(cmd, ctxt, reqFactory)
+	testcmd.RunCommand(fakeUI, cmd, ctxt, reqFactory)
 	return
 }
 
@@ -40,7 +42,7 @@ var _ = Describe("Testing with ginkgo", func() {
 		})
 
 		Expect(repo.CreateName).To(Equal("my-custom-service"))
-		Expect(repo.CreateParams).To(Equal(map[string]string{
+		Expect(repo.CreateParams).To(Equal(map[string]interface{}{
 			"foo": "foo value",
 			"bar": "bar value",
 			"baz": "baz value",
@@ -62,7 +64,7 @@ var _ = Describe("Testing with ginkgo", func() {
 		Expect(ui.Prompts).To(BeEmpty())
 
 		Expect(repo.CreateName).To(Equal("my-custom-service"))
-		Expect(repo.CreateParams).To(Equal(map[string]string{
+		Expect(repo.CreateParams).To(Equal(map[string]interface{}{
 			"foo": "foo value",
 			"bar": "bar value",
 			"baz": "baz value",
@@ -100,4 +102,38 @@ var _ = Describe("Testing with ginkgo", func() {
 			{"OK"},
 		})
 	})
+	It("reads nested credentials from a JSON file", func() {
+		fileutils.TempFile("creds", func(file *os.File, err error) {
+			Expect(err).NotTo(HaveOccurred())
+			file.WriteString(`{"uri": "mysql://example.com/db", "pool": {"max": 10}, "hosts": ["a", "b"]}`)
+
+			repo := &testapi.FakeUserProvidedServiceInstanceRepo{}
+			ui := callCreateUserProvidedService([]string{"-p", "@" + file.Name(), "my-custom-service"},
+				[]string{},
+				repo,
+			)
+
+			Expect(ui.Prompts).To(BeEmpty())
+			Expect(repo.CreateParams).To(Equal(map[string]interface{}{
+				"uri":   "mysql://example.com/db",
+				"pool":  map[string]interface{}{"max": float64(10)},
+				"hosts": []interface{}{"a", "b"},
+			}))
+		})
+	})
+
+	It("fails when the credentials file cannot be read", func() {
+		repo := &testapi.FakeUserProvidedServiceInstanceRepo{}
+		ui := callCreateUserProvidedService([]string{"-p", "@/does/not/exist.json", "my-custom-service"},
+			[]string{},
+			repo,
+		)
+
+		Expect(ui.Prompts).To(BeEmpty())
+		Expect(repo.CreateName).To(Equal(""))
+		testassert.SliceContains(ui.Outputs, testassert.Lines{
+			{"FAILED"},
+			{"Could not read credentials from /does/not/exist.json"},
+		})
+	})
 })
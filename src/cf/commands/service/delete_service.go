@@ -3,9 +3,9 @@ package service
 import (
 	"cf/api"
 	"cf/configuration"
+	"cf/errors"
 	"cf/requirements"
 	"cf/terminal"
-	"errors"
 	"github.com/codegangsta/cli"
 )
 
@@ -40,10 +40,15 @@ func (cmd *DeleteService) GetRequirements(reqFactory requirements.Factory, c *cl
 	return
 }
 
-func (cmd *DeleteService) Run(c *cli.Context) {
+func (cmd *DeleteService) Run(c *cli.Context) (err error) {
 	serviceName := c.Args()[0]
 	force := c.Bool("f")
 
+	if !force && !cmd.ui.IsInteractive() {
+		err = errors.NewMissingInputError("confirmation", "-f")
+		return
+	}
+
 	if !force {
 		answer := cmd.ui.Confirm("Are you sure you want to delete the service %s ?", terminal.EntityNameColor(serviceName))
 		if !answer {
@@ -61,7 +66,7 @@ func (cmd *DeleteService) Run(c *cli.Context) {
 	instance, apiResponse := cmd.serviceRepo.FindInstanceByName(serviceName)
 
 	if apiResponse.IsError() {
-		cmd.ui.Failed(apiResponse.Message)
+		err = errors.FromApiResponse(apiResponse)
 		return
 	}
 
@@ -73,9 +78,10 @@ func (cmd *DeleteService) Run(c *cli.Context) {
 
 	apiResponse = cmd.serviceRepo.DeleteService(instance)
 	if apiResponse.IsNotSuccessful() {
-		cmd.ui.Failed(apiResponse.Message)
+		err = errors.FromApiResponse(apiResponse)
 		return
 	}
 
 	cmd.ui.Ok()
+	return
 }
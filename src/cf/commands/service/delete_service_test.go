@@ -23,7 +23,7 @@ func callDeleteService(confirmation string, args []string, reqFactory *testreq.F
 	config := testconfig.NewRepositoryWithDefaults()
 
 	cmd := NewDeleteService(fakeUI, config, serviceRepo)
-	testcmd.RunCommand(cmd, ctxt, reqFactory)
+	testcmd.RunCommand(fakeUI, cmd, ctxt, reqFactory)
 	return
 }
 
@@ -3,6 +3,7 @@ package service
 import (
 	"cf/api"
 	"cf/configuration"
+	"cf/errors"
 	"cf/requirements"
 	"cf/terminal"
 	"github.com/codegangsta/cli"
@@ -26,7 +27,7 @@ func (cmd ListServices) GetRequirements(reqFactory requirements.Factory, c *cli.
 	return
 }
 
-func (cmd ListServices) Run(c *cli.Context) {
+func (cmd ListServices) Run(c *cli.Context) (err error) {
 	cmd.ui.Say("Getting services in org %s / space %s as %s...",
 		terminal.EntityNameColor(cmd.config.OrganizationFields().Name),
 		terminal.EntityNameColor(cmd.config.SpaceFields().Name),
@@ -36,7 +37,7 @@ func (cmd ListServices) Run(c *cli.Context) {
 	serviceInstances, apiResponse := cmd.serviceSummaryRepo.GetSummariesInCurrentSpace()
 
 	if apiResponse.IsNotSuccessful() {
-		cmd.ui.Failed(apiResponse.Message)
+		err = errors.FromApiResponse(apiResponse)
 		return
 	}
 
@@ -45,12 +46,9 @@ func (cmd ListServices) Run(c *cli.Context) {
 
 	if len(serviceInstances) == 0 {
 		cmd.ui.Say("No services found")
-		return
 	}
 
-	table := [][]string{
-		[]string{"name", "service", "plan", "bound apps"},
-	}
+	table := cmd.ui.Table([]string{"name", "service", "plan", "bound apps"})
 
 	for _, instance := range serviceInstances {
 		var serviceColumn string
@@ -61,13 +59,14 @@ func (cmd ListServices) Run(c *cli.Context) {
 			serviceColumn = instance.ServiceOffering.Label
 		}
 
-		table = append(table, []string{
+		table.Add(newServiceInstanceOutput(instance),
 			instance.Name,
 			serviceColumn,
 			instance.ServicePlan.Name,
 			strings.Join(instance.ApplicationNames, ", "),
-		})
+		)
 	}
 
-	cmd.ui.DisplayTable(table)
+	err = table.Print()
+	return
 }
@@ -3,8 +3,11 @@ package service
 import (
 	"cf/api"
 	"cf/configuration"
+	"cf/errors"
+	"cf/models"
 	"cf/requirements"
 	"cf/terminal"
+	"encoding/json"
 	"github.com/codegangsta/cli"
 	"sort"
 	"strings"
@@ -24,59 +27,141 @@ func NewMarketplaceServices(ui terminal.UI, config configuration.Reader, service
 }
 
 func (cmd MarketplaceServices) GetRequirements(reqFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
+	if c.String("s") != "" && c.String("search") != "" {
+		err = errors.NewUsageError("Use either -s or --search, not both")
+		cmd.ui.Failed(err.Error())
+	}
 	return
 }
 
-func (cmd MarketplaceServices) Run(c *cli.Context) {
-	if cmd.config.HasSpace() {
+func (cmd MarketplaceServices) Run(c *cli.Context) (err error) {
+	serviceName := c.String("s")
+
+	switch {
+	case serviceName != "" && cmd.config.HasSpace():
+		cmd.ui.Say("Getting service plan information for service %s in org %s / space %s as %s...",
+			terminal.EntityNameColor(serviceName),
+			terminal.EntityNameColor(cmd.config.OrganizationFields().Name),
+			terminal.EntityNameColor(cmd.config.SpaceFields().Name),
+			terminal.EntityNameColor(cmd.config.Username()),
+		)
+	case serviceName != "":
+		cmd.ui.Say("Getting service plan information for service %s...", terminal.EntityNameColor(serviceName))
+	case cmd.config.HasSpace():
 		cmd.ui.Say("Getting services from marketplace in org %s / space %s as %s...",
 			terminal.EntityNameColor(cmd.config.OrganizationFields().Name),
 			terminal.EntityNameColor(cmd.config.SpaceFields().Name),
 			terminal.EntityNameColor(cmd.config.Username()),
 		)
-	} else {
+	default:
 		cmd.ui.Say("Getting services from marketplace...")
 	}
 
 	serviceOfferings, apiResponse := cmd.serviceRepo.GetServiceOfferings()
 
 	if apiResponse.IsNotSuccessful() {
-		cmd.ui.Failed(apiResponse.Message)
+		err = errors.FromApiResponse(apiResponse)
 		return
 	}
 
+	if serviceName != "" {
+		return cmd.showPlans(serviceOfferings, serviceName)
+	}
+
+	if term := c.String("search"); term != "" {
+		serviceOfferings = searchOfferings(serviceOfferings, term)
+	}
+
 	cmd.ui.Ok()
 	cmd.ui.Say("")
 
 	if len(serviceOfferings) == 0 {
 		cmd.ui.Say("No service offerings found")
-		return
 	}
 
-	table := [][]string{
-		[]string{"service", "plans", "description"},
-	}
+	table := cmd.ui.Table([]string{"service", "plans", "description"})
 
 	sort.Sort(serviceOfferings)
 	for _, offering := range serviceOfferings {
-		planNames := ""
+		output := newServiceOfferingOutput(offering)
+		table.Add(output,
+			output.Label,
+			strings.Join(output.Plans, ", "),
+			output.Description,
+		)
+	}
+
+	err = table.Print()
+	return
+}
+
+func (cmd MarketplaceServices) showPlans(offerings models.ServiceOfferings, serviceName string) (err error) {
+	offering, err := findOffering(offerings, serviceName)
+	if err != nil {
+		err = errors.NewNotFoundError("Service %s not found in the marketplace", serviceName)
+		return
+	}
+
+	cmd.ui.Ok()
+	cmd.ui.Say("")
+
+	if offering.Description != "" {
+		cmd.ui.Say("%s", offering.Description)
+	}
+	if len(offering.Tags) > 0 {
+		cmd.ui.Say("tags: %s", strings.Join(offering.Tags, ", "))
+	}
+	cmd.ui.Say("")
+
+	if len(offering.Plans) == 0 {
+		cmd.ui.Say("No plans found")
+		return
+	}
+
+	table := cmd.ui.Table([]string{"plan", "description", "free or paid", "extra"})
+	for _, plan := range offering.Plans {
+		cost := "paid"
+		if plan.Free {
+			cost = "free"
+		}
+		table.Add(newServicePlanOutput(plan), plan.Name, plan.Description, cost, formatPlanExtra(plan.Extra))
+	}
 
+	err = table.Print()
+	return
+}
+
+// searchOfferings keeps the offerings whose label, tags, or own or plan
+// descriptions contain term, ignoring case
+func searchOfferings(offerings models.ServiceOfferings, term string) (found models.ServiceOfferings) {
+	term = strings.ToLower(term)
+	for _, offering := range offerings {
+		texts := append([]string{offering.Label, offering.Description}, offering.Tags...)
 		for _, plan := range offering.Plans {
-			if plan.Name == "" {
-				continue
-			}
-			planNames = planNames + ", " + plan.Name
+			texts = append(texts, plan.Description)
 		}
 
-		planNames = strings.TrimPrefix(planNames, ", ")
+		for _, text := range texts {
+			if strings.Contains(strings.ToLower(text), term) {
+				found = append(found, offering)
+				break
+			}
+		}
+	}
+	return
+}
 
-		table = append(table, []string{
-			offering.Label,
-			planNames,
-			offering.Description,
-		})
+// formatPlanExtra compacts the JSON brokers attach to plans, showing it as
+// is when it is not JSON
+func formatPlanExtra(extra string) string {
+	var value interface{}
+	if json.Unmarshal([]byte(extra), &value) != nil {
+		return extra
 	}
 
-	cmd.ui.DisplayTable(table)
-	return
+	compact, err := json.Marshal(value)
+	if err != nil {
+		return extra
+	}
+	return string(compact)
 }
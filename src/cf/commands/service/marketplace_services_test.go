@@ -5,6 +5,7 @@ import (
 	"cf/configuration"
 	"cf/models"
 	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
 	testapi "testhelpers/api"
 	testassert "testhelpers/assert"
 	testcmd "testhelpers/commands"
@@ -13,14 +14,14 @@ import (
 	testterm "testhelpers/terminal"
 )
 
-func callMarketplaceServices(config configuration.Reader, serviceRepo *testapi.FakeServiceRepo) (ui *testterm.FakeUI) {
+func callMarketplaceServices(config configuration.Reader, serviceRepo *testapi.FakeServiceRepo, args ...string) (ui *testterm.FakeUI) {
 	ui = &testterm.FakeUI{}
 
-	ctxt := testcmd.NewContext("marketplace", []string{})
+	ctxt := testcmd.NewContext("marketplace", args)
 	reqFactory := &testreq.FakeReqFactory{}
 
 	cmd := NewMarketplaceServices(ui, config, serviceRepo)
-	testcmd.RunCommand(cmd, ctxt, reqFactory)
+	testcmd.RunCommand(ui, cmd, ctxt, reqFactory)
 	return
 }
 
@@ -68,6 +69,82 @@ var _ = Describe("Testing Marketplace Services", func() {
 			})
 		})
 
+		Describe("with plan details", func() {
+			var serviceRepo *testapi.FakeServiceRepo
+
+			BeforeEach(func() {
+				offering := models.ServiceOffering{}
+				offering.Label = "cleardb"
+				offering.Description = "Highly available MySQL"
+				offering.Tags = []string{"mysql", "relational"}
+				offering.Plans = []models.ServicePlanFields{
+					{Name: "spark", Description: "Great for getting started", Free: true},
+					{Name: "boost", Description: "Best for production", Free: false, Extra: `{ "cost": 10 }`},
+				}
+
+				offering2 := models.ServiceOffering{}
+				offering2.Label = "redis"
+				offering2.Description = "Key value store"
+				offering2.Tags = []string{"cache"}
+				offering2.Plans = []models.ServicePlanFields{
+					{Name: "small", Description: "Shared production instance", Free: true},
+				}
+
+				serviceRepo = &testapi.FakeServiceRepo{ServiceOfferings: []models.ServiceOffering{offering, offering2}}
+			})
+
+			It("shows the plans of a service", func() {
+				ui := callMarketplaceServices(config, serviceRepo, "-s", "cleardb")
+
+				testassert.SliceContains(ui.Outputs, testassert.Lines{
+					{"Getting service plan information for service", "cleardb", "my-org", "my-space", "my-user"},
+					{"OK"},
+					{"Highly available MySQL"},
+					{"tags", "mysql, relational"},
+					{"plan", "description", "free or paid", "extra"},
+					{"spark", "Great for getting started", "free"},
+					{"boost", "Best for production", "paid", `{"cost":10}`},
+				})
+				testassert.SliceDoesNotContain(ui.Outputs, testassert.Lines{
+					{"small"},
+				})
+			})
+
+			It("fails when the service is not in the marketplace", func() {
+				ui := callMarketplaceServices(config, serviceRepo, "-s", "oracle")
+
+				testassert.SliceContains(ui.Outputs, testassert.Lines{
+					{"FAILED"},
+					{"Service oracle not found"},
+				})
+			})
+
+			It("searches labels, descriptions and tags", func() {
+				ui := callMarketplaceServices(config, serviceRepo, "--search", "CACHE")
+				testassert.SliceContains(ui.Outputs, testassert.Lines{
+					{"redis", "small", "Key value store"},
+				})
+				testassert.SliceDoesNotContain(ui.Outputs, testassert.Lines{
+					{"cleardb"},
+				})
+
+				ui = callMarketplaceServices(config, serviceRepo, "--search", "production")
+				testassert.SliceContains(ui.Outputs, testassert.Lines{
+					{"cleardb", "spark, boost"},
+					{"redis", "small"},
+				})
+			})
+
+			It("does not combine -s and --search", func() {
+				ui := callMarketplaceServices(config, serviceRepo, "-s", "cleardb", "--search", "mysql")
+
+				Expect(testcmd.CommandDidPassRequirements).To(BeFalse())
+				testassert.SliceContains(ui.Outputs, testassert.Lines{
+					{"Use either -s or --search"},
+				})
+			})
+		})
+
 	})
 
 	Context("when user is not logged in", func() {
@@ -0,0 +1,86 @@
+package service
+
+import (
+	"cf/models"
+	"encoding/json"
+)
+
+// serviceInstanceOutput is what structured output prints for a service
+// instance. Credentials of user-provided services are never included.
+type serviceInstanceOutput struct {
+	Guid             string   `json:"guid"`
+	Name             string   `json:"name"`
+	Service          string   `json:"service"`
+	Plan             string   `json:"plan"`
+	Description      string   `json:"description"`
+	DocumentationUrl string   `json:"documentation_url"`
+	BoundApps        []string `json:"bound_apps"`
+}
+
+type serviceOfferingOutput struct {
+	Label            string   `json:"label"`
+	Provider         string   `json:"provider"`
+	Version          string   `json:"version"`
+	Description      string   `json:"description"`
+	DocumentationUrl string   `json:"documentation_url"`
+	Tags             []string `json:"tags"`
+	Plans            []string `json:"plans"`
+}
+
+type servicePlanOutput struct {
+	Name        string      `json:"name"`
+	Description string      `json:"description"`
+	Free        bool        `json:"free"`
+	Extra       interface{} `json:"extra"`
+}
+
+func newServiceInstanceOutput(instance models.ServiceInstance) (output serviceInstanceOutput) {
+	output = serviceInstanceOutput{
+		Guid:      instance.Guid,
+		Name:      instance.Name,
+		Service:   "user-provided",
+		BoundApps: append([]string{}, instance.ApplicationNames...),
+	}
+
+	if !instance.IsUserProvided() {
+		output.Service = instance.ServiceOffering.Label
+		output.Plan = instance.ServicePlan.Name
+		output.Description = instance.ServiceOffering.Description
+		output.DocumentationUrl = instance.ServiceOffering.DocumentationUrl
+	}
+	return
+}
+
+func newServiceOfferingOutput(offering models.ServiceOffering) (output serviceOfferingOutput) {
+	output = serviceOfferingOutput{
+		Label:            offering.Label,
+		Provider:         offering.Provider,
+		Version:          offering.Version,
+		Description:      offering.Description,
+		DocumentationUrl: offering.DocumentationUrl,
+		Tags:             append([]string{}, offering.Tags...),
+		Plans:            []string{},
+	}
+
+	for _, plan := range offering.Plans {
+		if plan.Name != "" {
+			output.Plans = append(output.Plans, plan.Name)
+		}
+	}
+	return
+}
+
+// newServicePlanOutput keeps the extra data of plans as JSON when it is
+// valid, instead of a string holding JSON
+func newServicePlanOutput(plan models.ServicePlanFields) (output servicePlanOutput) {
+	output = servicePlanOutput{
+		Name:        plan.Name,
+		Description: plan.Description,
+		Free:        plan.Free,
+	}
+
+	if plan.Extra != "" && json.Unmarshal([]byte(plan.Extra), &output.Extra) != nil {
+		output.Extra = plan.Extra
+	}
+	return
+}
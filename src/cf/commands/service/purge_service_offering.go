@@ -3,9 +3,9 @@ package service
 import (
 	"cf/api"
 	"cf/configuration"
+	"cf/errors"
 	"cf/requirements"
 	"cf/terminal"
-	"errors"
 	"github.com/codegangsta/cli"
 )
 
@@ -22,10 +22,15 @@ func (cmd PurgeServiceOffering) GetRequirements(reqFactory requirements.Factory,
 	return
 }
 
-func (cmd PurgeServiceOffering) Run(c *cli.Context) {
+func (cmd PurgeServiceOffering) Run(c *cli.Context) (err error) {
 	serviceName := c.Args()[0]
 
 	confirmed := c.Bool("f")
+	if !confirmed && !cmd.ui.IsInteractive() {
+		err = errors.NewMissingInputError("confirmation", "-f")
+		return
+	}
+
 	if !confirmed {
 		cmd.ui.Warn(`Warning: This operation assumes that the service broker responsible for this service offering is no longer available, and all service instances have been deleted, leaving orphan records in Cloud Foundry's database. All knowledge of the service will be removed from Cloud Foundry, including service instances and service bindings. No attempt will be made to contact the service broker; running this command without destroying the service broker will cause orphan service instances. After running this command you may want to run either delete-service-auth-token or delete-service-broker to complete the cleanup.`)
 		confirmed = cmd.ui.Confirm("Really purge service offering %s from Cloud Foundry?", serviceName)
@@ -37,7 +42,7 @@ func (cmd PurgeServiceOffering) Run(c *cli.Context) {
 			cmd.ui.Ok()
 			cmd.ui.Warn("Service offering does not exist", serviceName)
 		} else if apiResponse.IsNotSuccessful() {
-			cmd.ui.Failed(apiResponse.Message)
+			err = errors.FromApiResponse(apiResponse)
 		} else {
 			cmd.serviceRepo.PurgeServiceOffering(offering)
 			cmd.ui.Ok()
@@ -21,6 +21,7 @@ var _ = Describe("Purging services", func() {
 		deps := setupDependencies()
 
 		testcmd.RunCommand(
+			deps.ui,
 			NewPurgeServiceOffering(deps.ui, deps.config, deps.serviceRepo),
 			testcmd.NewContext("purge-service-offering", []string{}),
 			deps.reqFactory,
@@ -40,6 +41,7 @@ var _ = Describe("Purging services", func() {
 		deps.ui.Inputs = []string{"yes"}
 
 		testcmd.RunCommand(
+			deps.ui,
 			NewPurgeServiceOffering(deps.ui, deps.config, deps.serviceRepo),
 			testcmd.NewContext("purge-service-offering", []string{"-p", "the-provider", "the-service-name"}),
 			deps.reqFactory,
@@ -59,6 +61,7 @@ var _ = Describe("Purging services", func() {
 		deps.ui.Inputs = []string{"yes"}
 
 		testcmd.RunCommand(
+			deps.ui,
 			NewPurgeServiceOffering(deps.ui, deps.config, deps.serviceRepo),
 			testcmd.NewContext("purge-service-offering", []string{"the-service-name"}),
 			deps.reqFactory,
@@ -86,6 +89,7 @@ var _ = Describe("Purging services", func() {
 		deps.ui.Inputs = []string{"no"}
 
 		testcmd.RunCommand(
+			deps.ui,
 			NewPurgeServiceOffering(deps.ui, deps.config, deps.serviceRepo),
 			testcmd.NewContext("purge-service-offering", []string{"the-service-name"}),
 			deps.reqFactory,
@@ -102,6 +106,7 @@ var _ = Describe("Purging services", func() {
 		deps.serviceRepo.FindServiceOfferingByLabelAndProviderServiceOffering = offering
 
 		testcmd.RunCommand(
+			deps.ui,
 			NewPurgeServiceOffering(deps.ui, deps.config, deps.serviceRepo),
 			testcmd.NewContext("purge-service-offering", []string{"-f", "the-service-name"}),
 			deps.reqFactory,
@@ -117,6 +122,7 @@ var _ = Describe("Purging services", func() {
 		deps.serviceRepo.FindServiceOfferingByLabelAndProviderApiResponse = net.NewApiResponseWithError("oh no!", errors.New("!"))
 
 		testcmd.RunCommand(
+			deps.ui,
 			NewPurgeServiceOffering(deps.ui, deps.config, deps.serviceRepo),
 			testcmd.NewContext("purge-service-offering", []string{"-f", "-p", "the-provider", "the-service-name"}),
 			deps.reqFactory,
@@ -136,6 +142,7 @@ var _ = Describe("Purging services", func() {
 		deps.serviceRepo.FindServiceOfferingByLabelAndProviderApiResponse = net.NewNotFoundApiResponse("uh oh cant find it")
 
 		testcmd.RunCommand(
+			deps.ui,
 			NewPurgeServiceOffering(deps.ui, deps.config, deps.serviceRepo),
 			testcmd.NewContext("purge-service-offering", []string{"-f", "-p", "the-provider", "the-service-name"}),
 			deps.reqFactory,
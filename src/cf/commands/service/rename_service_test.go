@@ -22,7 +22,7 @@ func callRenameService(args []string, reqFactory *testreq.FakeReqFactory) (ui *t
 	cmd := NewRenameService(ui, config, serviceRepo)
 	ctxt := testcmd.NewContext("rename-service", args)
 
-	testcmd.RunCommand(cmd, ctxt, reqFactory)
+	testcmd.RunCommand(ui, cmd, ctxt, reqFactory)
 
 	return
 }
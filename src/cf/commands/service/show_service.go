@@ -1,9 +1,9 @@
 package service
 
 import (
+	"cf/errors"
 	"cf/requirements"
 	"cf/terminal"
-	"errors"
 	"github.com/codegangsta/cli"
 )
 
@@ -35,9 +35,14 @@ func (cmd *ShowService) GetRequirements(reqFactory requirements.Factory, c *cli.
 	return
 }
 
-func (cmd *ShowService) Run(c *cli.Context) {
+func (cmd *ShowService) Run(c *cli.Context) (err error) {
 	serviceInstance := cmd.serviceInstanceReq.GetServiceInstance()
 
+	if cmd.ui.OutputOptions().IsStructured() {
+		err = cmd.ui.PrintValue(newServiceInstanceOutput(serviceInstance))
+		return
+	}
+
 	cmd.ui.Say("")
 	cmd.ui.Say("Service instance: %s", terminal.EntityNameColor(serviceInstance.Name))
 
@@ -49,4 +54,5 @@ func (cmd *ShowService) Run(c *cli.Context) {
 		cmd.ui.Say("Description: %s", terminal.EntityNameColor(serviceInstance.ServiceOffering.Description))
 		cmd.ui.Say("Documentation url: %s", terminal.EntityNameColor(serviceInstance.ServiceOffering.DocumentationUrl))
 	}
+	return
 }
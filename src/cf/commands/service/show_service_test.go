@@ -3,6 +3,7 @@ package service_test
 import (
 	. "cf/commands/service"
 	"cf/models"
+	"cf/terminal"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	testassert "testhelpers/assert"
@@ -15,7 +16,7 @@ func callShowService(args []string, reqFactory *testreq.FakeReqFactory) (ui *tes
 	ui = new(testterm.FakeUI)
 	ctxt := testcmd.NewContext("service", args)
 	cmd := NewShowService(ui)
-	testcmd.RunCommand(cmd, ctxt, reqFactory)
+	testcmd.RunCommand(ui, cmd, ctxt, reqFactory)
 	return
 }
 
@@ -95,4 +96,28 @@ var _ = Describe("Testing with ginkgo", func() {
 			{"Service: ", "user-provided"},
 		})
 	})
+	It("never prints credentials in structured output", func() {
+		serviceInstance := models.ServiceInstance{}
+		serviceInstance.Name = "my-user-provided-service"
+		serviceInstance.Params = map[string]interface{}{"password": "s3cret"}
+		reqFactory := &testreq.FakeReqFactory{
+			LoginSuccess:         true,
+			TargetedSpaceSuccess: true,
+			ServiceInstance:      serviceInstance,
+		}
+
+		ui := new(testterm.FakeUI)
+		ui.SetOutputOptions(terminal.OutputOptions{Format: terminal.OutputJSON})
+		testcmd.RunCommand(ui, NewShowService(ui), testcmd.NewContext("service", []string{"my-user-provided-service"}), reqFactory)
+
+		testassert.SliceContains(ui.Outputs, testassert.Lines{
+			{`"name": "my-user-provided-service"`},
+			{`"service": "user-provided"`},
+		})
+		testassert.SliceDoesNotContain(ui.Outputs, testassert.Lines{
+			{"s3cret"},
+			{"password"},
+			{"LastOperation"},
+		})
+	})
 })
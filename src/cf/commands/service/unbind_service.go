@@ -3,9 +3,9 @@ package service
 import (
 	"cf/api"
 	"cf/configuration"
+	"cf/errors"
 	"cf/requirements"
 	"cf/terminal"
-	"errors"
 	"github.com/codegangsta/cli"
 )
 
@@ -42,7 +42,7 @@ func (cmd *UnbindService) GetRequirements(reqFactory requirements.Factory, c *cl
 	return
 }
 
-func (cmd *UnbindService) Run(c *cli.Context) {
+func (cmd *UnbindService) Run(c *cli.Context) (err error) {
 	app := cmd.appReq.GetApplication()
 	instance := cmd.serviceInstanceReq.GetServiceInstance()
 
@@ -56,7 +56,7 @@ func (cmd *UnbindService) Run(c *cli.Context) {
 
 	found, apiResponse := cmd.serviceBindingRepo.Delete(instance, app.Guid)
 	if apiResponse.IsNotSuccessful() {
-		cmd.ui.Failed(apiResponse.Message)
+		err = errors.FromApiResponse(apiResponse)
 		return
 	}
 
@@ -66,4 +66,5 @@ func (cmd *UnbindService) Run(c *cli.Context) {
 		cmd.ui.Warn("Binding between %s and %s did not exist", instance.Name, app.Name)
 	}
 
+	return
 }
@@ -0,0 +1,111 @@
+package service
+
+import (
+	"cf"
+	"cf/api"
+	"cf/configuration"
+	"cf/errors"
+	"cf/models"
+	"cf/requirements"
+	"cf/terminal"
+	"github.com/codegangsta/cli"
+)
+
+type UpdateService struct {
+	ui                 terminal.UI
+	config             configuration.Reader
+	serviceRepo        api.ServiceRepository
+	serviceInstanceReq requirements.ServiceInstanceRequirement
+}
+
+func NewUpdateService(ui terminal.UI, config configuration.Reader, serviceRepo api.ServiceRepository) (cmd *UpdateService) {
+	cmd = new(UpdateService)
+	cmd.ui = ui
+	cmd.config = config
+	cmd.serviceRepo = serviceRepo
+	return
+}
+
+func (cmd *UpdateService) GetRequirements(reqFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
+	if len(c.Args()) != 1 || c.String("p") == "" {
+		err = errors.New("Incorrect Usage")
+		cmd.ui.FailWithUsage(c, "update-service")
+		return
+	}
+
+	cmd.serviceInstanceReq = reqFactory.NewServiceInstanceRequirement(c.Args()[0])
+
+	reqs = []requirements.Requirement{
+		reqFactory.NewLoginRequirement(),
+		reqFactory.NewTargetedSpaceRequirement(),
+		cmd.serviceInstanceReq,
+	}
+	return
+}
+
+func (cmd *UpdateService) Run(c *cli.Context) (err error) {
+	planName := c.String("p")
+	serviceInstance := cmd.serviceInstanceReq.GetServiceInstance()
+
+	cmd.ui.Say("Updating service %s in org %s / space %s as %s...",
+		terminal.EntityNameColor(serviceInstance.Name),
+		terminal.EntityNameColor(cmd.config.OrganizationFields().Name),
+		terminal.EntityNameColor(cmd.config.SpaceFields().Name),
+		terminal.EntityNameColor(cmd.config.Username()),
+	)
+
+	if serviceInstance.IsUserProvided() {
+		err = errors.New("Service %s is user-provided and has no plan to change", serviceInstance.Name)
+		return
+	}
+
+	if serviceInstance.ServicePlan.Name == planName {
+		cmd.ui.Ok()
+		cmd.ui.Warn("Service %s already uses plan %s", serviceInstance.Name, planName)
+		return
+	}
+
+	offerings, apiResponse := cmd.serviceRepo.GetServiceOfferings()
+	if apiResponse.IsNotSuccessful() {
+		err = errors.FromApiResponse(apiResponse)
+		return
+	}
+
+	offering, err := findInstanceOffering(offerings, serviceInstance)
+	if err != nil {
+		return
+	}
+
+	plan, err := findPlan(offering.Plans, planName)
+	if err != nil {
+		return
+	}
+
+	apiResponse = cmd.serviceRepo.UpdateServicePlan(serviceInstance, plan.Guid)
+	if apiResponse.IsNotSuccessful() {
+		if apiResponse.ErrorCode == cf.SERVICE_PLAN_NOT_UPDATEABLE {
+			err = errors.FromApiResponseWithMessage(apiResponse,
+				"The %s service does not support changing plans.\nTIP: Create a new service instance with plan %s and move your data to it.",
+				offering.Label, planName)
+		} else {
+			err = errors.FromApiResponse(apiResponse)
+		}
+		return
+	}
+
+	cmd.ui.Ok()
+	return
+}
+
+// findInstanceOffering finds the offering of an instance, whose plans are
+// the ones it can change to
+func findInstanceOffering(offerings []models.ServiceOffering, instance models.ServiceInstance) (offering models.ServiceOffering, err error) {
+	for _, offering := range offerings {
+		if offering.Guid == instance.ServiceOffering.Guid {
+			return offering, nil
+		}
+	}
+
+	err = errors.New("Could not find offering %s of service %s", instance.ServiceOffering.Label, instance.Name)
+	return
+}
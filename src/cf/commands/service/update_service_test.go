@@ -0,0 +1,115 @@
+package service_test
+
+import (
+	"cf"
+	. "cf/commands/service"
+	"cf/models"
+	"cf/net"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	testapi "testhelpers/api"
+	testassert "testhelpers/assert"
+	testcmd "testhelpers/commands"
+	testconfig "testhelpers/configuration"
+	testreq "testhelpers/requirements"
+	testterm "testhelpers/terminal"
+)
+
+var _ = Describe("update-service command", func() {
+	var (
+		ui              *testterm.FakeUI
+		serviceRepo     *testapi.FakeServiceRepo
+		reqFactory      *testreq.FakeReqFactory
+		serviceInstance models.ServiceInstance
+	)
+
+	BeforeEach(func() {
+		ui = &testterm.FakeUI{}
+
+		offering := models.ServiceOffering{}
+		offering.Guid = "cleardb-guid"
+		offering.Label = "cleardb"
+		offering.Plans = []models.ServicePlanFields{
+			{Name: "spark", Guid: "spark-guid"},
+			{Name: "boost", Guid: "boost-guid"},
+		}
+
+		otherOffering := models.ServiceOffering{}
+		otherOffering.Guid = "redis-guid"
+		otherOffering.Label = "redis"
+		otherOffering.Plans = []models.ServicePlanFields{
+			{Name: "large", Guid: "redis-large-guid"},
+		}
+
+		serviceRepo = &testapi.FakeServiceRepo{ServiceOfferings: []models.ServiceOffering{otherOffering, offering}}
+
+		serviceInstance = models.ServiceInstance{}
+		serviceInstance.Name = "my-db"
+		serviceInstance.Guid = "my-db-guid"
+		serviceInstance.ServicePlan = models.ServicePlanFields{Name: "spark", Guid: "spark-guid"}
+		serviceInstance.ServiceOffering = offering.ServiceOfferingFields
+
+		reqFactory = &testreq.FakeReqFactory{LoginSuccess: true, TargetedSpaceSuccess: true, ServiceInstance: serviceInstance}
+	})
+
+	callUpdateService := func(args []string) (err error) {
+		config := testconfig.NewRepositoryWithDefaults()
+		cmd := NewUpdateService(ui, config, serviceRepo)
+		return testcmd.RunCommand(ui, cmd, testcmd.NewContext("update-service", args), reqFactory)
+	}
+
+	It("fails with usage without an instance or a plan", func() {
+		callUpdateService([]string{"my-db"})
+		Expect(ui.FailedWithUsage).To(BeTrue())
+
+		ui = &testterm.FakeUI{}
+		callUpdateService([]string{"-p", "boost"})
+		Expect(ui.FailedWithUsage).To(BeTrue())
+	})
+
+	It("requires a login, a targeted space and the service instance", func() {
+		reqFactory.TargetedSpaceSuccess = false
+		callUpdateService([]string{"-p", "boost", "my-db"})
+
+		Expect(testcmd.CommandDidPassRequirements).To(BeFalse())
+		Expect(reqFactory.ServiceInstanceName).To(Equal("my-db"))
+	})
+
+	It("changes the plan within the offering of the instance", func() {
+		err := callUpdateService([]string{"-p", "boost", "my-db"})
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(serviceRepo.UpdateServicePlanServiceInstance).To(Equal(serviceInstance))
+		Expect(serviceRepo.UpdateServicePlanPlanGuid).To(Equal("boost-guid"))
+		testassert.SliceContains(ui.Outputs, testassert.Lines{
+			{"Updating service", "my-db", "my-org", "my-space", "my-user"},
+			{"OK"},
+		})
+	})
+
+	It("fails when the plan belongs to another offering", func() {
+		err := callUpdateService([]string{"-p", "large", "my-db"})
+
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("Could not find plan with name large"))
+		Expect(serviceRepo.UpdateServicePlanPlanGuid).To(Equal(""))
+	})
+
+	It("explains when the service does not support changing plans", func() {
+		serviceRepo.UpdateServicePlanApiResponse = net.NewApiResponse("The service does not support changing plans.", cf.SERVICE_PLAN_NOT_UPDATEABLE, 400)
+		err := callUpdateService([]string{"-p", "boost", "my-db"})
+
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("The cleardb service does not support changing plans"))
+	})
+
+	It("does not change user-provided services", func() {
+		serviceInstance.ServicePlan = models.ServicePlanFields{}
+		reqFactory.ServiceInstance = serviceInstance
+		err := callUpdateService([]string{"-p", "boost", "my-db"})
+
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("user-provided"))
+		Expect(serviceRepo.UpdateServicePlanPlanGuid).To(Equal(""))
+	})
+})
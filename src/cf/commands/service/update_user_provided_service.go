@@ -4,10 +4,10 @@ import (
 	"cf"
 	"cf/api"
 	"cf/configuration"
+	"cf/errors"
+	"cf/net"
 	"cf/requirements"
 	"cf/terminal"
-	"encoding/json"
-	"errors"
 	"github.com/codegangsta/cli"
 )
 
@@ -43,23 +43,21 @@ func (cmd *UpdateUserProvidedService) GetRequirements(reqFactory requirements.Fa
 	return
 }
 
-func (cmd *UpdateUserProvidedService) Run(c *cli.Context) {
+func (cmd *UpdateUserProvidedService) Run(c *cli.Context) (err error) {
 
 	serviceInstance := cmd.serviceInstanceReq.GetServiceInstance()
 	if !serviceInstance.IsUserProvided() {
-		cmd.ui.Failed("Service Instance is not user provided")
+		err = errors.New("Service Instance is not user provided")
 		return
 	}
 
 	drainUrl := c.String("l")
 	params := c.String("p")
 
-	paramsMap := make(map[string]string)
+	var paramsMap, oldParams map[string]interface{}
 	if params != "" {
-
-		err := json.Unmarshal([]byte(params), &paramsMap)
+		paramsMap, err = parseCredentials(params)
 		if err != nil {
-			cmd.ui.Failed("JSON is invalid: %s", err.Error())
 			return
 		}
 	}
@@ -71,19 +69,48 @@ func (cmd *UpdateUserProvidedService) Run(c *cli.Context) {
 		terminal.EntityNameColor(cmd.config.Username()),
 	)
 
+	if params != "" {
+		var apiResponse net.ApiResponse
+		oldParams, apiResponse = cmd.userProvidedServiceInstanceRepo.GetCredentials(serviceInstance.Guid)
+		if apiResponse.IsNotSuccessful() {
+			err = errors.FromApiResponse(apiResponse)
+			return
+		}
+	}
+
 	serviceInstance.Params = paramsMap
 	serviceInstance.SysLogDrainUrl = drainUrl
 
 	apiResponse := cmd.userProvidedServiceInstanceRepo.Update(serviceInstance.ServiceInstanceFields)
 	if apiResponse.IsNotSuccessful() {
-		cmd.ui.Failed(apiResponse.Message)
+		err = errors.FromApiResponse(apiResponse)
 		return
 	}
 
 	cmd.ui.Ok()
+
+	if params != "" {
+		cmd.showCredentialChanges(oldParams, paramsMap)
+	}
+
 	cmd.ui.Say("TIP: To make these changes take effect, use '%s unbind-service' to unbind the service, '%s bind-service' to rebind, and then '%s push' to update the app with the new env variables", cf.Name(), cf.Name(), cf.Name())
 
 	if params == "" && drainUrl == "" {
 		cmd.ui.Warn("No flags specified. No changes were made.")
 	}
+	return
+}
+
+func (cmd *UpdateUserProvidedService) showCredentialChanges(oldParams, newParams map[string]interface{}) {
+	changes := credentialChanges(oldParams, newParams)
+	if len(changes) == 0 {
+		cmd.ui.Say("Credentials are unchanged")
+		return
+	}
+
+	cmd.ui.Say("Changed credentials:")
+	for _, change := range changes {
+		cmd.ui.Say("   %s", change)
+	}
+	cmd.ui.Say("")
 }
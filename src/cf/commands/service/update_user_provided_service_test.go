@@ -21,7 +21,7 @@ func callUpdateUserProvidedService(args []string, reqFactory *testreq.FakeReqFac
 	config := testconfig.NewRepositoryWithDefaults()
 
 	cmd := NewUpdateUserProvidedService(fakeUI, config, userProvidedServiceInstanceRepo)
-	testcmd.RunCommand(cmd, ctxt, reqFactory)
+	testcmd.RunCommand(fakeUI, cmd, ctxt, reqFactory)
 	return
 }
 
@@ -88,7 +88,7 @@ var _ = Describe("Testing with ginkgo", func() {
 			{"TIP"},
 		})
 		Expect(repo.UpdateServiceInstance.Name).To(Equal(serviceInstance.Name))
-		Expect(repo.UpdateServiceInstance.Params).To(Equal(map[string]string{"foo": "bar"}))
+		Expect(repo.UpdateServiceInstance.Params).To(Equal(map[string]interface{}{"foo": "bar"}))
 		Expect(repo.UpdateServiceInstance.SysLogDrainUrl).To(Equal("syslog://example.com"))
 	})
 	It("TestUpdateUserProvidedServiceWithoutJson", func() {
@@ -152,4 +152,59 @@ var _ = Describe("Testing with ginkgo", func() {
 			{"Service Instance is not user provided"},
 		})
 	})
+	It("shows which credentials changed without their values", func() {
+		serviceInstance := models.ServiceInstance{}
+		serviceInstance.Name = "found-service-name"
+		serviceInstance.Guid = "found-service-guid"
+		reqFactory := &testreq.FakeReqFactory{
+			LoginSuccess:    true,
+			ServiceInstance: serviceInstance,
+		}
+		repo := &testapi.FakeUserProvidedServiceInstanceRepo{
+			GetCredentialsCredentials: map[string]interface{}{
+				"host":     "example.com",
+				"password": "old-secret",
+				"token":    "old-token",
+			},
+		}
+		args := []string{"-p", `{"host":"example.com","password":"new-secret","port":{"primary":5432}}`, "service-name"}
+		ui := callUpdateUserProvidedService(args, reqFactory, repo)
+
+		Expect(repo.GetCredentialsGuid).To(Equal("found-service-guid"))
+		Expect(repo.UpdateServiceInstance.Params).To(Equal(map[string]interface{}{
+			"host":     "example.com",
+			"password": "new-secret",
+			"port":     map[string]interface{}{"primary": float64(5432)},
+		}))
+		testassert.SliceContains(ui.Outputs, testassert.Lines{
+			{"OK"},
+			{"Changed credentials"},
+			{"~ password: ***"},
+			{"+ port: ***"},
+			{"- token: ***"},
+		})
+		testassert.SliceDoesNotContain(ui.Outputs, testassert.Lines{
+			{"host"},
+			{"secret"},
+			{"5432"},
+		})
+	})
+
+	It("says when the credentials are unchanged", func() {
+		serviceInstance := models.ServiceInstance{}
+		serviceInstance.Name = "found-service-name"
+		reqFactory := &testreq.FakeReqFactory{
+			LoginSuccess:    true,
+			ServiceInstance: serviceInstance,
+		}
+		repo := &testapi.FakeUserProvidedServiceInstanceRepo{
+			GetCredentialsCredentials: map[string]interface{}{"nested": map[string]interface{}{"a": "b"}},
+		}
+		ui := callUpdateUserProvidedService([]string{"-p", `{"nested":{"a":"b"}}`, "service-name"}, reqFactory, repo)
+
+		testassert.SliceContains(ui.Outputs, testassert.Lines{
+			{"OK"},
+			{"Credentials are unchanged"},
+		})
+	})
 })
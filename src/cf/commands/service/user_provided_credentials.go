@@ -0,0 +1,68 @@
+package service
+
+import (
+	"cf/errors"
+	"cf/terminal"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+const maskedCredential = "***"
+
+func isCredentialsFile(params string) bool {
+	return strings.HasPrefix(params, "@")
+}
+
+// parseCredentials reads the credentials given with -p, either inline as a
+// JSON object or from the JSON file named after an @
+func parseCredentials(params string) (credentials map[string]interface{}, err error) {
+	data := []byte(params)
+	if isCredentialsFile(params) {
+		path := strings.TrimPrefix(params, "@")
+		data, err = ioutil.ReadFile(path)
+		if err != nil {
+			err = errors.New("Could not read credentials from %s\n%s", path, err.Error())
+			return
+		}
+	}
+
+	err = json.Unmarshal(data, &credentials)
+	if err != nil {
+		err = errors.New("JSON is invalid: %s", err.Error())
+	}
+	return
+}
+
+// credentialChanges lists the keys that differ between the old and new
+// credentials, with their values masked
+func credentialChanges(oldCredentials, newCredentials map[string]interface{}) (changes []string) {
+	keys := []string{}
+	for key := range oldCredentials {
+		keys = append(keys, key)
+	}
+	for key := range newCredentials {
+		if _, found := oldCredentials[key]; !found {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		oldValue, inOld := oldCredentials[key]
+		newValue, inNew := newCredentials[key]
+
+		switch {
+		case !inOld:
+			changes = append(changes, terminal.SuccessColor(fmt.Sprintf("+ %s: %s", key, maskedCredential)))
+		case !inNew:
+			changes = append(changes, terminal.FailureColor(fmt.Sprintf("- %s: %s", key, maskedCredential)))
+		case !reflect.DeepEqual(oldValue, newValue):
+			changes = append(changes, terminal.WarningColor(fmt.Sprintf("~ %s: %s", key, maskedCredential)))
+		}
+	}
+	return
+}
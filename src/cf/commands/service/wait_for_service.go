@@ -0,0 +1,120 @@
+package service
+
+import (
+	"cf"
+	"cf/api"
+	"cf/configuration"
+	"cf/errors"
+	"cf/models"
+	"cf/requirements"
+	"cf/terminal"
+	"github.com/codegangsta/cli"
+	"time"
+)
+
+const (
+	DefaultServiceTimeout      = 10 * time.Minute
+	DefaultServicePollInterval = 5 * time.Second
+)
+
+type WaitForService struct {
+	ui          terminal.UI
+	config      configuration.Reader
+	serviceRepo api.ServiceRepository
+
+	PollInterval time.Duration
+}
+
+func NewWaitForService(ui terminal.UI, config configuration.Reader, serviceRepo api.ServiceRepository) (cmd *WaitForService) {
+	cmd = new(WaitForService)
+	cmd.ui = ui
+	cmd.config = config
+	cmd.serviceRepo = serviceRepo
+	cmd.PollInterval = DefaultServicePollInterval
+	return
+}
+
+func (cmd *WaitForService) GetRequirements(reqFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
+	if len(c.Args()) != 1 {
+		err = errors.New("Incorrect Usage")
+		cmd.ui.FailWithUsage(c, "wait-for-service")
+		return
+	}
+
+	if err = validateServiceTimeout(c); err != nil {
+		cmd.ui.Failed(err.Error())
+		return
+	}
+
+	reqs = []requirements.Requirement{
+		reqFactory.NewLoginRequirement(),
+		reqFactory.NewTargetedSpaceRequirement(),
+	}
+	return
+}
+
+func (cmd *WaitForService) Run(c *cli.Context) (err error) {
+	return waitForServiceInstance(cmd.ui, cmd.serviceRepo, c.Args()[0], serviceTimeout(c), cmd.PollInterval)
+}
+
+func validateServiceTimeout(c *cli.Context) (err error) {
+	if c.Int("t") < 0 {
+		err = errors.NewUsageError("Invalid timeout %d, it must be a number of seconds", c.Int("t"))
+	}
+	return
+}
+
+// serviceTimeout is how long to wait for a service, in seconds with -t
+func serviceTimeout(c *cli.Context) time.Duration {
+	if c.Int("t") == 0 {
+		return DefaultServiceTimeout
+	}
+	return time.Duration(c.Int("t")) * time.Second
+}
+
+// waitForServiceInstance polls the last operation on a service instance until
+// its broker reports it done, which is right away for brokers that provision
+// synchronously
+func waitForServiceInstance(ui terminal.UI, serviceRepo api.ServiceRepository, name string, timeout, pollInterval time.Duration) (err error) {
+	ui.Say("Waiting for service %s to be ready...", terminal.EntityNameColor(name))
+
+	startTime := time.Now()
+	for {
+		instance, apiResponse := serviceRepo.FindInstanceByName(name)
+		if apiResponse.IsNotSuccessful() {
+			err = errors.FromApiResponse(apiResponse)
+			return
+		}
+
+		operation := instance.LastOperation
+		switch operation.State {
+		case models.ServiceOperationInProgress:
+		case models.ServiceOperationFailed:
+			err = errors.New("Could not %s service %s\n%s", operationVerb(operation), name, operation.Description)
+			return
+		default:
+			ui.Ok()
+			return
+		}
+
+		if time.Since(startTime) >= timeout {
+			err = errors.NewTimeoutError("Timed out after %s waiting for service %s, which is still in progress\nTIP: Use '%s' to keep waiting",
+				timeout, name, terminal.CommandColor(cf.Name()+" wait-for-service "+name))
+			return
+		}
+
+		ui.LoadingIndication()
+		if ui.Wait(pollInterval) {
+			err = errors.New("Stopped waiting for service %s, which is still in progress\nTIP: Use '%s' to keep waiting",
+				name, terminal.CommandColor(cf.Name()+" wait-for-service "+name))
+			return
+		}
+	}
+}
+
+func operationVerb(operation models.LastOperationFields) string {
+	if operation.Type == "" {
+		return "provision"
+	}
+	return operation.Type
+}
@@ -0,0 +1,126 @@
+package service_test
+
+import (
+	. "cf/commands/service"
+	"cf/errors"
+	"cf/models"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	testapi "testhelpers/api"
+	testassert "testhelpers/assert"
+	testcmd "testhelpers/commands"
+	testconfig "testhelpers/configuration"
+	testreq "testhelpers/requirements"
+	testterm "testhelpers/terminal"
+	"time"
+)
+
+var _ = Describe("wait-for-service command", func() {
+	var (
+		ui          *testterm.FakeUI
+		serviceRepo *testapi.FakeServiceRepo
+		reqFactory  *testreq.FakeReqFactory
+	)
+
+	BeforeEach(func() {
+		ui = &testterm.FakeUI{}
+		serviceRepo = &testapi.FakeServiceRepo{}
+		reqFactory = &testreq.FakeReqFactory{LoginSuccess: true, TargetedSpaceSuccess: true}
+	})
+
+	callWaitForService := func(args []string) (err error) {
+		cmd := NewWaitForService(ui, testconfig.NewRepositoryWithDefaults(), serviceRepo)
+		cmd.PollInterval = 0
+		return testcmd.RunCommand(ui, cmd, testcmd.NewContext("wait-for-service", args), reqFactory)
+	}
+
+	It("fails with usage without a service instance", func() {
+		callWaitForService([]string{})
+		Expect(ui.FailedWithUsage).To(BeTrue())
+	})
+
+	It("requires a login and a targeted space", func() {
+		reqFactory.TargetedSpaceSuccess = false
+		callWaitForService([]string{"my-db"})
+		Expect(testcmd.CommandDidPassRequirements).To(BeFalse())
+	})
+
+	It("rejects a negative timeout", func() {
+		err := callWaitForService([]string{"-t", "-5", "my-db"})
+
+		Expect(errors.ExitCode(err)).To(Equal(errors.ExitCodeUsage))
+		Expect(testcmd.CommandDidPassRequirements).To(BeFalse())
+	})
+
+	It("polls until the broker reports the service ready", func() {
+		serviceRepo.FindInstanceByNameLastOperations = []models.LastOperationFields{
+			{Type: "create", State: models.ServiceOperationInProgress},
+			{Type: "create", State: models.ServiceOperationInProgress},
+			{Type: "create", State: models.ServiceOperationSucceeded},
+		}
+		err := callWaitForService([]string{"my-db"})
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(serviceRepo.FindInstanceByNameName).To(Equal("my-db"))
+		Expect(serviceRepo.FindInstanceByNameCount).To(Equal(3))
+		testassert.SliceContains(ui.Outputs, testassert.Lines{
+			{"Waiting for service", "my-db"},
+			{"OK"},
+		})
+	})
+
+	It("treats services without a last operation as ready", func() {
+		err := callWaitForService([]string{"my-db"})
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(serviceRepo.FindInstanceByNameCount).To(Equal(1))
+	})
+
+	It("shows the description the broker gives for a failure", func() {
+		serviceRepo.FindInstanceByNameLastOperations = []models.LastOperationFields{
+			{Type: "create", State: models.ServiceOperationFailed, Description: "Quota exceeded for databases"},
+		}
+		err := callWaitForService([]string{"my-db"})
+
+		Expect(err).To(HaveOccurred())
+		Expect(errors.ExitCode(err)).To(Equal(errors.ExitCodeGeneral))
+		Expect(err.Error()).To(ContainSubstring("Could not create service my-db"))
+		Expect(err.Error()).To(ContainSubstring("Quota exceeded for databases"))
+	})
+
+	It("exits with the timeout code when the service is still in progress", func() {
+		serviceRepo.FindInstanceByNameServiceInstance.LastOperation = models.LastOperationFields{
+			Type:  "create",
+			State: models.ServiceOperationInProgress,
+		}
+		cmd := NewWaitForService(ui, testconfig.NewRepositoryWithDefaults(), serviceRepo)
+		cmd.PollInterval = 100 * time.Millisecond
+		err := testcmd.RunCommand(ui, cmd, testcmd.NewContext("wait-for-service", []string{"-t", "1", "my-db"}), reqFactory)
+
+		Expect(err).To(HaveOccurred())
+		Expect(errors.ExitCode(err)).To(Equal(errors.ExitCodeTimeout))
+		Expect(err.Error()).To(ContainSubstring("Timed out after 1s waiting for service my-db"))
+		Expect(err.Error()).To(ContainSubstring("wait-for-service my-db"))
+	})
+
+	It("stops waiting when interrupted with Ctrl-C", func() {
+		serviceRepo.FindInstanceByNameServiceInstance.LastOperation = models.LastOperationFields{
+			Type:  "create",
+			State: models.ServiceOperationInProgress,
+		}
+		ui.WaitInterrupted = true
+		err := callWaitForService([]string{"my-db"})
+
+		Expect(err).To(HaveOccurred())
+		Expect(serviceRepo.FindInstanceByNameCount).To(Equal(1))
+		Expect(err.Error()).To(ContainSubstring("Stopped waiting for service my-db"))
+		Expect(err.Error()).To(ContainSubstring("wait-for-service my-db"))
+	})
+
+	It("fails when the service does not exist", func() {
+		serviceRepo.FindInstanceByNameNotFound = true
+		err := callWaitForService([]string{"my-db"})
+
+		Expect(errors.ExitCode(err)).To(Equal(errors.ExitCodeNotFound))
+	})
+})
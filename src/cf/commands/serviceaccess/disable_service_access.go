@@ -0,0 +1,139 @@
+package serviceaccess
+
+import (
+	"cf"
+	"cf/api"
+	"cf/configuration"
+	"cf/errors"
+	"cf/models"
+	"cf/requirements"
+	"cf/terminal"
+	"github.com/codegangsta/cli"
+	"strings"
+)
+
+type DisableServiceAccess struct {
+	ui             terminal.UI
+	config         configuration.Reader
+	brokerRepo     api.ServiceBrokerRepository
+	serviceRepo    api.ServiceRepository
+	visibilityRepo api.ServicePlanVisibilityRepository
+	orgRepo        api.OrganizationRepository
+}
+
+func NewDisableServiceAccess(ui terminal.UI, config configuration.Reader, brokerRepo api.ServiceBrokerRepository, serviceRepo api.ServiceRepository, visibilityRepo api.ServicePlanVisibilityRepository, orgRepo api.OrganizationRepository) (cmd DisableServiceAccess) {
+	cmd.ui = ui
+	cmd.config = config
+	cmd.brokerRepo = brokerRepo
+	cmd.serviceRepo = serviceRepo
+	cmd.visibilityRepo = visibilityRepo
+	cmd.orgRepo = orgRepo
+	return
+}
+
+func (cmd DisableServiceAccess) GetRequirements(reqFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
+	if len(c.Args()) != 1 {
+		err = errors.New("Incorrect Usage")
+		cmd.ui.FailWithUsage(c, "disable-service-access")
+		return
+	}
+
+	reqs = append(reqs, reqFactory.NewLoginRequirement())
+	return
+}
+
+func (cmd DisableServiceAccess) Run(c *cli.Context) (err error) {
+	serviceName := c.Args()[0]
+	planName := c.String("p")
+	orgName := c.String("o")
+
+	plansText, orgsText := accessDescription(planName, orgName)
+	cmd.ui.Say("Disabling access to %s of service %s for %s as %s...",
+		plansText,
+		terminal.EntityNameColor(serviceName),
+		orgsText,
+		terminal.EntityNameColor(cmd.config.Username()),
+	)
+
+	brokers, err := loadBrokerOfferings(cmd.brokerRepo, cmd.serviceRepo)
+	if err != nil {
+		return
+	}
+
+	plans, err := findServicePlans(brokers, serviceName, planName)
+	if err != nil {
+		return
+	}
+
+	visibilities, apiResponse := cmd.visibilityRepo.List()
+	if apiResponse.IsNotSuccessful() {
+		err = errors.FromApiResponse(apiResponse)
+		return
+	}
+
+	if orgName == "" {
+		err = cmd.disableForAllOrgs(plans, visibilities)
+	} else {
+		err = cmd.disableForOrg(plans, visibilities, serviceName, orgName)
+	}
+	if err != nil {
+		return
+	}
+
+	cmd.ui.Ok()
+	return
+}
+
+// disableForAllOrgs makes the plans private, and takes them away from the
+// orgs they were enabled for
+func (cmd DisableServiceAccess) disableForAllOrgs(plans []models.ServicePlanFields, visibilities []models.ServicePlanVisibilityFields) (err error) {
+	for _, plan := range plans {
+		if plan.Public {
+			apiResponse := cmd.serviceRepo.SetServicePlanPublic(plan.Guid, false)
+			if apiResponse.IsNotSuccessful() {
+				return errors.FromApiResponse(apiResponse)
+			}
+		}
+
+		for _, visibility := range planVisibilities(visibilities, plan.Guid) {
+			apiResponse := cmd.visibilityRepo.Delete(visibility.Guid)
+			if apiResponse.IsNotSuccessful() {
+				return errors.FromApiResponse(apiResponse)
+			}
+		}
+	}
+	return
+}
+
+func (cmd DisableServiceAccess) disableForOrg(plans []models.ServicePlanFields, visibilities []models.ServicePlanVisibilityFields, serviceName, orgName string) (err error) {
+	// public plans cannot be taken away from a single org, so nothing is
+	// changed until that is ruled out
+	publicPlanNames := []string{}
+	for _, plan := range plans {
+		if plan.Public {
+			publicPlanNames = append(publicPlanNames, plan.Name)
+		}
+	}
+	if len(publicPlanNames) > 0 {
+		return errors.New("Cannot disable access for org %s, these plans of service %s are accessible to all orgs: %s\nTIP: Use '%s disable-service-access %s' first, then enable access for the orgs that should keep it.",
+			orgName, serviceName, strings.Join(publicPlanNames, ", "), cf.Name(), serviceName)
+	}
+
+	org, apiResponse := cmd.orgRepo.FindByName(orgName)
+	if apiResponse.IsNotSuccessful() {
+		return errors.FromApiResponse(apiResponse)
+	}
+
+	for _, plan := range plans {
+		for _, visibility := range planVisibilities(visibilities, plan.Guid) {
+			if visibility.OrganizationGuid != org.Guid {
+				continue
+			}
+			apiResponse = cmd.visibilityRepo.Delete(visibility.Guid)
+			if apiResponse.IsNotSuccessful() {
+				return errors.FromApiResponse(apiResponse)
+			}
+		}
+	}
+	return
+}
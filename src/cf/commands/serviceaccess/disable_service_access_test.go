@@ -0,0 +1,83 @@
+package serviceaccess_test
+
+import (
+	. "cf/commands/serviceaccess"
+	"cf/models"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	testassert "testhelpers/assert"
+	testcmd "testhelpers/commands"
+	testconfig "testhelpers/configuration"
+	testreq "testhelpers/requirements"
+	testterm "testhelpers/terminal"
+)
+
+var _ = Describe("disable-service-access command", func() {
+	var (
+		ui         *testterm.FakeUI
+		repos      serviceAccessRepos
+		reqFactory *testreq.FakeReqFactory
+	)
+
+	BeforeEach(func() {
+		ui = &testterm.FakeUI{}
+		repos = newServiceAccessRepos()
+		reqFactory = &testreq.FakeReqFactory{LoginSuccess: true}
+	})
+
+	callDisableServiceAccess := func(args []string) (err error) {
+		config := testconfig.NewRepositoryWithDefaults()
+		cmd := NewDisableServiceAccess(ui, config, repos.brokerRepo, repos.serviceRepo, repos.visibilityRepo, repos.orgRepo)
+		return testcmd.RunCommand(ui, cmd, testcmd.NewContext("disable-service-access", args), reqFactory)
+	}
+
+	It("fails with usage without a service", func() {
+		callDisableServiceAccess([]string{})
+		Expect(ui.FailedWithUsage).To(BeTrue())
+	})
+
+	It("makes the plans private and takes them away from every org", func() {
+		err := callDisableServiceAccess([]string{"mysql"})
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(repos.serviceRepo.PublicServicePlans).To(Equal(map[string]bool{"small-guid": false}))
+		Expect(repos.visibilityRepo.DeletedGuids).To(Equal([]string{"visibility-guid"}))
+		testassert.SliceContains(ui.Outputs, testassert.Lines{
+			{"Disabling access to", "mysql", "my-user"},
+			{"OK"},
+		})
+	})
+
+	It("takes a plan away from an org", func() {
+		err := callDisableServiceAccess([]string{"-p", "large", "-o", "org-1", "mysql"})
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(repos.serviceRepo.PublicServicePlans).To(BeNil())
+		Expect(repos.visibilityRepo.DeletedGuids).To(Equal([]string{"visibility-guid"}))
+	})
+
+	It("leaves other orgs alone", func() {
+		err := callDisableServiceAccess([]string{"-p", "large", "-o", "org-2", "mysql"})
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(repos.visibilityRepo.DeletedGuids).To(BeNil())
+	})
+
+	It("refuses to take public plans away from a single org", func() {
+		err := callDisableServiceAccess([]string{"-o", "org-1", "mysql"})
+
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("accessible to all orgs: small"))
+		Expect(err.Error()).To(ContainSubstring("TIP"))
+		Expect(repos.visibilityRepo.DeletedGuids).To(BeNil())
+		Expect(repos.serviceRepo.PublicServicePlans).To(BeNil())
+	})
+
+	It("fails when the service does not exist", func() {
+		repos.serviceRepo.ServiceOfferingsByBrokerGuid = map[string]models.ServiceOfferings{}
+		err := callDisableServiceAccess([]string{"mysql"})
+
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("Service mysql not found"))
+	})
+})
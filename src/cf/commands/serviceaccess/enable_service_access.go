@@ -0,0 +1,120 @@
+package serviceaccess
+
+import (
+	"cf/api"
+	"cf/configuration"
+	"cf/errors"
+	"cf/requirements"
+	"cf/terminal"
+	"github.com/codegangsta/cli"
+)
+
+type EnableServiceAccess struct {
+	ui             terminal.UI
+	config         configuration.Reader
+	brokerRepo     api.ServiceBrokerRepository
+	serviceRepo    api.ServiceRepository
+	visibilityRepo api.ServicePlanVisibilityRepository
+	orgRepo        api.OrganizationRepository
+}
+
+func NewEnableServiceAccess(ui terminal.UI, config configuration.Reader, brokerRepo api.ServiceBrokerRepository, serviceRepo api.ServiceRepository, visibilityRepo api.ServicePlanVisibilityRepository, orgRepo api.OrganizationRepository) (cmd EnableServiceAccess) {
+	cmd.ui = ui
+	cmd.config = config
+	cmd.brokerRepo = brokerRepo
+	cmd.serviceRepo = serviceRepo
+	cmd.visibilityRepo = visibilityRepo
+	cmd.orgRepo = orgRepo
+	return
+}
+
+func (cmd EnableServiceAccess) GetRequirements(reqFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
+	if len(c.Args()) != 1 {
+		err = errors.New("Incorrect Usage")
+		cmd.ui.FailWithUsage(c, "enable-service-access")
+		return
+	}
+
+	reqs = append(reqs, reqFactory.NewLoginRequirement())
+	return
+}
+
+func (cmd EnableServiceAccess) Run(c *cli.Context) (err error) {
+	serviceName := c.Args()[0]
+	planName := c.String("p")
+	orgName := c.String("o")
+
+	plansText, orgsText := accessDescription(planName, orgName)
+	cmd.ui.Say("Enabling access to %s of service %s for %s as %s...",
+		plansText,
+		terminal.EntityNameColor(serviceName),
+		orgsText,
+		terminal.EntityNameColor(cmd.config.Username()),
+	)
+
+	brokers, err := loadBrokerOfferings(cmd.brokerRepo, cmd.serviceRepo)
+	if err != nil {
+		return
+	}
+
+	plans, err := findServicePlans(brokers, serviceName, planName)
+	if err != nil {
+		return
+	}
+
+	if orgName == "" {
+		for _, plan := range plans {
+			if plan.Public {
+				continue
+			}
+			apiResponse := cmd.serviceRepo.SetServicePlanPublic(plan.Guid, true)
+			if apiResponse.IsNotSuccessful() {
+				err = errors.FromApiResponse(apiResponse)
+				return
+			}
+		}
+
+		cmd.ui.Ok()
+		return
+	}
+
+	org, apiResponse := cmd.orgRepo.FindByName(orgName)
+	if apiResponse.IsNotSuccessful() {
+		err = errors.FromApiResponse(apiResponse)
+		return
+	}
+
+	visibilities, apiResponse := cmd.visibilityRepo.List()
+	if apiResponse.IsNotSuccessful() {
+		err = errors.FromApiResponse(apiResponse)
+		return
+	}
+
+	publicPlanNames := []string{}
+	for _, plan := range plans {
+		if plan.Public {
+			publicPlanNames = append(publicPlanNames, plan.Name)
+			continue
+		}
+
+		alreadyVisible := false
+		for _, visibility := range planVisibilities(visibilities, plan.Guid) {
+			alreadyVisible = alreadyVisible || visibility.OrganizationGuid == org.Guid
+		}
+		if alreadyVisible {
+			continue
+		}
+
+		apiResponse = cmd.visibilityRepo.Create(plan.Guid, org.Guid)
+		if apiResponse.IsNotSuccessful() {
+			err = errors.FromApiResponse(apiResponse)
+			return
+		}
+	}
+
+	cmd.ui.Ok()
+	for _, name := range publicPlanNames {
+		cmd.ui.Say("Plan %s is already accessible to all orgs", terminal.EntityNameColor(name))
+	}
+	return
+}
@@ -0,0 +1,96 @@
+package serviceaccess_test
+
+import (
+	. "cf/commands/serviceaccess"
+	"cf/models"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	testassert "testhelpers/assert"
+	testcmd "testhelpers/commands"
+	testconfig "testhelpers/configuration"
+	testreq "testhelpers/requirements"
+	testterm "testhelpers/terminal"
+)
+
+var _ = Describe("enable-service-access command", func() {
+	var (
+		ui         *testterm.FakeUI
+		repos      serviceAccessRepos
+		reqFactory *testreq.FakeReqFactory
+	)
+
+	BeforeEach(func() {
+		ui = &testterm.FakeUI{}
+		repos = newServiceAccessRepos()
+		reqFactory = &testreq.FakeReqFactory{LoginSuccess: true}
+	})
+
+	callEnableServiceAccess := func(args []string) (err error) {
+		config := testconfig.NewRepositoryWithDefaults()
+		cmd := NewEnableServiceAccess(ui, config, repos.brokerRepo, repos.serviceRepo, repos.visibilityRepo, repos.orgRepo)
+		return testcmd.RunCommand(ui, cmd, testcmd.NewContext("enable-service-access", args), reqFactory)
+	}
+
+	It("fails with usage without a service", func() {
+		callEnableServiceAccess([]string{})
+		Expect(ui.FailedWithUsage).To(BeTrue())
+	})
+
+	It("requires a login", func() {
+		reqFactory.LoginSuccess = false
+		callEnableServiceAccess([]string{"mysql"})
+		Expect(testcmd.CommandDidPassRequirements).To(BeFalse())
+	})
+
+	It("makes the private plans of a service public", func() {
+		err := callEnableServiceAccess([]string{"mysql"})
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(repos.serviceRepo.PublicServicePlans).To(Equal(map[string]bool{"large-guid": true}))
+		testassert.SliceContains(ui.Outputs, testassert.Lines{
+			{"Enabling access to", "mysql", "my-user"},
+			{"OK"},
+		})
+	})
+
+	It("enables a plan for an org", func() {
+		err := callEnableServiceAccess([]string{"-p", "large", "-o", "org-2", "mysql"})
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(repos.serviceRepo.PublicServicePlans).To(BeNil())
+		Expect(repos.visibilityRepo.CreatedVisibilities).To(Equal([]models.ServicePlanVisibilityFields{
+			{ServicePlanGuid: "large-guid", OrganizationGuid: "org-2-guid"},
+		}))
+	})
+
+	It("leaves plans that are already accessible alone", func() {
+		err := callEnableServiceAccess([]string{"-o", "org-1", "mysql"})
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(repos.visibilityRepo.CreatedVisibilities).To(BeNil())
+		testassert.SliceContains(ui.Outputs, testassert.Lines{
+			{"OK"},
+			{"Plan", "small", "already accessible to all orgs"},
+		})
+	})
+
+	It("fails when the plan does not exist", func() {
+		err := callEnableServiceAccess([]string{"-p", "huge", "mysql"})
+
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("Plan huge not found for service mysql"))
+	})
+
+	It("fails when several brokers offer the service", func() {
+		otherBroker := models.ServiceBroker{}
+		otherBroker.Name = "other-broker"
+		otherBroker.Guid = "other-broker-guid"
+		repos.brokerRepo.ServiceBrokers = append(repos.brokerRepo.ServiceBrokers, otherBroker)
+		repos.serviceRepo.ServiceOfferingsByBrokerGuid["other-broker-guid"] = repos.serviceRepo.ServiceOfferingsByBrokerGuid["my-broker-guid"]
+
+		err := callEnableServiceAccess([]string{"mysql"})
+
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("offered by several brokers: my-broker, other-broker"))
+	})
+})
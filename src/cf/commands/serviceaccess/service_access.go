@@ -0,0 +1,119 @@
+package serviceaccess
+
+import (
+	"cf/api"
+	"cf/configuration"
+	"cf/errors"
+	"cf/models"
+	"cf/requirements"
+	"cf/terminal"
+	"github.com/codegangsta/cli"
+	"sort"
+	"strings"
+)
+
+type ServiceAccess struct {
+	ui             terminal.UI
+	config         configuration.Reader
+	brokerRepo     api.ServiceBrokerRepository
+	serviceRepo    api.ServiceRepository
+	visibilityRepo api.ServicePlanVisibilityRepository
+	orgRepo        api.OrganizationRepository
+}
+
+// planAccess is a row of the service-access table, and what structured
+// output prints
+type planAccess struct {
+	Broker  string   `json:"broker"`
+	Service string   `json:"service"`
+	Plan    string   `json:"plan"`
+	Access  string   `json:"access"`
+	Orgs    []string `json:"orgs"`
+}
+
+func NewServiceAccess(ui terminal.UI, config configuration.Reader, brokerRepo api.ServiceBrokerRepository, serviceRepo api.ServiceRepository, visibilityRepo api.ServicePlanVisibilityRepository, orgRepo api.OrganizationRepository) (cmd ServiceAccess) {
+	cmd.ui = ui
+	cmd.config = config
+	cmd.brokerRepo = brokerRepo
+	cmd.serviceRepo = serviceRepo
+	cmd.visibilityRepo = visibilityRepo
+	cmd.orgRepo = orgRepo
+	return
+}
+
+func (cmd ServiceAccess) GetRequirements(reqFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
+	if len(c.Args()) != 0 {
+		err = errors.New("Incorrect Usage")
+		cmd.ui.FailWithUsage(c, "service-access")
+		return
+	}
+
+	reqs = append(reqs, reqFactory.NewLoginRequirement())
+	return
+}
+
+func (cmd ServiceAccess) Run(c *cli.Context) (err error) {
+	cmd.ui.Say("Getting service access as %s...", terminal.EntityNameColor(cmd.config.Username()))
+
+	brokers, err := loadBrokerOfferings(cmd.brokerRepo, cmd.serviceRepo)
+	if err != nil {
+		return
+	}
+
+	visibilities, apiResponse := cmd.visibilityRepo.List()
+	if apiResponse.IsNotSuccessful() {
+		err = errors.FromApiResponse(apiResponse)
+		return
+	}
+
+	orgNames := map[string]string{}
+	apiResponse = cmd.orgRepo.ListOrgs(func(org models.Organization) bool {
+		orgNames[org.Guid] = org.Name
+		return true
+	})
+	if apiResponse.IsNotSuccessful() {
+		err = errors.FromApiResponse(apiResponse)
+		return
+	}
+
+	cmd.ui.Ok()
+	cmd.ui.Say("")
+
+	if len(brokers) == 0 {
+		cmd.ui.Say("No service brokers found")
+		return
+	}
+
+	table := cmd.ui.Table([]string{"broker", "service", "plan", "access", "orgs"})
+	for _, broker := range brokers {
+		sort.Sort(broker.Offerings)
+		for _, offering := range broker.Offerings {
+			for _, plan := range offering.Plans {
+				row := planAccess{
+					Broker:  broker.Broker.Name,
+					Service: offering.Label,
+					Plan:    plan.Name,
+					Access:  "none",
+					Orgs:    []string{},
+				}
+
+				for _, visibility := range planVisibilities(visibilities, plan.Guid) {
+					row.Orgs = append(row.Orgs, orgNames[visibility.OrganizationGuid])
+				}
+				sort.Strings(row.Orgs)
+
+				switch {
+				case plan.Public:
+					row.Access = "all"
+				case len(row.Orgs) > 0:
+					row.Access = "limited"
+				}
+
+				table.Add(row, row.Broker, row.Service, row.Plan, row.Access, strings.Join(row.Orgs, ", "))
+			}
+		}
+	}
+
+	err = table.Print()
+	return
+}
@@ -0,0 +1,103 @@
+package serviceaccess_test
+
+import (
+	. "cf/commands/serviceaccess"
+	"cf/models"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	testapi "testhelpers/api"
+	testassert "testhelpers/assert"
+	testcmd "testhelpers/commands"
+	testconfig "testhelpers/configuration"
+	testreq "testhelpers/requirements"
+	testterm "testhelpers/terminal"
+)
+
+type serviceAccessRepos struct {
+	brokerRepo     *testapi.FakeServiceBrokerRepo
+	serviceRepo    *testapi.FakeServiceRepo
+	visibilityRepo *testapi.FakeServicePlanVisibilityRepo
+	orgRepo        *testapi.FakeOrgRepository
+}
+
+// newServiceAccessRepos offers a mysql service with a public small plan and
+// a large plan enabled for org-1
+func newServiceAccessRepos() (repos serviceAccessRepos) {
+	broker := models.ServiceBroker{}
+	broker.Name = "my-broker"
+	broker.Guid = "my-broker-guid"
+
+	offering := models.ServiceOffering{}
+	offering.Label = "mysql"
+	offering.Guid = "mysql-guid"
+	offering.Plans = []models.ServicePlanFields{
+		{Name: "small", Guid: "small-guid", Public: true},
+		{Name: "large", Guid: "large-guid"},
+	}
+
+	org1 := models.Organization{}
+	org1.Name = "org-1"
+	org1.Guid = "org-1-guid"
+	org2 := models.Organization{}
+	org2.Name = "org-2"
+	org2.Guid = "org-2-guid"
+
+	repos.brokerRepo = &testapi.FakeServiceBrokerRepo{ServiceBrokers: []models.ServiceBroker{broker}}
+	repos.serviceRepo = &testapi.FakeServiceRepo{ServiceOfferingsByBrokerGuid: map[string]models.ServiceOfferings{
+		"my-broker-guid": {offering},
+	}}
+	repos.visibilityRepo = &testapi.FakeServicePlanVisibilityRepo{Visibilities: []models.ServicePlanVisibilityFields{
+		{Guid: "visibility-guid", ServicePlanGuid: "large-guid", OrganizationGuid: "org-1-guid"},
+	}}
+	repos.orgRepo = &testapi.FakeOrgRepository{Organizations: []models.Organization{org1, org2}}
+	return
+}
+
+var _ = Describe("service-access command", func() {
+	var (
+		ui         *testterm.FakeUI
+		repos      serviceAccessRepos
+		reqFactory *testreq.FakeReqFactory
+	)
+
+	BeforeEach(func() {
+		ui = &testterm.FakeUI{}
+		repos = newServiceAccessRepos()
+		reqFactory = &testreq.FakeReqFactory{LoginSuccess: true}
+	})
+
+	callServiceAccess := func(args []string) (err error) {
+		config := testconfig.NewRepositoryWithDefaults()
+		cmd := NewServiceAccess(ui, config, repos.brokerRepo, repos.serviceRepo, repos.visibilityRepo, repos.orgRepo)
+		return testcmd.RunCommand(ui, cmd, testcmd.NewContext("service-access", args), reqFactory)
+	}
+
+	It("requires a login", func() {
+		reqFactory.LoginSuccess = false
+		callServiceAccess([]string{})
+		Expect(testcmd.CommandDidPassRequirements).To(BeFalse())
+	})
+
+	It("lists the access to each plan", func() {
+		err := callServiceAccess([]string{})
+
+		Expect(err).NotTo(HaveOccurred())
+		testassert.SliceContains(ui.Outputs, testassert.Lines{
+			{"Getting service access as", "my-user"},
+			{"OK"},
+			{"broker", "service", "plan", "access", "orgs"},
+			{"my-broker", "mysql", "small", "all"},
+			{"my-broker", "mysql", "large", "limited", "org-1"},
+		})
+	})
+
+	It("says when there are no brokers", func() {
+		repos.brokerRepo.ServiceBrokers = []models.ServiceBroker{}
+		err := callServiceAccess([]string{})
+
+		Expect(err).NotTo(HaveOccurred())
+		testassert.SliceContains(ui.Outputs, testassert.Lines{
+			{"No service brokers found"},
+		})
+	})
+})
@@ -0,0 +1,98 @@
+package serviceaccess
+
+import (
+	"cf/api"
+	"cf/errors"
+	"cf/models"
+	"cf/terminal"
+	"strings"
+)
+
+// brokerOfferings are the services a broker offers, with their plans
+type brokerOfferings struct {
+	Broker    models.ServiceBroker
+	Offerings models.ServiceOfferings
+}
+
+func loadBrokerOfferings(brokerRepo api.ServiceBrokerRepository, serviceRepo api.ServiceRepository) (brokers []brokerOfferings, err error) {
+	apiResponse := brokerRepo.ListServiceBrokers(func(broker models.ServiceBroker) bool {
+		brokers = append(brokers, brokerOfferings{Broker: broker})
+		return true
+	})
+	if apiResponse.IsNotSuccessful() {
+		err = errors.FromApiResponseWithMessage(apiResponse, "Failed fetching service brokers.\n%s", apiResponse.Message)
+		return
+	}
+
+	for index := range brokers {
+		brokers[index].Offerings, apiResponse = serviceRepo.GetServiceOfferingsForBroker(brokers[index].Broker.Guid)
+		if apiResponse.IsNotSuccessful() {
+			err = errors.FromApiResponse(apiResponse)
+			return
+		}
+	}
+	return
+}
+
+// findServicePlans finds the plans of a service, or the one plan named when
+// planName is given
+func findServicePlans(brokers []brokerOfferings, serviceName, planName string) (plans []models.ServicePlanFields, err error) {
+	brokerNames := []string{}
+	var offering models.ServiceOffering
+	for _, broker := range brokers {
+		for _, brokerOffering := range broker.Offerings {
+			if brokerOffering.Label == serviceName {
+				offering = brokerOffering
+				brokerNames = append(brokerNames, broker.Broker.Name)
+			}
+		}
+	}
+
+	switch len(brokerNames) {
+	case 0:
+		err = errors.NewNotFoundError("Service %s not found", serviceName)
+		return
+	case 1:
+	default:
+		err = errors.New("Service %s is offered by several brokers: %s", serviceName, strings.Join(brokerNames, ", "))
+		return
+	}
+
+	if planName == "" {
+		plans = offering.Plans
+		return
+	}
+
+	for _, plan := range offering.Plans {
+		if plan.Name == planName {
+			plans = append(plans, plan)
+			return
+		}
+	}
+
+	err = errors.NewNotFoundError("Plan %s not found for service %s", planName, serviceName)
+	return
+}
+
+func planVisibilities(visibilities []models.ServicePlanVisibilityFields, planGuid string) (found []models.ServicePlanVisibilityFields) {
+	for _, visibility := range visibilities {
+		if visibility.ServicePlanGuid == planGuid {
+			found = append(found, visibility)
+		}
+	}
+	return
+}
+
+// accessDescription names the plans and orgs a command changes access to
+func accessDescription(planName, orgName string) (plans, orgs string) {
+	plans = "all plans"
+	if planName != "" {
+		plans = "plan " + terminal.EntityNameColor(planName)
+	}
+
+	orgs = "all orgs"
+	if orgName != "" {
+		orgs = "org " + terminal.EntityNameColor(orgName)
+	}
+	return
+}
@@ -3,10 +3,10 @@ package serviceauthtoken
 import (
 	"cf/api"
 	"cf/configuration"
+	"cf/errors"
 	"cf/models"
 	"cf/requirements"
 	"cf/terminal"
-	"errors"
 	"github.com/codegangsta/cli"
 )
 
@@ -36,7 +36,7 @@ func (cmd CreateServiceAuthTokenFields) GetRequirements(reqFactory requirements.
 	return
 }
 
-func (cmd CreateServiceAuthTokenFields) Run(c *cli.Context) {
+func (cmd CreateServiceAuthTokenFields) Run(c *cli.Context) (err error) {
 	cmd.ui.Say("Creating service auth token as %s...", terminal.EntityNameColor(cmd.config.Username()))
 
 	serviceAuthTokenRepo := models.ServiceAuthTokenFields{
@@ -47,9 +47,10 @@ func (cmd CreateServiceAuthTokenFields) Run(c *cli.Context) {
 
 	apiResponse := cmd.authTokenRepo.Create(serviceAuthTokenRepo)
 	if apiResponse.IsNotSuccessful() {
-		cmd.ui.Failed(apiResponse.Message)
+		err = errors.FromApiResponse(apiResponse)
 		return
 	}
 
 	cmd.ui.Ok()
+	return
 }
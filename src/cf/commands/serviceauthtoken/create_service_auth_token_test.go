@@ -21,7 +21,7 @@ func callCreateServiceAuthToken(args []string, reqFactory *testreq.FakeReqFactor
 	cmd := NewCreateServiceAuthToken(ui, config, authTokenRepo)
 	ctxt := testcmd.NewContext("create-service-auth-token", args)
 
-	testcmd.RunCommand(cmd, ctxt, reqFactory)
+	testcmd.RunCommand(ui, cmd, ctxt, reqFactory)
 	return
 }
 
@@ -3,9 +3,9 @@ package serviceauthtoken
 import (
 	"cf/api"
 	"cf/configuration"
+	"cf/errors"
 	"cf/requirements"
 	"cf/terminal"
-	"errors"
 	"fmt"
 	"github.com/codegangsta/cli"
 )
@@ -34,10 +34,15 @@ func (cmd DeleteServiceAuthTokenFields) GetRequirements(reqFactory requirements.
 	return
 }
 
-func (cmd DeleteServiceAuthTokenFields) Run(c *cli.Context) {
+func (cmd DeleteServiceAuthTokenFields) Run(c *cli.Context) (err error) {
 	tokenLabel := c.Args()[0]
 	tokenProvider := c.Args()[1]
 
+	if !c.Bool("f") && !cmd.ui.IsInteractive() {
+		err = errors.NewMissingInputError("confirmation", "-f")
+		return
+	}
+
 	if c.Bool("f") == false {
 		response := cmd.ui.Confirm(
 			"Are you sure you want to delete %s?%s",
@@ -52,7 +57,7 @@ func (cmd DeleteServiceAuthTokenFields) Run(c *cli.Context) {
 	cmd.ui.Say("Deleting service auth token as %s", terminal.EntityNameColor(cmd.config.Username()))
 	token, apiResponse := cmd.authTokenRepo.FindByLabelAndProvider(tokenLabel, tokenProvider)
 	if apiResponse.IsError() {
-		cmd.ui.Failed(apiResponse.Message)
+		err = errors.FromApiResponse(apiResponse)
 		return
 	}
 	if apiResponse.IsNotFound() {
@@ -63,9 +68,10 @@ func (cmd DeleteServiceAuthTokenFields) Run(c *cli.Context) {
 
 	apiResponse = cmd.authTokenRepo.Delete(token)
 	if apiResponse.IsNotSuccessful() {
-		cmd.ui.Failed(apiResponse.Message)
+		err = errors.FromApiResponse(apiResponse)
 		return
 	}
 
 	cmd.ui.Ok()
+	return
 }
@@ -24,7 +24,7 @@ func callDeleteServiceAuthToken(args []string, inputs []string, reqFactory *test
 	cmd := NewDeleteServiceAuthToken(ui, config, authTokenRepo)
 	ctxt := testcmd.NewContext("delete-service-auth-token", args)
 
-	testcmd.RunCommand(cmd, ctxt, reqFactory)
+	testcmd.RunCommand(ui, cmd, ctxt, reqFactory)
 	return
 }
 
@@ -3,11 +3,20 @@ package serviceauthtoken
 import (
 	"cf/api"
 	"cf/configuration"
+	"cf/errors"
 	"cf/requirements"
 	"cf/terminal"
 	"github.com/codegangsta/cli"
 )
 
+// authTokenOutput is what structured output prints for a service auth
+// token. The token itself is left out.
+type authTokenOutput struct {
+	Guid     string `json:"guid"`
+	Label    string `json:"label"`
+	Provider string `json:"provider"`
+}
+
 type ListServiceAuthTokens struct {
 	ui            terminal.UI
 	config        configuration.Reader
@@ -28,23 +37,22 @@ func (cmd ListServiceAuthTokens) GetRequirements(reqFactory requirements.Factory
 	return
 }
 
-func (cmd ListServiceAuthTokens) Run(c *cli.Context) {
+func (cmd ListServiceAuthTokens) Run(c *cli.Context) (err error) {
 	cmd.ui.Say("Getting service auth tokens as %s...", terminal.EntityNameColor(cmd.config.Username()))
 	authTokens, apiResponse := cmd.authTokenRepo.FindAll()
 	if apiResponse.IsNotSuccessful() {
-		cmd.ui.Failed(apiResponse.Message)
+		err = errors.FromApiResponse(apiResponse)
 		return
 	}
 	cmd.ui.Ok()
 	cmd.ui.Say("")
 
-	table := [][]string{
-		{"label", "provider"},
-	}
-
+	table := cmd.ui.Table([]string{"label", "provider"})
 	for _, authToken := range authTokens {
-		table = append(table, []string{authToken.Label, authToken.Provider})
+		output := authTokenOutput{Guid: authToken.Guid, Label: authToken.Label, Provider: authToken.Provider}
+		table.Add(output, authToken.Label, authToken.Provider)
 	}
 
-	cmd.ui.DisplayTable(table)
+	err = table.Print()
+	return
 }
@@ -3,6 +3,7 @@ package serviceauthtoken_test
 import (
 	. "cf/commands/serviceauthtoken"
 	"cf/models"
+	"cf/terminal"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	testapi "testhelpers/api"
@@ -20,7 +21,7 @@ func callListServiceAuthTokens(reqFactory *testreq.FakeReqFactory, authTokenRepo
 
 	cmd := NewListServiceAuthTokens(ui, config, authTokenRepo)
 	ctxt := testcmd.NewContext("service-auth-tokens", []string{})
-	testcmd.RunCommand(cmd, ctxt, reqFactory)
+	testcmd.RunCommand(ui, cmd, ctxt, reqFactory)
 
 	return
 }
@@ -59,4 +60,24 @@ var _ = Describe("Testing with ginkgo", func() {
 			{"a second label", "a second provider"},
 		})
 	})
+
+	It("prints the tokens without their secret when a structured output format is used", func() {
+		authTokenRepo := &testapi.FakeAuthTokenRepo{}
+		authTokenRepo.FindAllAuthTokens = []models.ServiceAuthTokenFields{
+			{Guid: "token-guid", Label: "a label", Provider: "a provider", Token: "s3cret"},
+		}
+
+		ui := &testterm.FakeUI{}
+		ui.SetOutputOptions(terminal.OutputOptions{Format: terminal.OutputJSON})
+		cmd := NewListServiceAuthTokens(ui, testconfig.NewRepositoryWithDefaults(), authTokenRepo)
+		testcmd.RunCommand(ui, cmd, testcmd.NewContext("service-auth-tokens", []string{}), &testreq.FakeReqFactory{LoginSuccess: true})
+
+		testassert.SliceContains(ui.Outputs, testassert.Lines{
+			{`"label": "a label"`},
+			{`"provider": "a provider"`},
+		})
+		testassert.SliceDoesNotContain(ui.Outputs, testassert.Lines{
+			{"s3cret"},
+		})
+	})
 })
@@ -3,9 +3,9 @@ package serviceauthtoken
 import (
 	"cf/api"
 	"cf/configuration"
+	"cf/errors"
 	"cf/requirements"
 	"cf/terminal"
-	"errors"
 	"github.com/codegangsta/cli"
 )
 
@@ -35,12 +35,12 @@ func (cmd UpdateServiceAuthTokenFields) GetRequirements(reqFactory requirements.
 	return
 }
 
-func (cmd UpdateServiceAuthTokenFields) Run(c *cli.Context) {
+func (cmd UpdateServiceAuthTokenFields) Run(c *cli.Context) (err error) {
 	cmd.ui.Say("Updating service auth token as %s...", terminal.EntityNameColor(cmd.config.Username()))
 
 	serviceAuthToken, apiResponse := cmd.authTokenRepo.FindByLabelAndProvider(c.Args()[0], c.Args()[1])
 	if apiResponse.IsNotSuccessful() {
-		cmd.ui.Failed(apiResponse.Message)
+		err = errors.FromApiResponse(apiResponse)
 		return
 	}
 
@@ -48,9 +48,10 @@ func (cmd UpdateServiceAuthTokenFields) Run(c *cli.Context) {
 
 	apiResponse = cmd.authTokenRepo.Update(serviceAuthToken)
 	if apiResponse.IsNotSuccessful() {
-		cmd.ui.Failed(apiResponse.Message)
+		err = errors.FromApiResponse(apiResponse)
 		return
 	}
 
 	cmd.ui.Ok()
+	return
 }
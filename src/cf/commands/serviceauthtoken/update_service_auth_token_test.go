@@ -21,7 +21,7 @@ func callUpdateServiceAuthToken(args []string, reqFactory *testreq.FakeReqFactor
 	cmd := NewUpdateServiceAuthToken(ui, config, authTokenRepo)
 	ctxt := testcmd.NewContext("update-service-auth-token", args)
 
-	testcmd.RunCommand(cmd, ctxt, reqFactory)
+	testcmd.RunCommand(ui, cmd, ctxt, reqFactory)
 	return
 }
 
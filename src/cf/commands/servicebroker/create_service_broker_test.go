@@ -70,6 +70,6 @@ func callCreateServiceBroker(args []string, reqFactory *testreq.FakeReqFactory,
 	ctxt := testcmd.NewContext("create-service-broker", args)
 	config := testconfig.NewRepositoryWithDefaults()
 	cmd := NewCreateServiceBroker(ui, config, serviceBrokerRepo)
-	testcmd.RunCommand(cmd, ctxt, reqFactory)
+	testcmd.RunCommand(ui, cmd, ctxt, reqFactory)
 	return
 }
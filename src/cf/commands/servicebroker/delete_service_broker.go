@@ -3,9 +3,9 @@ package servicebroker
 import (
 	"cf/api"
 	"cf/configuration"
+	"cf/errors"
 	"cf/requirements"
 	"cf/terminal"
-	"errors"
 	"github.com/codegangsta/cli"
 )
 
@@ -33,10 +33,15 @@ func (cmd DeleteServiceBroker) GetRequirements(reqFactory requirements.Factory,
 
 	return
 }
-func (cmd DeleteServiceBroker) Run(c *cli.Context) {
+func (cmd DeleteServiceBroker) Run(c *cli.Context) (err error) {
 	brokerName := c.Args()[0]
 	force := c.Bool("f")
 
+	if !force && !cmd.ui.IsInteractive() {
+		err = errors.NewMissingInputError("confirmation", "-f")
+		return
+	}
+
 	if !force {
 		response := cmd.ui.Confirm(
 			"Really delete %s?%s",
@@ -56,7 +61,7 @@ func (cmd DeleteServiceBroker) Run(c *cli.Context) {
 	broker, apiResponse := cmd.repo.FindByName(brokerName)
 
 	if apiResponse.IsError() {
-		cmd.ui.Failed(apiResponse.Message)
+		err = errors.FromApiResponse(apiResponse)
 		return
 	}
 
@@ -68,7 +73,7 @@ func (cmd DeleteServiceBroker) Run(c *cli.Context) {
 
 	apiResponse = cmd.repo.Delete(broker.Guid)
 	if apiResponse.IsNotSuccessful() {
-		cmd.ui.Failed(apiResponse.Message)
+		err = errors.FromApiResponse(apiResponse)
 		return
 	}
 
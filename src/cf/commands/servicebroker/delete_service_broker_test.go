@@ -19,7 +19,7 @@ func callDeleteServiceBroker(args []string, reqFactory *testreq.FakeReqFactory,
 	config := testconfig.NewRepositoryWithDefaults()
 
 	cmd := NewDeleteServiceBroker(ui, config, repo)
-	testcmd.RunCommand(cmd, ctxt, reqFactory)
+	testcmd.RunCommand(ui, cmd, ctxt, reqFactory)
 	return
 }
 
@@ -37,7 +37,7 @@ func deleteServiceBroker(confirmation string, args []string) (ui *testterm.FakeU
 
 	ctxt := testcmd.NewContext("delete-service-broker", args)
 	cmd := NewDeleteServiceBroker(ui, config, repo)
-	testcmd.RunCommand(cmd, ctxt, reqFactory)
+	testcmd.RunCommand(ui, cmd, ctxt, reqFactory)
 	return
 }
 
@@ -3,12 +3,21 @@ package servicebroker
 import (
 	"cf/api"
 	"cf/configuration"
+	"cf/errors"
 	"cf/models"
 	"cf/requirements"
 	"cf/terminal"
 	"github.com/codegangsta/cli"
 )
 
+// brokerOutput is what structured output prints for a broker, leaving out
+// its credentials
+type brokerOutput struct {
+	Guid string `json:"guid"`
+	Name string `json:"name"`
+	Url  string `json:"url"`
+}
+
 type ListServiceBrokers struct {
 	ui     terminal.UI
 	config configuration.Reader
@@ -26,23 +35,30 @@ func (cmd ListServiceBrokers) GetRequirements(reqFactory requirements.Factory, c
 	return
 }
 
-func (cmd ListServiceBrokers) Run(c *cli.Context) {
+func (cmd ListServiceBrokers) Run(c *cli.Context) (err error) {
 	cmd.ui.Say("Getting service brokers as %s...\n", terminal.EntityNameColor(cmd.config.Username()))
 
 	table := cmd.ui.Table([]string{"name", "url"})
 	foundBrokers := false
 	apiStatus := cmd.repo.ListServiceBrokers(func(serviceBroker models.ServiceBroker) bool {
-		table.Print([][]string{{serviceBroker.Name, serviceBroker.Url}})
+		output := brokerOutput{Guid: serviceBroker.Guid, Name: serviceBroker.Name, Url: serviceBroker.Url}
+		table.Add(output, serviceBroker.Name, serviceBroker.Url)
 		foundBrokers = true
 		return true
 	})
 
 	if apiStatus.IsNotSuccessful() {
-		cmd.ui.Failed("Failed fetching service brokers.\n%s", apiStatus.Message)
+		err = errors.FromApiResponseWithMessage(apiStatus, "Failed fetching service brokers.\n%s", apiStatus.Message)
+		return
+	}
+
+	err = table.Print()
+	if err != nil {
 		return
 	}
 
 	if !foundBrokers {
 		cmd.ui.Say("No service brokers found")
 	}
+	return
 }
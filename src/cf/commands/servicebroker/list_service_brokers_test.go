@@ -3,6 +3,7 @@ package servicebroker_test
 import (
 	. "cf/commands/servicebroker"
 	"cf/models"
+	"cf/terminal"
 	. "github.com/onsi/ginkgo"
 	testapi "testhelpers/api"
 	testassert "testhelpers/assert"
@@ -17,7 +18,7 @@ func callListServiceBrokers(args []string, serviceBrokerRepo *testapi.FakeServic
 	config := testconfig.NewRepositoryWithDefaults()
 	ctxt := testcmd.NewContext("service-brokers", args)
 	cmd := NewListServiceBrokers(ui, config, serviceBrokerRepo)
-	testcmd.RunCommand(cmd, ctxt, &testreq.FakeReqFactory{})
+	testcmd.RunCommand(ui, cmd, ctxt, &testreq.FakeReqFactory{})
 
 	return
 }
@@ -52,6 +53,33 @@ var _ = Describe("Testing with ginkgo", func() {
 			{"service-broker-to-list-c", "http://service-c-url.com"},
 		})
 	})
+	It("leaves broker credentials out of structured output", func() {
+		broker := models.ServiceBroker{}
+		broker.Name = "service-broker-to-list-a"
+		broker.Guid = "service-broker-to-list-guid-a"
+		broker.Url = "http://service-a-url.com"
+		broker.Username = "broker-user"
+		broker.Password = "s3cret"
+
+		repo := &testapi.FakeServiceBrokerRepo{
+			ServiceBrokers: []models.ServiceBroker{broker},
+		}
+
+		ui := &testterm.FakeUI{}
+		ui.SetOutputOptions(terminal.OutputOptions{Format: terminal.OutputJSON})
+		cmd := NewListServiceBrokers(ui, testconfig.NewRepositoryWithDefaults(), repo)
+		testcmd.RunCommand(ui, cmd, testcmd.NewContext("service-brokers", []string{}), &testreq.FakeReqFactory{})
+
+		testassert.SliceContains(ui.Outputs, testassert.Lines{
+			{`"name": "service-broker-to-list-a"`},
+			{`"url": "http://service-a-url.com"`},
+		})
+		testassert.SliceDoesNotContain(ui.Outputs, testassert.Lines{
+			{"broker-user"},
+			{"s3cret"},
+		})
+	})
+
 	It("TestListingServiceBrokersWhenNoneExist", func() {
 
 		repo := &testapi.FakeServiceBrokerRepo{
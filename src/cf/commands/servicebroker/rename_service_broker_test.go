@@ -18,7 +18,7 @@ func callRenameServiceBroker(args []string, reqFactory *testreq.FakeReqFactory,
 	config := testconfig.NewRepositoryWithDefaults()
 	cmd := NewRenameServiceBroker(ui, config, repo)
 	ctxt := testcmd.NewContext("rename-service-broker", args)
-	testcmd.RunCommand(cmd, ctxt, reqFactory)
+	testcmd.RunCommand(ui, cmd, ctxt, reqFactory)
 
 	return
 }
@@ -20,7 +20,7 @@ func callUpdateServiceBroker(args []string, reqFactory *testreq.FakeReqFactory,
 
 	cmd := NewUpdateServiceBroker(ui, config, repo)
 	ctxt := testcmd.NewContext("update-service-broker", args)
-	testcmd.RunCommand(cmd, ctxt, reqFactory)
+	testcmd.RunCommand(ui, cmd, ctxt, reqFactory)
 
 	return
 }
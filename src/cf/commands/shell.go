@@ -0,0 +1,179 @@
+package commands
+
+import (
+	"bufio"
+	"cf"
+	"cf/configuration"
+	"cf/errors"
+	"cf/requirements"
+	"cf/terminal"
+	"fmt"
+	"github.com/codegangsta/cli"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// commands whose lines are kept out of the shell history, as they carry
+// passwords, tokens or credentials
+var shellHistoryExcludedCommands = map[string]bool{
+	"auth":                         true,
+	"create-service-auth-token":    true,
+	"create-service-broker":        true,
+	"create-user":                  true,
+	"create-user-provided-service": true,
+	"login":                        true,
+	"update-service-auth-token":    true,
+	"update-service-broker":        true,
+	"update-user-provided-service": true,
+}
+
+type Shell struct {
+	ui          terminal.UI
+	config      configuration.Reader
+	cmdFactory  Factory
+	reqFactory  requirements.Factory
+	in          io.Reader
+	out         io.Writer
+	historyPath string
+}
+
+func NewShell(ui terminal.UI, config configuration.Reader, cmdFactory Factory, in io.Reader, out io.Writer, historyPath string) (cmd *Shell) {
+	cmd = new(Shell)
+	cmd.ui = ui
+	cmd.config = config
+	cmd.cmdFactory = cmdFactory
+	cmd.in = in
+	cmd.out = out
+	cmd.historyPath = historyPath
+	return
+}
+
+func (cmd *Shell) GetRequirements(reqFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
+	if len(c.Args()) != 0 {
+		err = errors.New("Incorrect Usage")
+		cmd.ui.FailWithUsage(c, "shell")
+		return
+	}
+
+	// the commands entered check their own requirements
+	cmd.reqFactory = reqFactory
+	return
+}
+
+func (cmd *Shell) Run(c *cli.Context) (err error) {
+	// Ctrl-C stops the commands that wait on the platform, such as push,
+	// start, wait-for-service and logs, returning to the prompt instead of
+	// ending the shell. Other commands finish what they are doing first.
+	stopCatchingInterrupts := terminal.CatchInterrupts()
+	defer stopCatchingInterrupts()
+
+	runner := newCommandLineRunner(cmd.ui, cmd.config, NewRunner(cmd.ui, cmd.cmdFactory, cmd.reqFactory), c, "the shell", map[string]string{
+		"shell": "The shell is already running",
+	})
+
+	reader := terminal.NewLineReader(cmd.in, cmd.out, cmd.completer(c))
+	reader.SetHistory(readShellHistory(cmd.historyPath))
+
+	cmd.ui.Say("Type 'help' for the commands, 'exit' or Ctrl-D to leave.")
+	for {
+		line, readErr := reader.ReadLine(cmd.prompt())
+		if readErr == io.EOF {
+			fmt.Fprintln(cmd.out)
+			return
+		}
+		if readErr != nil {
+			err = errors.New("Error reading command\n%s", readErr.Error())
+			return
+		}
+
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		if line == "exit" || line == "quit" {
+			return
+		}
+
+		// lines that don't parse are left out too, as there is no telling
+		// what they carry
+		if name := runner.CommandName(line); name != "" && !shellHistoryExcludedCommands[name] {
+			reader.AddHistory(line)
+			writeShellHistory(cmd.historyPath, reader.History())
+		}
+
+		// failures have been reported, the shell carries on
+		terminal.ClearInterrupts()
+		runner.Run(line)
+	}
+}
+
+func (cmd *Shell) prompt() string {
+	target := ""
+	switch {
+	case cmd.config.HasSpace():
+		target = fmt.Sprintf(" [%s/%s]", cmd.config.OrganizationFields().Name, cmd.config.SpaceFields().Name)
+	case cmd.config.HasOrganization():
+		target = fmt.Sprintf(" [%s]", cmd.config.OrganizationFields().Name)
+	}
+	return cf.Name() + target + "> "
+}
+
+// completer completes command names and aliases as the first word, and the
+// flags of the command afterwards
+func (cmd *Shell) completer(c *cli.Context) terminal.Completer {
+	return func(line string) (candidates []string) {
+		words := strings.Fields(line)
+		if len(words) > 0 && (words[0] == "cf" || words[0] == cf.Name()) {
+			words = words[1:]
+		}
+
+		completingFirstWord := len(words) == 0 || (len(words) == 1 && !strings.HasSuffix(line, " "))
+		if completingFirstWord {
+			candidates = append(candidates, "exit")
+			for _, name := range cmd.cmdFactory.CommandNames() {
+				if !strings.HasPrefix(name, hiddenCommandPrefix) {
+					candidates = append(candidates, name)
+				}
+			}
+			for name := range cmd.config.Aliases() {
+				candidates = append(candidates, name)
+			}
+			sort.Strings(candidates)
+			return
+		}
+
+		cliCommand := c.App.Command(words[0])
+		if cliCommand == nil || strings.HasSuffix(line, " ") || !strings.HasPrefix(words[len(words)-1], "-") {
+			return
+		}
+
+		candidates, _ = completionFlags(cliCommand.Flags)
+		return
+	}
+}
+
+func readShellHistory(path string) (history []string) {
+	file, err := os.Open(path)
+	if err != nil {
+		return
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		history = append(history, scanner.Text())
+	}
+	return
+}
+
+// the history is a convenience, failing to save it does not stop the shell
+func writeShellHistory(path string, history []string) {
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return
+	}
+	ioutil.WriteFile(path, []byte(strings.Join(history, "\n")+"\n"), 0600)
+}
@@ -0,0 +1,117 @@
+package commands_test
+
+import (
+	"bytes"
+	"cf/app"
+	. "cf/commands"
+	"cf/errors"
+	"fileutils"
+	"flag"
+	"github.com/codegangsta/cli"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	testassert "testhelpers/assert"
+	testcmd "testhelpers/commands"
+	testconfig "testhelpers/configuration"
+	testreq "testhelpers/requirements"
+	testterm "testhelpers/terminal"
+)
+
+var _ = Describe("shell command", func() {
+	var (
+		ui         *testterm.FakeUI
+		cmdFactory *scriptCommandFactory
+		out        *bytes.Buffer
+		history    string
+	)
+
+	BeforeEach(func() {
+		ui = &testterm.FakeUI{}
+		cmdFactory = &scriptCommandFactory{Errors: map[string]error{}}
+		out = &bytes.Buffer{}
+	})
+
+	runShell := func(input string) (err error) {
+		fileutils.TempDir("shell", func(dir string, tmpErr error) {
+			Expect(tmpErr).NotTo(HaveOccurred())
+
+			cfApp, tmpErr := app.NewApp(NewRunner(ui, ConcreteFactory{}, &testreq.FakeReqFactory{}))
+			Expect(tmpErr).NotTo(HaveOccurred())
+			ctxt := cli.NewContext(cfApp, new(flag.FlagSet), new(flag.FlagSet))
+
+			config := testconfig.NewRepositoryWithDefaults()
+			config.SetAlias("lp", "logs --recent")
+
+			historyPath := filepath.Join(dir, "shell_history")
+			cmd := NewShell(ui, config, cmdFactory, strings.NewReader(input), out, historyPath)
+			err = testcmd.RunCommand(ui, cmd, ctxt, &testreq.FakeReqFactory{})
+
+			contents, _ := ioutil.ReadFile(historyPath)
+			history = string(contents)
+		})
+		return
+	}
+
+	It("runs commands until exit, showing the target in the prompt", func() {
+		err := runShell("apps\ncf create-space dev\nexit\npush my-app\n")
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cmdFactory.Ran).To(Equal([][]string{{"apps"}, {"create-space", "dev"}}))
+		Expect(out.String()).To(ContainSubstring("[my-org/my-space]> "))
+	})
+
+	It("carries on after a command fails", func() {
+		cmdFactory.Errors["create-space"] = errors.New("Space dev already exists")
+		err := runShell("create-space dev\npush my-app --bogus-flag\nshell\napps\n")
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cmdFactory.Ran).To(Equal([][]string{{"create-space", "dev"}, {"apps"}}))
+		testassert.SliceContains(ui.Outputs, testassert.Lines{
+			{"FAILED"},
+			{"Incorrect Usage", "bogus-flag"},
+			{"FAILED"},
+			{"The shell is already running"},
+		})
+	})
+
+	It("expands aliases", func() {
+		err := runShell("lp my-app\n")
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cmdFactory.Ran).To(Equal([][]string{{"logs", "my-app"}}))
+	})
+
+	It("completes the flags of a command", func() {
+		err := runShell("push my-app --no-st\t\n")
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cmdFactory.Ran).To(Equal([][]string{{"push", "my-app"}}))
+		Expect(history).To(ContainSubstring("push my-app --no-start"))
+	})
+
+	It("keeps commands carrying passwords out of the history by their short names too", func() {
+		err := runShell("apps\nl -u admin -p s3cret\nuups my-db -p @creds.json\nspaces\n")
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(history).To(Equal("apps\nspaces\n"))
+		Expect(history).NotTo(ContainSubstring("s3cret"))
+	})
+
+	It("keeps broker passwords and service auth tokens out of the history", func() {
+		err := runShell("apps\ncreate-service-broker b u secret http://broker.example.com\nupdate-service-auth-token label provider token\nspaces\n")
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(history).To(Equal("apps\nspaces\n"))
+		Expect(history).NotTo(ContainSubstring("secret"))
+	})
+
+	It("keeps commands carrying passwords out of the history", func() {
+		err := runShell("apps\nlogin -p secret\nauth me secret\ncups my-db -p @creds.json\nuups my-db -p '{\"password\":\"secret\"}'\nuups my-db -p '{\"password\nspaces\n")
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(history).To(Equal("apps\nspaces\n"))
+	})
+})
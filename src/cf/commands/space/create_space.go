@@ -5,10 +5,10 @@ import (
 	"cf/api"
 	"cf/commands/user"
 	"cf/configuration"
+	"cf/errors"
 	"cf/models"
 	"cf/requirements"
 	"cf/terminal"
-	"errors"
 	"github.com/codegangsta/cli"
 )
 
@@ -46,7 +46,7 @@ func (cmd CreateSpace) GetRequirements(reqFactory requirements.Factory, c *cli.C
 	return
 }
 
-func (cmd CreateSpace) Run(c *cli.Context) {
+func (cmd CreateSpace) Run(c *cli.Context) (err error) {
 	spaceName := c.Args()[0]
 	orgName := c.String("o")
 	orgGuid := ""
@@ -64,11 +64,11 @@ func (cmd CreateSpace) Run(c *cli.Context) {
 	if orgGuid == "" {
 		org, apiResponse := cmd.orgRepo.FindByName(orgName)
 		if apiResponse.IsNotFound() {
-			cmd.ui.Failed("Org %s does not exist or is not accessible", orgName)
+			err = errors.NewNotFoundError("Org %s does not exist or is not accessible", orgName)
 			return
 		}
 		if apiResponse.IsError() {
-			cmd.ui.Failed("Error finding org %s\n%s", orgName, apiResponse.Message)
+			err = errors.FromApiResponseWithMessage(apiResponse, "Error finding org %s\n%s", orgName, apiResponse.Message)
 			return
 		}
 		orgGuid = org.Guid
@@ -81,24 +81,21 @@ func (cmd CreateSpace) Run(c *cli.Context) {
 			cmd.ui.Warn("Space %s already exists", spaceName)
 			return
 		}
-		cmd.ui.Failed(apiResponse.Message)
+		err = errors.FromApiResponse(apiResponse)
 		return
 	}
 	cmd.ui.Ok()
 
-	var err error
-
 	err = cmd.spaceRoleSetter.SetSpaceRole(space, models.SPACE_MANAGER, cmd.config.UserGuid(), cmd.config.Username())
 	if err != nil {
-		cmd.ui.Failed(err.Error())
 		return
 	}
 
 	err = cmd.spaceRoleSetter.SetSpaceRole(space, models.SPACE_DEVELOPER, cmd.config.UserGuid(), cmd.config.Username())
 	if err != nil {
-		cmd.ui.Failed(err.Error())
 		return
 	}
 
 	cmd.ui.Say("\nTIP: Use '%s' to target new space", terminal.CommandColor(cf.Name()+" target -o "+orgName+" -s "+space.Name))
+	return
 }
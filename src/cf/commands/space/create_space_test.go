@@ -55,7 +55,7 @@ func callCreateSpace(args []string, reqFactory *testreq.FakeReqFactory, spaceRep
 	configRepo := testconfig.NewRepositoryWithDefaults()
 	spaceRoleSetter := user.NewSetSpaceRole(ui, configRepo, spaceRepo, userRepo)
 	cmd := NewCreateSpace(ui, configRepo, spaceRoleSetter, spaceRepo, orgRepo, userRepo)
-	testcmd.RunCommand(cmd, ctxt, reqFactory)
+	testcmd.RunCommand(ui, cmd, ctxt, reqFactory)
 	return
 }
 
@@ -4,10 +4,10 @@ import (
 	"cf"
 	"cf/api"
 	"cf/configuration"
+	"cf/errors"
 	"cf/models"
 	"cf/requirements"
 	"cf/terminal"
-	"errors"
 	"github.com/codegangsta/cli"
 )
 
@@ -42,7 +42,7 @@ func (cmd *DeleteSpace) GetRequirements(reqFactory requirements.Factory, c *cli.
 	return
 }
 
-func (cmd *DeleteSpace) Run(c *cli.Context) {
+func (cmd *DeleteSpace) Run(c *cli.Context) (err error) {
 	spaceName := c.Args()[0]
 	force := c.Bool("f")
 
@@ -54,6 +54,11 @@ func (cmd *DeleteSpace) Run(c *cli.Context) {
 
 	space := cmd.spaceReq.GetSpace()
 
+	if !force && !cmd.ui.IsInteractive() {
+		err = errors.NewMissingInputError("confirmation", "-f")
+		return
+	}
+
 	if !force {
 		response := cmd.ui.Confirm(
 			"Really delete space %s and everything associated with it?%s",
@@ -67,7 +72,7 @@ func (cmd *DeleteSpace) Run(c *cli.Context) {
 
 	apiResponse := cmd.spaceRepo.Delete(space.Guid)
 	if apiResponse.IsNotSuccessful() {
-		cmd.ui.Failed(apiResponse.Message)
+		err = errors.FromApiResponse(apiResponse)
 		return
 	}
 
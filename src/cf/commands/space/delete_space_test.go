@@ -33,7 +33,7 @@ func deleteSpace(inputs []string, args []string, reqFactory *testreq.FakeReqFact
 	ctxt := testcmd.NewContext("delete-space", args)
 	configRepo := testconfig.NewRepositoryWithDefaults()
 	cmd := NewDeleteSpace(ui, configRepo, spaceRepo)
-	testcmd.RunCommand(cmd, ctxt, reqFactory)
+	testcmd.RunCommand(ui, cmd, ctxt, reqFactory)
 	return
 }
 
@@ -106,7 +106,7 @@ var _ = Describe("Testing with ginkgo", func() {
 		ctxt := testcmd.NewContext("delete", []string{"-f", "space-to-delete"})
 
 		cmd := NewDeleteSpace(ui, config, spaceRepo)
-		testcmd.RunCommand(cmd, ctxt, reqFactory)
+		testcmd.RunCommand(ui, cmd, ctxt, reqFactory)
 
 		Expect(config.HasSpace()).To(Equal(false))
 	})
@@ -126,7 +126,7 @@ var _ = Describe("Testing with ginkgo", func() {
 		ctxt := testcmd.NewContext("delete", []string{"-f", "space-to-delete"})
 
 		cmd := NewDeleteSpace(ui, config, spaceRepo)
-		testcmd.RunCommand(cmd, ctxt, reqFactory)
+		testcmd.RunCommand(ui, cmd, ctxt, reqFactory)
 
 		Expect(config.HasSpace()).To(Equal(true))
 	})
@@ -3,6 +3,7 @@ package space
 import (
 	"cf/api"
 	"cf/configuration"
+	"cf/errors"
 	"cf/models"
 	"cf/requirements"
 	"cf/terminal"
@@ -30,7 +31,7 @@ func (cmd ListSpaces) GetRequirements(reqFactory requirements.Factory, c *cli.Co
 	return
 }
 
-func (cmd ListSpaces) Run(c *cli.Context) {
+func (cmd ListSpaces) Run(c *cli.Context) (err error) {
 	cmd.ui.Say("Getting spaces in org %s as %s...\n",
 		terminal.EntityNameColor(cmd.config.OrganizationFields().Name),
 		terminal.EntityNameColor(cmd.config.Username()))
@@ -38,17 +39,23 @@ func (cmd ListSpaces) Run(c *cli.Context) {
 	foundSpaces := false
 	table := cmd.ui.Table([]string{"name"})
 	apiResponse := cmd.spaceRepo.ListSpaces(func(space models.Space) bool {
-		table.Print([][]string{{space.Name}})
+		table.Add(newSpaceOutput(space.SpaceFields), space.Name)
 		foundSpaces = true
 		return true
 	})
 
 	if apiResponse.IsNotSuccessful() {
-		cmd.ui.Failed("Failed fetching spaces.\n%s", apiResponse.Message)
+		err = errors.FromApiResponseWithMessage(apiResponse, "Failed fetching spaces.\n%s", apiResponse.Message)
+		return
+	}
+
+	err = table.Print()
+	if err != nil {
 		return
 	}
 
 	if !foundSpaces {
 		cmd.ui.Say("No spaces found")
 	}
+	return
 }
@@ -20,7 +20,7 @@ func callSpaces(args []string, reqFactory *testreq.FakeReqFactory, config config
 	ctxt := testcmd.NewContext("spaces", args)
 
 	cmd := NewListSpaces(ui, config, spaceRepo)
-	testcmd.RunCommand(cmd, ctxt, reqFactory)
+	testcmd.RunCommand(ui, cmd, ctxt, reqFactory)
 	return
 }
 
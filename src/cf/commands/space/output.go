@@ -0,0 +1,44 @@
+package space
+
+import "cf/models"
+
+// spaceOutput is what structured output prints for each space listed by
+// spaces
+type spaceOutput struct {
+	Guid string `json:"guid"`
+	Name string `json:"name"`
+}
+
+// spaceDetailsOutput adds what space shows about a space
+type spaceDetailsOutput struct {
+	spaceOutput
+	Org      string   `json:"org"`
+	Apps     []string `json:"apps"`
+	Domains  []string `json:"domains"`
+	Services []string `json:"services"`
+}
+
+func newSpaceOutput(space models.SpaceFields) spaceOutput {
+	return spaceOutput{Guid: space.Guid, Name: space.Name}
+}
+
+func newSpaceDetailsOutput(space models.Space) (output spaceDetailsOutput) {
+	output = spaceDetailsOutput{
+		spaceOutput: newSpaceOutput(space.SpaceFields),
+		Org:         space.Organization.Name,
+		Apps:        []string{},
+		Domains:     []string{},
+		Services:    []string{},
+	}
+
+	for _, app := range space.Applications {
+		output.Apps = append(output.Apps, app.Name)
+	}
+	for _, domain := range space.Domains {
+		output.Domains = append(output.Domains, domain.Name)
+	}
+	for _, service := range space.ServiceInstances {
+		output.Services = append(output.Services, service.Name)
+	}
+	return
+}
@@ -3,9 +3,9 @@ package space
 import (
 	"cf/api"
 	"cf/configuration"
+	"cf/errors"
 	"cf/requirements"
 	"cf/terminal"
-	"errors"
 	"github.com/codegangsta/cli"
 )
 
@@ -39,7 +39,7 @@ func (cmd *RenameSpace) GetRequirements(reqFactory requirements.Factory, c *cli.
 	return
 }
 
-func (cmd *RenameSpace) Run(c *cli.Context) {
+func (cmd *RenameSpace) Run(c *cli.Context) (err error) {
 	space := cmd.spaceReq.GetSpace()
 	newName := c.Args()[1]
 	cmd.ui.Say("Renaming space %s to %s in org %s as %s...",
@@ -51,7 +51,7 @@ func (cmd *RenameSpace) Run(c *cli.Context) {
 
 	apiResponse := cmd.spaceRepo.Rename(space.Guid, newName)
 	if apiResponse.IsNotSuccessful() {
-		cmd.ui.Failed(apiResponse.Message)
+		err = errors.FromApiResponse(apiResponse)
 		return
 	}
 
@@ -61,4 +61,5 @@ func (cmd *RenameSpace) Run(c *cli.Context) {
 	}
 
 	cmd.ui.Ok()
+	return
 }
@@ -18,7 +18,7 @@ func callRenameSpace(args []string, reqFactory *testreq.FakeReqFactory, spaceRep
 	ctxt := testcmd.NewContext("create-space", args)
 	configRepo := testconfig.NewRepositoryWithDefaults()
 	cmd := NewRenameSpace(ui, configRepo, spaceRepo)
-	testcmd.RunCommand(cmd, ctxt, reqFactory)
+	testcmd.RunCommand(ui, cmd, ctxt, reqFactory)
 	return
 }
 
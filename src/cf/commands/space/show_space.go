@@ -2,9 +2,9 @@ package space
 
 import (
 	"cf/configuration"
+	"cf/errors"
 	"cf/requirements"
 	"cf/terminal"
-	"errors"
 	"github.com/codegangsta/cli"
 	"strings"
 )
@@ -38,7 +38,7 @@ func (cmd *ShowSpace) GetRequirements(reqFactory requirements.Factory, c *cli.Co
 	return
 }
 
-func (cmd *ShowSpace) Run(c *cli.Context) {
+func (cmd *ShowSpace) Run(c *cli.Context) (err error) {
 	space := cmd.spaceReq.GetSpace()
 	cmd.ui.Say("Getting info for space %s in org %s as %s...",
 		terminal.EntityNameColor(space.Name),
@@ -46,6 +46,12 @@ func (cmd *ShowSpace) Run(c *cli.Context) {
 		terminal.EntityNameColor(cmd.config.Username()),
 	)
 	cmd.ui.Ok()
+
+	if cmd.ui.OutputOptions().IsStructured() {
+		err = cmd.ui.PrintValue(newSpaceDetailsOutput(space))
+		return
+	}
+
 	cmd.ui.Say("\n%s:", terminal.EntityNameColor(space.Name))
 	cmd.ui.Say("  Org: %s", terminal.EntityNameColor(space.Organization.Name))
 
@@ -66,4 +72,5 @@ func (cmd *ShowSpace) Run(c *cli.Context) {
 		services = append(services, service.Name)
 	}
 	cmd.ui.Say("  Services: %s", terminal.EntityNameColor(strings.Join(services, ", ")))
+	return
 }
@@ -19,7 +19,7 @@ func callShowSpace(args []string, reqFactory *testreq.FakeReqFactory) (ui *testt
 	config := testconfig.NewRepositoryWithDefaults()
 
 	cmd := NewShowSpace(ui, config)
-	testcmd.RunCommand(cmd, ctxt, reqFactory)
+	testcmd.RunCommand(ui, cmd, ctxt, reqFactory)
 	return
 }
 
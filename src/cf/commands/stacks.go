@@ -3,11 +3,19 @@ package commands
 import (
 	"cf/api"
 	"cf/configuration"
+	"cf/errors"
 	"cf/requirements"
 	"cf/terminal"
 	"github.com/codegangsta/cli"
 )
 
+// stackOutput is what structured output prints for a stack
+type stackOutput struct {
+	Guid        string `json:"guid"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
 type Stacks struct {
 	ui         terminal.UI
 	config     configuration.Reader
@@ -26,7 +34,7 @@ func (cmd *Stacks) GetRequirements(reqFactory requirements.Factory, c *cli.Conte
 	return
 }
 
-func (cmd *Stacks) Run(c *cli.Context) {
+func (cmd *Stacks) Run(c *cli.Context) (err error) {
 	cmd.ui.Say("Getting stacks in org %s / space %s as %s...",
 		terminal.EntityNameColor(cmd.config.OrganizationFields().Name),
 		terminal.EntityNameColor(cmd.config.SpaceFields().Name),
@@ -35,23 +43,19 @@ func (cmd *Stacks) Run(c *cli.Context) {
 
 	stacks, apiResponse := cmd.stacksRepo.FindAll()
 	if apiResponse.IsNotSuccessful() {
-		cmd.ui.Failed(apiResponse.Message)
+		err = errors.FromApiResponse(apiResponse)
 		return
 	}
 
 	cmd.ui.Ok()
 	cmd.ui.Say("")
 
-	table := [][]string{
-		[]string{"name", "description"},
-	}
-
+	table := cmd.ui.Table([]string{"name", "description"})
 	for _, stack := range stacks {
-		table = append(table, []string{
-			stack.Name,
-			stack.Description,
-		})
+		output := stackOutput{Guid: stack.Guid, Name: stack.Name, Description: stack.Description}
+		table.Add(output, stack.Name, stack.Description)
 	}
 
-	cmd.ui.DisplayTable(table)
+	err = table.Print()
+	return
 }
@@ -3,6 +3,7 @@ package commands_test
 import (
 	. "cf/commands"
 	"cf/models"
+	"cf/terminal"
 	. "github.com/onsi/ginkgo"
 	testapi "testhelpers/api"
 	testassert "testhelpers/assert"
@@ -16,7 +17,7 @@ func callStacks(stackRepo *testapi.FakeStackRepository) (ui *testterm.FakeUI) {
 	ctxt := testcmd.NewContext("stacks", []string{})
 	configRepo := testconfig.NewRepositoryWithDefaults()
 	cmd := NewStacks(ui, configRepo, stackRepo)
-	testcmd.RunCommand(cmd, ctxt, nil)
+	testcmd.RunCommand(ui, cmd, ctxt, nil)
 
 	return
 }
@@ -43,4 +44,23 @@ var _ = Describe("stacks command", func() {
 			{"Stack-2", "Stack 2 Description"},
 		})
 	})
+
+	It("prints the stacks when a structured output format is used", func() {
+		stack := models.Stack{Guid: "stack-guid", Name: "Stack-1", Description: "Stack 1 Description"}
+		stackRepo := &testapi.FakeStackRepository{FindAllStacks: []models.Stack{stack}}
+
+		ui := &testterm.FakeUI{}
+		ui.SetOutputOptions(terminal.OutputOptions{Format: terminal.OutputJSON})
+		cmd := NewStacks(ui, testconfig.NewRepositoryWithDefaults(), stackRepo)
+		testcmd.RunCommand(ui, cmd, testcmd.NewContext("stacks", []string{}), nil)
+
+		testassert.SliceContains(ui.Outputs, testassert.Lines{
+			{`"guid": "stack-guid"`},
+			{`"name": "Stack-1"`},
+			{`"description": "Stack 1 Description"`},
+		})
+		testassert.SliceDoesNotContain(ui.Outputs, testassert.Lines{
+			{"name", "description"},
+		})
+	})
 })
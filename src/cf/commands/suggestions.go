@@ -0,0 +1,132 @@
+package commands
+
+import (
+	"cf"
+	"cf/errors"
+	"fmt"
+	"github.com/codegangsta/cli"
+	"sort"
+	"strings"
+)
+
+// commands of cf v5 that have a different name in this version
+var legacyCommandNames = map[string]string{
+	"crashes":      "events",
+	"crashlogs":    "logs",
+	"file":         "files",
+	"health":       "app",
+	"info":         "target",
+	"instances":    "app",
+	"map":          "map-route",
+	"map-domain":   "create-domain",
+	"register":     "create-user",
+	"stats":        "app",
+	"switch-space": "target",
+	"unmap":        "unmap-route",
+	"unmap-domain": "delete-domain",
+	"unregister":   "delete-user",
+	"user":         "target",
+}
+
+// names further than this from a known command are not suggested
+const maxSuggestionDistance = 2
+
+const maxSuggestions = 3
+
+// NewUnknownCommandError explains that cmdName is not a command, pointing to
+// the command that replaced it in this version or to the closest known ones
+func NewUnknownCommandError(cmdName string, knownNames []string) error {
+	if newName, found := legacyCommandNames[cmdName]; found {
+		return errors.NewUsageError("'%s' is a cf v5 command. Use '%s %s' instead.", cmdName, cf.Name(), newName)
+	}
+
+	message := fmt.Sprintf("'%s' is not a registered command. See '%s help'", cmdName, cf.Name())
+
+	suggestions := SuggestCommandNames(cmdName, knownNames)
+	if len(suggestions) > 0 {
+		message += "\n\nDid you mean?\n      " + strings.Join(suggestions, "\n      ")
+	}
+	return errors.NewUsageError("%s", message)
+}
+
+// SuggestCommandNames returns the known names closest to cmdName, closest first
+func SuggestCommandNames(cmdName string, knownNames []string) (suggestions []string) {
+	distances := map[string]int{}
+	for _, name := range knownNames {
+		if _, seen := distances[name]; seen || strings.HasPrefix(name, hiddenCommandPrefix) {
+			continue
+		}
+
+		distance := editDistance(cmdName, name)
+		if distance <= maxSuggestionDistance {
+			distances[name] = distance
+			suggestions = append(suggestions, name)
+		}
+	}
+
+	sort.Sort(suggestionsByDistance{suggestions, distances})
+	if len(suggestions) > maxSuggestions {
+		suggestions = suggestions[:maxSuggestions]
+	}
+	return
+}
+
+// the names and short names of the commands of app
+func appCommandNames(app *cli.App) (names []string) {
+	if app == nil {
+		return
+	}
+
+	for _, cliCommand := range app.Commands {
+		names = append(names, cliCommand.Name)
+		if cliCommand.ShortName != "" {
+			names = append(names, cliCommand.ShortName)
+		}
+	}
+	return
+}
+
+// editDistance is the Levenshtein distance between a and b
+func editDistance(a, b string) int {
+	previous := make([]int, len(b)+1)
+	current := make([]int, len(b)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		current[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			current[j] = minInt(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+	return previous[len(b)]
+}
+
+func minInt(first int, others ...int) int {
+	for _, other := range others {
+		if other < first {
+			first = other
+		}
+	}
+	return first
+}
+
+type suggestionsByDistance struct {
+	names     []string
+	distances map[string]int
+}
+
+func (s suggestionsByDistance) Len() int      { return len(s.names) }
+func (s suggestionsByDistance) Swap(i, j int) { s.names[i], s.names[j] = s.names[j], s.names[i] }
+func (s suggestionsByDistance) Less(i, j int) bool {
+	if s.distances[s.names[i]] != s.distances[s.names[j]] {
+		return s.distances[s.names[i]] < s.distances[s.names[j]]
+	}
+	return s.names[i] < s.names[j]
+}
@@ -0,0 +1,29 @@
+package commands_test
+
+import (
+	. "cf/commands"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("command suggestions", func() {
+	knownNames := []string{"apps", "a", "app", "create-service", "delete-service", "push", "__complete-names"}
+
+	It("suggests the closest names first", func() {
+		Expect(SuggestCommandNames("ap", knownNames)).To(Equal([]string{"a", "app", "apps"}))
+		Expect(SuggestCommandNames("create-servce", knownNames)).To(Equal([]string{"create-service"}))
+	})
+
+	It("suggests nothing when no name is close", func() {
+		Expect(SuggestCommandNames("marketplace", knownNames)).To(BeEmpty())
+	})
+
+	It("does not suggest hidden commands", func() {
+		Expect(SuggestCommandNames("__complete-name", knownNames)).To(BeEmpty())
+	})
+
+	It("points v5 commands to their replacements", func() {
+		err := NewUnknownCommandError("map", knownNames)
+		Expect(err.Error()).To(ContainSubstring("map-route' instead"))
+	})
+})
@@ -4,9 +4,9 @@ import (
 	"cf"
 	"cf/api"
 	"cf/configuration"
+	"cf/errors"
 	"cf/requirements"
 	"cf/terminal"
-	"errors"
 	"fmt"
 	"github.com/codegangsta/cli"
 )
@@ -44,7 +44,7 @@ func (cmd Target) GetRequirements(reqFactory requirements.Factory, c *cli.Contex
 	return
 }
 
-func (cmd Target) Run(c *cli.Context) {
+func (cmd Target) Run(c *cli.Context) (err error) {
 	orgName := c.String("o")
 	spaceName := c.String("s")
 	shouldShowTarget := (orgName == "" && spaceName == "")
@@ -55,21 +55,19 @@ func (cmd Target) Run(c *cli.Context) {
 	}
 
 	if orgName != "" {
-		err := cmd.setOrganization(orgName)
-
-		if spaceName == "" && cmd.config.IsLoggedIn() {
-			cmd.ui.ShowConfiguration(cmd.config)
+		err = cmd.setOrganization(orgName)
+		if err != nil {
 			return
 		}
 
-		if err != nil {
+		if spaceName == "" {
+			cmd.ui.ShowConfiguration(cmd.config)
 			return
 		}
 	}
 
 	if spaceName != "" {
-		err := cmd.setSpace(spaceName)
-
+		err = cmd.setSpace(spaceName)
 		if err != nil {
 			return
 		}
@@ -80,13 +78,13 @@ func (cmd Target) Run(c *cli.Context) {
 
 func (cmd Target) setOrganization(orgName string) (err error) {
 	if !cmd.config.IsLoggedIn() {
-		cmd.ui.Failed("You must be logged in to target an org. Use '%s'.", terminal.CommandColor(cf.Name()+" login"))
+		err = errors.NewAuthError("You must be logged in to target an org. Use '%s'.", terminal.CommandColor(cf.Name()+" login"))
 		return
 	}
 
 	org, apiResponse := cmd.orgRepo.FindByName(orgName)
 	if apiResponse.IsNotSuccessful() {
-		cmd.ui.Failed("Could not target org.\n%s", apiResponse.Message)
+		err = errors.FromApiResponseWithMessage(apiResponse, "Could not target org.\n%s", apiResponse.Message)
 		return
 	}
 
@@ -96,19 +94,19 @@ func (cmd Target) setOrganization(orgName string) (err error) {
 
 func (cmd Target) setSpace(spaceName string) (err error) {
 	if !cmd.config.IsLoggedIn() {
-		cmd.ui.Failed("You must be logged in to set a space. Use '%s'.", terminal.CommandColor(fmt.Sprintf("%s login", cf.Name())))
+		err = errors.NewAuthError("You must be logged in to set a space. Use '%s'.", terminal.CommandColor(fmt.Sprintf("%s login", cf.Name())))
 		return
 	}
 
 	if !cmd.config.HasOrganization() {
-		cmd.ui.Failed("An org must be targeted before targeting a space")
+		err = errors.New("An org must be targeted before targeting a space")
 		return
 	}
 
 	space, apiResponse := cmd.spaceRepo.FindByName(spaceName)
 
 	if apiResponse.IsNotSuccessful() {
-		cmd.ui.Failed("Unable to access space %s.\n%s", spaceName, apiResponse.Message)
+		err = errors.FromApiResponseWithMessage(apiResponse, "Unable to access space %s.\n%s", spaceName, apiResponse.Message)
 		return
 	}
 
@@ -216,6 +216,6 @@ func callTarget(args []string,
 	cmd := NewTarget(ui, config, orgRepo, spaceRepo)
 	ctxt := testcmd.NewContext("target", args)
 
-	testcmd.RunCommand(cmd, ctxt, reqFactory)
+	testcmd.RunCommand(ui, cmd, ctxt, reqFactory)
 	return
 }
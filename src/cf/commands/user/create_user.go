@@ -4,9 +4,9 @@ import (
 	"cf"
 	"cf/api"
 	"cf/configuration"
+	"cf/errors"
 	"cf/requirements"
 	"cf/terminal"
-	"errors"
 	"github.com/codegangsta/cli"
 )
 
@@ -34,7 +34,7 @@ func (cmd CreateUserFields) GetRequirements(reqFactory requirements.Factory, c *
 	return
 }
 
-func (cmd CreateUserFields) Run(c *cli.Context) {
+func (cmd CreateUserFields) Run(c *cli.Context) (err error) {
 	username := c.Args()[0]
 	password := c.Args()[1]
 
@@ -45,11 +45,12 @@ func (cmd CreateUserFields) Run(c *cli.Context) {
 
 	apiResponse := cmd.userRepo.Create(username, password)
 	if apiResponse.IsNotSuccessful() {
-		cmd.ui.Failed("Error creating user %s.\n%s", terminal.EntityNameColor(username), apiResponse.Message)
+		err = errors.FromApiResponseWithMessage(apiResponse, "Error creating user %s.\n%s", terminal.EntityNameColor(username), apiResponse.Message)
 		return
 	}
 
 	cmd.ui.Ok()
 
 	cmd.ui.Say("\nTIP: Assign roles with '%s set-org-role' and '%s set-space-role'", cf.Name(), cf.Name())
+	return
 }
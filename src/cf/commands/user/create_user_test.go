@@ -80,6 +80,6 @@ func callCreateUser(args []string, reqFactory *testreq.FakeReqFactory, userRepo
 	configRepo.SetAccessToken(accessToken)
 
 	cmd := NewCreateUser(ui, configRepo, userRepo)
-	testcmd.RunCommand(cmd, ctxt, reqFactory)
+	testcmd.RunCommand(ui, cmd, ctxt, reqFactory)
 	return
 }
@@ -3,9 +3,9 @@ package user
 import (
 	"cf/api"
 	"cf/configuration"
+	"cf/errors"
 	"cf/requirements"
 	"cf/terminal"
-	"errors"
 	"github.com/codegangsta/cli"
 )
 
@@ -34,10 +34,15 @@ func (cmd DeleteUserFields) GetRequirements(reqFactory requirements.Factory, c *
 	return
 }
 
-func (cmd DeleteUserFields) Run(c *cli.Context) {
+func (cmd DeleteUserFields) Run(c *cli.Context) (err error) {
 	username := c.Args()[0]
 	force := c.Bool("f")
 
+	if !force && !cmd.ui.IsInteractive() {
+		err = errors.NewMissingInputError("confirmation", "-f")
+		return
+	}
+
 	if !force && !cmd.ui.Confirm("Really delete user %s?%s",
 		terminal.EntityNameColor(username),
 		terminal.PromptColor(">"),
@@ -52,7 +57,7 @@ func (cmd DeleteUserFields) Run(c *cli.Context) {
 
 	user, apiResponse := cmd.userRepo.FindByUsername(username)
 	if apiResponse.IsError() {
-		cmd.ui.Failed(apiResponse.Message)
+		err = errors.FromApiResponse(apiResponse)
 		return
 	}
 	if apiResponse.IsNotFound() {
@@ -63,9 +68,10 @@ func (cmd DeleteUserFields) Run(c *cli.Context) {
 
 	apiResponse = cmd.userRepo.Delete(user.Guid)
 	if apiResponse.IsNotSuccessful() {
-		cmd.ui.Failed(apiResponse.Message)
+		err = errors.FromApiResponse(apiResponse)
 		return
 	}
 
 	cmd.ui.Ok()
+	return
 }
@@ -25,7 +25,7 @@ func callDeleteUser(args []string, userRepo *testapi.FakeUserRepository, reqFact
 
 	cmd := NewDeleteUser(ui, configRepo, userRepo)
 	ctxt := testcmd.NewContext("delete-user", args)
-	testcmd.RunCommand(cmd, ctxt, reqFactory)
+	testcmd.RunCommand(ui, cmd, ctxt, reqFactory)
 	return
 }
 
@@ -52,7 +52,7 @@ func deleteWithConfirmation(confirmation string) (ui *testterm.FakeUI, userRepo
 	ctxt := testcmd.NewContext("delete-user", []string{"my-user"})
 	reqFactory := &testreq.FakeReqFactory{LoginSuccess: true}
 
-	testcmd.RunCommand(cmd, ctxt, reqFactory)
+	testcmd.RunCommand(ui, cmd, ctxt, reqFactory)
 	return
 }
 
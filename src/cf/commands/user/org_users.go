@@ -3,10 +3,10 @@ package user
 import (
 	"cf/api"
 	"cf/configuration"
+	"cf/errors"
 	"cf/models"
 	"cf/requirements"
 	"cf/terminal"
-	"errors"
 	"github.com/codegangsta/cli"
 )
 
@@ -19,6 +19,13 @@ var orgRoleToDisplayName = map[string]string{
 	models.ORG_AUDITOR:     "ORG AUDITOR",
 }
 
+// userOutput is what structured output prints for each user, keyed by the
+// role names set-org-role takes
+type userOutput struct {
+	Guid     string `json:"guid"`
+	Username string `json:"username"`
+}
+
 type OrgUsers struct {
 	ui       terminal.UI
 	config   configuration.Reader
@@ -48,7 +55,7 @@ func (cmd *OrgUsers) GetRequirements(reqFactory requirements.Factory, c *cli.Con
 	return
 }
 
-func (cmd *OrgUsers) Run(c *cli.Context) {
+func (cmd *OrgUsers) Run(c *cli.Context) (err error) {
 	org := cmd.orgReq.GetOrganization()
 	all := c.Bool("a")
 
@@ -62,11 +69,27 @@ func (cmd *OrgUsers) Run(c *cli.Context) {
 		roles = []string{models.ORG_USER}
 	}
 
+	structured := cmd.ui.OutputOptions().IsStructured()
+	usersByRole := map[string][]userOutput{}
+
 	for _, role := range roles {
 		displayName := orgRoleToDisplayName[role]
 
 		users, apiResponse := cmd.userRepo.ListUsersInOrgForRole(org.Guid, role)
 
+		if structured {
+			if apiResponse.IsNotSuccessful() {
+				err = errors.FromApiResponseWithMessage(apiResponse, "Failed fetching org-users for role %s.\n%s", displayName, apiResponse.Message)
+				return
+			}
+
+			usersByRole[role] = []userOutput{}
+			for _, user := range users {
+				usersByRole[role] = append(usersByRole[role], userOutput{Guid: user.Guid, Username: user.Username})
+			}
+			continue
+		}
+
 		cmd.ui.Say("")
 		cmd.ui.Say("%s", terminal.HeaderColor(displayName))
 
@@ -75,8 +98,13 @@ func (cmd *OrgUsers) Run(c *cli.Context) {
 		}
 
 		if apiResponse.IsNotSuccessful() {
-			cmd.ui.Failed("Failed fetching org-users for role %s.\n%s", apiResponse.Message, displayName)
+			err = errors.FromApiResponseWithMessage(apiResponse, "Failed fetching org-users for role %s.\n%s", apiResponse.Message, displayName)
 			return
 		}
 	}
+
+	if structured {
+		err = cmd.ui.PrintValue(usersByRole)
+	}
+	return
 }
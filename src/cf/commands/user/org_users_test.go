@@ -21,7 +21,7 @@ func callOrgUsers(args []string, reqFactory *testreq.FakeReqFactory, userRepo *t
 	cmd := NewOrgUsers(ui, config, userRepo)
 	ctxt := testcmd.NewContext("org-users", args)
 
-	testcmd.RunCommand(cmd, ctxt, reqFactory)
+	testcmd.RunCommand(ui, cmd, ctxt, reqFactory)
 	return
 }
 
@@ -3,10 +3,10 @@ package user
 import (
 	"cf/api"
 	"cf/configuration"
+	"cf/errors"
 	"cf/models"
 	"cf/requirements"
 	"cf/terminal"
-	"errors"
 	"github.com/codegangsta/cli"
 )
 
@@ -45,7 +45,7 @@ func (cmd *SetOrgRole) GetRequirements(reqFactory requirements.Factory, c *cli.C
 	return
 }
 
-func (cmd *SetOrgRole) Run(c *cli.Context) {
+func (cmd *SetOrgRole) Run(c *cli.Context) (err error) {
 	user := cmd.userReq.GetUser()
 	org := cmd.orgReq.GetOrganization()
 	role := models.UserInputToOrgRole[c.Args()[2]]
@@ -59,9 +59,10 @@ func (cmd *SetOrgRole) Run(c *cli.Context) {
 
 	apiResponse := cmd.userRepo.SetOrgRole(user.Guid, org.Guid, role)
 	if apiResponse.IsNotSuccessful() {
-		cmd.ui.Failed(apiResponse.Message)
+		err = errors.FromApiResponse(apiResponse)
 		return
 	}
 
 	cmd.ui.Ok()
+	return
 }
@@ -20,7 +20,7 @@ func callSetOrgRole(args []string, reqFactory *testreq.FakeReqFactory, userRepo
 	config := testconfig.NewRepositoryWithDefaults()
 
 	cmd := NewSetOrgRole(ui, config, userRepo)
-	testcmd.RunCommand(cmd, ctxt, reqFactory)
+	testcmd.RunCommand(ui, cmd, ctxt, reqFactory)
 	return
 }
 
@@ -3,10 +3,10 @@ package user
 import (
 	"cf/api"
 	"cf/configuration"
+	"cf/errors"
 	"cf/models"
 	"cf/requirements"
 	"cf/terminal"
-	"errors"
 	"github.com/codegangsta/cli"
 )
 
@@ -50,7 +50,7 @@ func (cmd *SetSpaceRole) GetRequirements(reqFactory requirements.Factory, c *cli
 	return
 }
 
-func (cmd *SetSpaceRole) Run(c *cli.Context) {
+func (cmd *SetSpaceRole) Run(c *cli.Context) (err error) {
 	spaceName := c.Args()[2]
 	role := models.UserInputToSpaceRole[c.Args()[3]]
 	user := cmd.userReq.GetUser()
@@ -58,15 +58,12 @@ func (cmd *SetSpaceRole) Run(c *cli.Context) {
 
 	space, apiResponse := cmd.spaceRepo.FindByNameInOrg(spaceName, org.Guid)
 	if apiResponse.IsNotSuccessful() {
-		cmd.ui.Failed(apiResponse.Message)
+		err = errors.FromApiResponse(apiResponse)
 		return
 	}
 
-	err := cmd.SetSpaceRole(space, role, user.Guid, user.Username)
-	if err != nil {
-		cmd.ui.Failed(err.Error())
-		return
-	}
+	err = cmd.SetSpaceRole(space, role, user.Guid, user.Username)
+	return
 }
 
 func (cmd *SetSpaceRole) SetSpaceRole(space models.Space, role, userGuid, userName string) (err error) {
@@ -80,7 +77,7 @@ func (cmd *SetSpaceRole) SetSpaceRole(space models.Space, role, userGuid, userNa
 
 	apiResponse := cmd.userRepo.SetSpaceRole(userGuid, space.Guid, space.Organization.Guid, role)
 	if apiResponse.IsNotSuccessful() {
-		err = errors.New(apiResponse.Message)
+		err = errors.FromApiResponse(apiResponse)
 		return
 	}
 
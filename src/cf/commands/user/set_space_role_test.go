@@ -32,7 +32,7 @@ func callSetSpaceRole(args []string, reqFactory *testreq.FakeReqFactory, spaceRe
 	configRepo.SetAccessToken(accessToken)
 
 	cmd := NewSetSpaceRole(ui, configRepo, spaceRepo, userRepo)
-	testcmd.RunCommand(cmd, ctxt, reqFactory)
+	testcmd.RunCommand(ui, cmd, ctxt, reqFactory)
 	return
 }
 
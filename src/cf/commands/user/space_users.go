@@ -3,10 +3,10 @@ package user
 import (
 	"cf/api"
 	"cf/configuration"
+	"cf/errors"
 	"cf/models"
 	"cf/requirements"
 	"cf/terminal"
-	"errors"
 	"github.com/codegangsta/cli"
 )
 
@@ -49,13 +49,14 @@ func (cmd *SpaceUsers) GetRequirements(reqFactory requirements.Factory, c *cli.C
 	return
 }
 
-func (cmd *SpaceUsers) Run(c *cli.Context) {
+func (cmd *SpaceUsers) Run(c *cli.Context) (err error) {
 	spaceName := c.Args()[1]
 	org := cmd.orgReq.GetOrganization()
 
 	space, apiResponse := cmd.spaceRepo.FindByNameInOrg(spaceName, org.Guid)
 	if apiResponse.IsNotSuccessful() {
-		cmd.ui.Failed(apiResponse.Message)
+		err = errors.FromApiResponse(apiResponse)
+		return
 	}
 
 	cmd.ui.Say("Getting users in org %s / space %s as %s",
@@ -77,8 +78,9 @@ func (cmd *SpaceUsers) Run(c *cli.Context) {
 		}
 
 		if apiResponse.IsNotSuccessful() {
-			cmd.ui.Failed("Failed fetching space-users for role %s.\n%s", apiResponse.Message, displayName)
+			err = errors.FromApiResponseWithMessage(apiResponse, "Failed fetching space-users for role %s.\n%s", apiResponse.Message, displayName)
 			return
 		}
 	}
+	return
 }
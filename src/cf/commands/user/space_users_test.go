@@ -21,7 +21,7 @@ func callSpaceUsers(args []string, reqFactory *testreq.FakeReqFactory, spaceRepo
 	cmd := NewSpaceUsers(ui, config, spaceRepo, userRepo)
 	ctxt := testcmd.NewContext("space-users", args)
 
-	testcmd.RunCommand(cmd, ctxt, reqFactory)
+	testcmd.RunCommand(ui, cmd, ctxt, reqFactory)
 	return
 }
 
@@ -3,10 +3,10 @@ package user
 import (
 	"cf/api"
 	"cf/configuration"
+	"cf/errors"
 	"cf/models"
 	"cf/requirements"
 	"cf/terminal"
-	"errors"
 	"github.com/codegangsta/cli"
 )
 
@@ -46,7 +46,7 @@ func (cmd *UnsetOrgRole) GetRequirements(reqFactory requirements.Factory, c *cli
 	return
 }
 
-func (cmd *UnsetOrgRole) Run(c *cli.Context) {
+func (cmd *UnsetOrgRole) Run(c *cli.Context) (err error) {
 	role := models.UserInputToOrgRole[c.Args()[2]]
 	user := cmd.userReq.GetUser()
 	org := cmd.orgReq.GetOrganization()
@@ -61,9 +61,10 @@ func (cmd *UnsetOrgRole) Run(c *cli.Context) {
 	apiResponse := cmd.userRepo.UnsetOrgRole(user.Guid, org.Guid, role)
 
 	if apiResponse.IsNotSuccessful() {
-		cmd.ui.Failed(apiResponse.Message)
+		err = errors.FromApiResponse(apiResponse)
 		return
 	}
 
 	cmd.ui.Ok()
+	return
 }
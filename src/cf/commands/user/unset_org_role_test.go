@@ -83,6 +83,6 @@ func callUnsetOrgRole(args []string, userRepo *testapi.FakeUserRepository, reqFa
 	configRepo := testconfig.NewRepositoryWithDefaults()
 
 	cmd := NewUnsetOrgRole(ui, configRepo, userRepo)
-	testcmd.RunCommand(cmd, ctxt, reqFactory)
+	testcmd.RunCommand(ui, cmd, ctxt, reqFactory)
 	return
 }
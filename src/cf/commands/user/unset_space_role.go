@@ -3,10 +3,10 @@ package user
 import (
 	"cf/api"
 	"cf/configuration"
+	"cf/errors"
 	"cf/models"
 	"cf/requirements"
 	"cf/terminal"
-	"errors"
 	"github.com/codegangsta/cli"
 )
 
@@ -47,7 +47,7 @@ func (cmd *UnsetSpaceRole) GetRequirements(reqFactory requirements.Factory, c *c
 	return
 }
 
-func (cmd *UnsetSpaceRole) Run(c *cli.Context) {
+func (cmd *UnsetSpaceRole) Run(c *cli.Context) (err error) {
 	spaceName := c.Args()[2]
 	role := models.UserInputToSpaceRole[c.Args()[3]]
 
@@ -55,7 +55,7 @@ func (cmd *UnsetSpaceRole) Run(c *cli.Context) {
 	org := cmd.orgReq.GetOrganization()
 	space, apiResponse := cmd.spaceRepo.FindByNameInOrg(spaceName, org.Guid)
 	if apiResponse.IsNotSuccessful() {
-		cmd.ui.Failed(apiResponse.Message)
+		err = errors.FromApiResponse(apiResponse)
 		return
 	}
 
@@ -70,9 +70,10 @@ func (cmd *UnsetSpaceRole) Run(c *cli.Context) {
 	apiResponse = cmd.userRepo.UnsetSpaceRole(user.Guid, space.Guid, role)
 
 	if apiResponse.IsNotSuccessful() {
-		cmd.ui.Failed(apiResponse.Message)
+		err = errors.FromApiResponse(apiResponse)
 		return
 	}
 
 	cmd.ui.Ok()
+	return
 }
@@ -27,7 +27,7 @@ func callUnsetSpaceRole(args []string, spaceRepo *testapi.FakeSpaceRepository, u
 	config := testconfig.NewRepositoryWithDefaults()
 
 	cmd := NewUnsetSpaceRole(ui, config, spaceRepo, userRepo)
-	testcmd.RunCommand(cmd, ctxt, reqFactory)
+	testcmd.RunCommand(ui, cmd, ctxt, reqFactory)
 	return
 }
 
@@ -4,12 +4,22 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"strings"
+	"time"
 )
 
 type TokenInfo struct {
 	Username string `json:"user_name"`
 	Email    string `json:"email"`
 	UserGuid string `json:"user_id"`
+	Expiry   int64  `json:"exp,omitempty"`
+}
+
+// ExpiresAt returns the zero time when the token does not carry an expiry
+func (info TokenInfo) ExpiresAt() time.Time {
+	if info.Expiry == 0 {
+		return time.Time{}
+	}
+	return time.Unix(info.Expiry, 0)
 }
 
 func NewTokenInfo(accessToken string) (info TokenInfo) {
@@ -4,19 +4,33 @@ import (
 	"cf/models"
 )
 
-type Data struct {
-	ConfigVersion         int
+const DefaultProfileName = "default"
+
+type ProfileData struct {
 	Target                string
 	ApiVersion            string
 	AuthorizationEndpoint string
 	LoggregatorEndPoint   string
 	AccessToken           string
 	RefreshToken          string
+	ClientId              string
+	ClientSecret          string
 	OrganizationFields    models.OrganizationFields
 	SpaceFields           models.SpaceFields
 }
 
+// Data embeds the default profile, which is stored at the top level of the
+// config file so that files written before profiles existed keep working.
+type Data struct {
+	ConfigVersion int
+	ProfileData
+	CurrentProfile string
+	Profiles       map[string]*ProfileData
+	Aliases        map[string]string
+}
+
 func NewData() (data *Data) {
 	data = new(Data)
+	data.Profiles = map[string]*ProfileData{}
 	return
 }
@@ -17,8 +17,12 @@ type Persistor interface {
 	Delete()
 	Load() (*Data, error)
 	Save(*Data) error
+	Update(current *Data, mutate func(*Data)) (*Data, error)
 }
 
+// DiskPersistor serializes access to the config file across cf processes
+// with a lock file, and replaces the file atomically so that readers never
+// see a partially written config.
 type DiskPersistor struct {
 	filePath string
 }
@@ -32,6 +36,12 @@ func (dp DiskPersistor) Delete() {
 }
 
 func (dp DiskPersistor) Load() (data *Data, err error) {
+	unlock, err := dp.lock()
+	if err != nil {
+		return
+	}
+	defer unlock()
+
 	data, err = dp.read()
 	if err != nil {
 		err = dp.write(data)
@@ -40,17 +50,49 @@ func (dp DiskPersistor) Load() (data *Data, err error) {
 }
 
 func (dp DiskPersistor) Save(data *Data) (err error) {
+	unlock, err := dp.lock()
+	if err != nil {
+		return
+	}
+	defer unlock()
+
 	return dp.write(data)
 }
 
-func (dp DiskPersistor) read() (data *Data, err error) {
-	data = NewData()
+// Update re-reads the config file while holding the lock, applies mutate to
+// it and saves the result, so that changes written by other processes since
+// current was loaded (such as a refreshed token) are kept. If the file cannot
+// be read, current is saved as is.
+func (dp DiskPersistor) Update(current *Data, mutate func(*Data)) (data *Data, err error) {
+	unlock, err := dp.lock()
+	if err != nil {
+		return
+	}
+	defer unlock()
+
+	data, err = dp.read()
+	if err != nil {
+		data = current
+	} else {
+		mutate(data)
+	}
 
+	err = dp.write(data)
+	return
+}
+
+func (dp DiskPersistor) lock() (unlock func(), err error) {
 	err = os.MkdirAll(filepath.Dir(dp.filePath), dirPermissions)
 	if err != nil {
 		return
 	}
 
+	return lockFile(dp.filePath)
+}
+
+func (dp DiskPersistor) read() (data *Data, err error) {
+	data = NewData()
+
 	jsonBytes, err := ioutil.ReadFile(dp.filePath)
 	if err != nil {
 		return
@@ -66,9 +108,30 @@ func (dp DiskPersistor) write(data *Data) (err error) {
 		return
 	}
 
-	err = ioutil.WriteFile(dp.filePath, bytes, filePermissions)
+	tmpFile, err := ioutil.TempFile(filepath.Dir(dp.filePath), filepath.Base(dp.filePath)+".tmp")
+	if err != nil {
+		err = errors.New(fmt.Sprintf("Error writing to config file:%s\n%s", dp.filePath, err))
+		return
+	}
+
+	_, err = tmpFile.Write(bytes)
+	if err == nil {
+		err = tmpFile.Sync()
+	}
+	closeErr := tmpFile.Close()
+	if err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Chmod(tmpFile.Name(), filePermissions)
+	}
+	if err == nil {
+		err = os.Rename(tmpFile.Name(), dp.filePath)
+	}
+
 	if err != nil {
-		err = errors.New(fmt.Sprintf("Error writing to manifest file:%s\n%s", dp.filePath, err))
+		os.Remove(tmpFile.Name())
+		err = errors.New(fmt.Sprintf("Error writing to config file:%s\n%s", dp.filePath, err))
 		return
 	}
 	return
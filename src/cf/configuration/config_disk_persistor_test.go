@@ -5,6 +5,7 @@ import (
 	"fileutils"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	"io/ioutil"
 	"os"
 	"path/filepath"
 )
@@ -58,6 +59,50 @@ var _ = Describe("Testing with ginkgo", func() {
 		})
 	})
 
+	It("does not leave temporary files next to the config file after saving", func() {
+		withFakeHome(func(configPath string) {
+			repo := NewDiskPersistor(configPath)
+			configData, err := repo.Load()
+			Expect(err).NotTo(HaveOccurred())
+
+			configData.AccessToken = "bearer my_access_token"
+			err = repo.Save(configData)
+			Expect(err).NotTo(HaveOccurred())
+
+			files, err := ioutil.ReadDir(filepath.Dir(configPath))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(len(files)).To(Equal(1))
+			Expect(files[0].Name()).To(Equal("config.json"))
+		})
+	})
+
+	It("keeps changes saved by another process when updating", func() {
+		withFakeHome(func(configPath string) {
+			repo := NewDiskPersistor(configPath)
+			staleData, err := repo.Load()
+			Expect(err).NotTo(HaveOccurred())
+
+			otherRepo := NewDiskPersistor(configPath)
+			otherData, err := otherRepo.Load()
+			Expect(err).NotTo(HaveOccurred())
+			otherData.AccessToken = "bearer refreshed_token"
+			err = otherRepo.Save(otherData)
+			Expect(err).NotTo(HaveOccurred())
+
+			staleData.SpaceFields.Name = "my-space"
+			updatedData, err := repo.Update(staleData, func(data *Data) {
+				data.SpaceFields.Name = "my-space"
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(updatedData.AccessToken).To(Equal("bearer refreshed_token"))
+			Expect(updatedData.SpaceFields.Name).To(Equal("my-space"))
+
+			savedData, err := repo.Load()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(savedData).To(Equal(updatedData))
+		})
+	})
+
 	It("TestReadingOutdatedConfigReturnsNewConfig", func() {
 		withConfigFixture("outdated-config", func(configPath string) {
 			repo := NewDiskPersistor(configPath)
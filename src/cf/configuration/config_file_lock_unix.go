@@ -0,0 +1,30 @@
+// +build darwin freebsd linux netbsd openbsd
+
+package configuration
+
+import (
+	"os"
+	"path/filepath"
+	"syscall"
+)
+
+// the config file itself is replaced on every write, so the directory holding
+// it is locked instead; the kernel releases the lock if the process dies
+func lockFile(path string) (unlock func(), err error) {
+	dir, err := os.Open(filepath.Dir(path))
+	if err != nil {
+		return
+	}
+
+	err = syscall.Flock(int(dir.Fd()), syscall.LOCK_EX)
+	if err != nil {
+		dir.Close()
+		return
+	}
+
+	unlock = func() {
+		syscall.Flock(int(dir.Fd()), syscall.LOCK_UN)
+		dir.Close()
+	}
+	return
+}
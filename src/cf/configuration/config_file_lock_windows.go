@@ -0,0 +1,54 @@
+// +build windows
+
+package configuration
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"time"
+)
+
+const (
+	lockTimeout       = 10 * time.Second
+	lockRetryInterval = 50 * time.Millisecond
+	staleLockAge      = 30 * time.Second
+)
+
+// windows has no flock, so the lock is held by creating the lock file
+// exclusively; locks left behind by a crashed process expire after a while
+func lockFile(path string) (unlock func(), err error) {
+	path = path + ".lock"
+	deadline := time.Now().Add(lockTimeout)
+
+	for {
+		var file *os.File
+		file, err = os.OpenFile(path, os.O_RDWR|os.O_CREATE|os.O_EXCL, filePermissions)
+		if err == nil {
+			file.Close()
+			break
+		}
+
+		if !os.IsExist(err) {
+			return
+		}
+
+		info, statErr := os.Stat(path)
+		if statErr == nil && time.Since(info.ModTime()) > staleLockAge {
+			os.Remove(path)
+			continue
+		}
+
+		if time.Now().After(deadline) {
+			err = errors.New(fmt.Sprintf("Timed out waiting for config file lock %s", path))
+			return
+		}
+
+		time.Sleep(lockRetryInterval)
+	}
+
+	unlock = func() {
+		os.Remove(path)
+	}
+	return
+}
@@ -19,6 +19,33 @@ func DefaultFilePath() string {
 	return filepath.Join(configDir, "config.json")
 }
 
+// CompletionCachePath is where the names offered by shell completion are
+// cached, next to the config file
+func CompletionCachePath() string {
+	return filepath.Join(filepath.Dir(DefaultFilePath()), "completion_cache.json")
+}
+
+// ShellHistoryPath is where cf shell keeps the lines entered at its prompt
+func ShellHistoryPath() string {
+	return filepath.Join(filepath.Dir(DefaultFilePath()), "shell_history")
+}
+
+// PluginsDir is searched for cf-NAME plugin executables before PATH
+func PluginsDir() string {
+	if os.Getenv("CF_HOME") != "" {
+		return filepath.Join(os.Getenv("CF_HOME"), "plugins")
+	}
+	return filepath.Join(filepath.Dir(DefaultFilePath()), "plugins")
+}
+
+func DefaultProjectPin() (pin *ProjectPin, err error) {
+	dir, err := os.Getwd()
+	if err != nil {
+		return
+	}
+	return FindProjectPin(dir)
+}
+
 // See: http://stackoverflow.com/questions/7922270/obtain-users-home-directory
 // we can't cross compile using cgo and use user.Current()
 func userHomeDir() string {
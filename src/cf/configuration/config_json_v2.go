@@ -13,8 +13,13 @@ type configJsonV2 struct {
 	LoggregatorEndpoint   string
 	AccessToken           string
 	RefreshToken          string
+	ClientId              string `json:",omitempty"`
+	ClientSecret          string `json:",omitempty"`
 	OrganizationFields    models.OrganizationFields
 	SpaceFields           models.SpaceFields
+	CurrentProfile        string                  `json:",omitempty"`
+	Profiles              map[string]*ProfileData `json:",omitempty"`
+	Aliases               map[string]string       `json:",omitempty"`
 }
 
 func JsonMarshalV2(config *Data) (output []byte, err error) {
@@ -26,8 +31,13 @@ func JsonMarshalV2(config *Data) (output []byte, err error) {
 		LoggregatorEndpoint:   config.LoggregatorEndPoint,
 		AccessToken:           config.AccessToken,
 		RefreshToken:          config.RefreshToken,
+		ClientId:              config.ClientId,
+		ClientSecret:          config.ClientSecret,
 		OrganizationFields:    config.OrganizationFields,
 		SpaceFields:           config.SpaceFields,
+		CurrentProfile:        config.CurrentProfile,
+		Profiles:              config.Profiles,
+		Aliases:               config.Aliases,
 	})
 }
 
@@ -47,10 +57,21 @@ func JsonUnmarshalV2(input []byte, config *Data) (err error) {
 	config.ApiVersion = configJson.ApiVersion
 	config.AccessToken = configJson.AccessToken
 	config.RefreshToken = configJson.RefreshToken
+	config.ClientId = configJson.ClientId
+	config.ClientSecret = configJson.ClientSecret
 	config.SpaceFields = configJson.SpaceFields
 	config.OrganizationFields = configJson.OrganizationFields
 	config.LoggregatorEndPoint = configJson.LoggregatorEndpoint
 	config.AuthorizationEndpoint = configJson.AuthorizationEndpoint
+	config.CurrentProfile = configJson.CurrentProfile
+	config.Aliases = configJson.Aliases
+
+	config.Profiles = map[string]*ProfileData{}
+	for name, profile := range configJson.Profiles {
+		if profile != nil {
+			config.Profiles[name] = profile
+		}
+	}
 
 	return
 }
@@ -38,15 +38,56 @@ var _ = Describe("Parsing V2 config files", func() {
 
 			Expect(err).NotTo(HaveOccurred())
 			Expect(configData).To(Equal(&Data{
-				Target:                "api.example.com",
-				ApiVersion:            "2",
-				AuthorizationEndpoint: "auth.example.com",
-				LoggregatorEndPoint:   "logs.example.com",
-				AccessToken:           "the-access-token",
-				RefreshToken:          "the-refresh-token",
-				OrganizationFields:    models.OrganizationFields{Name: "the-org"},
-				SpaceFields:           models.SpaceFields{Name: "the-space"},
+				ProfileData: ProfileData{
+					Target:                "api.example.com",
+					ApiVersion:            "2",
+					AuthorizationEndpoint: "auth.example.com",
+					LoggregatorEndPoint:   "logs.example.com",
+					AccessToken:           "the-access-token",
+					RefreshToken:          "the-refresh-token",
+					OrganizationFields:    models.OrganizationFields{Name: "the-org"},
+					SpaceFields:           models.SpaceFields{Name: "the-space"},
+				},
+				Profiles: map[string]*ProfileData{},
 			}))
 		})
 	})
+
+	Describe("when the file has named profiles", func() {
+		var json = []byte(`
+			{
+				"ConfigVersion": 2,
+				"Target": "api.example.com",
+				"CurrentProfile": "prod",
+				"Profiles": {
+					"prod": {
+						"Target": "api.prod.example.com",
+						"AccessToken": "the-prod-access-token",
+						"SpaceFields": {
+							"Name": "the-prod-space"
+						}
+					}
+				}
+			}`)
+
+		It("round-trips the profiles", func() {
+			configData := NewData()
+			err := JsonUnmarshalV2(json, configData)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(configData.Target).To(Equal("api.example.com"))
+			Expect(configData.CurrentProfile).To(Equal("prod"))
+			Expect(configData.Profiles["prod"].Target).To(Equal("api.prod.example.com"))
+			Expect(configData.Profiles["prod"].AccessToken).To(Equal("the-prod-access-token"))
+			Expect(configData.Profiles["prod"].SpaceFields.Name).To(Equal("the-prod-space"))
+
+			output, err := JsonMarshalV2(configData)
+			Expect(err).NotTo(HaveOccurred())
+
+			reloadedData := NewData()
+			err = JsonUnmarshalV2(output, reloadedData)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(reloadedData).To(Equal(configData))
+		})
+	})
 })
@@ -2,15 +2,21 @@ package configuration
 
 import (
 	"cf/models"
+	"errors"
+	"fmt"
+	"os"
 	"sync"
 )
 
+const CF_PROFILE = "CF_PROFILE"
+
 type configRepository struct {
-	data      *Data
-	mutex     *sync.RWMutex
-	initOnce  *sync.Once
-	persistor Persistor
-	onError   func(error)
+	data        *Data
+	profileName string
+	mutex       *sync.RWMutex
+	initOnce    *sync.Once
+	persistor   Persistor
+	onError     func(error)
 }
 
 func NewRepositoryFromFilepath(filepath string, errorHandler func(error)) Repository {
@@ -33,6 +39,8 @@ type Reader interface {
 	LoggregatorEndpoint() string
 	AccessToken() string
 	RefreshToken() string
+	ClientId() string
+	ClientSecret() string
 	OrganizationFields() models.OrganizationFields
 	SpaceFields() models.SpaceFields
 
@@ -42,6 +50,11 @@ type Reader interface {
 	Username() string
 	UserGuid() string
 	UserEmail() string
+
+	ProfileName() string
+	Profiles() map[string]ProfileData
+
+	Aliases() map[string]string
 }
 
 type ReadWriter interface {
@@ -53,8 +66,14 @@ type ReadWriter interface {
 	SetLoggregatorEndpoint(string)
 	SetAccessToken(string)
 	SetRefreshToken(string)
+	SetClientCredentials(clientId, clientSecret string)
 	SetOrganizationFields(models.OrganizationFields)
 	SetSpaceFields(models.SpaceFields)
+	SetCurrentProfile(string)
+	CreateProfile(string)
+	DeleteProfile(string)
+	SetAlias(name, expansion string)
+	DeleteAlias(name string)
 }
 
 type Repository interface {
@@ -71,9 +90,51 @@ func (c *configRepository) init() {
 		if err != nil {
 			c.onError(err)
 		}
+
+		// CF_PROFILE lets a single invocation use another profile without
+		// changing the one other terminals are using
+		c.profileName = c.data.CurrentProfile
+		if envProfile := os.Getenv(CF_PROFILE); envProfile != "" {
+			c.profileName = envProfile
+			if !c.hasProfile(envProfile) {
+				c.onError(errors.New(fmt.Sprintf("Profile %s from %s does not exist", envProfile, CF_PROFILE)))
+			}
+		}
+
+		// the current profile may have been deleted by another cf process
+		if !c.hasProfile(c.profileName) {
+			c.profileName = ""
+		}
 	})
 }
 
+func (c *configRepository) hasProfile(name string) bool {
+	return name == "" || name == DefaultProfileName || c.data.Profiles[name] != nil
+}
+
+func (c *configRepository) ensureProfile(name string) {
+	if c.hasProfile(name) {
+		return
+	}
+
+	if c.data.Profiles == nil {
+		c.data.Profiles = map[string]*ProfileData{}
+	}
+	c.data.Profiles[name] = new(ProfileData)
+}
+
+// profile returns the data of the current profile. When another cf process
+// deleted it, changes go to a throwaway copy instead of bringing it back.
+func (c *configRepository) profile() *ProfileData {
+	if c.profileName == "" || c.profileName == DefaultProfileName {
+		return &c.data.ProfileData
+	}
+	if profile := c.data.Profiles[c.profileName]; profile != nil {
+		return profile
+	}
+	return new(ProfileData)
+}
+
 func (c *configRepository) read(cb func()) {
 	c.mutex.RLock()
 	defer c.mutex.RUnlock()
@@ -89,10 +150,21 @@ func (c *configRepository) write(cb func()) {
 
 	cb()
 
-	err := c.persistor.Save(c.data)
+	// another cf process may have changed the file since it was loaded (e.g.
+	// by refreshing the token), so the change is replayed onto the latest copy.
+	// Only CreateProfile creates profiles, so changes to a profile deleted
+	// meanwhile are dropped.
+	current := c.data
+	latest, err := c.persistor.Update(current, func(data *Data) {
+		c.data = data
+		cb()
+	})
 	if err != nil {
+		c.data = current
 		c.onError(err)
+		return
 	}
+	c.data = latest
 }
 
 // CLOSERS
@@ -107,98 +179,143 @@ func (c *configRepository) Close() {
 
 func (c *configRepository) ApiVersion() (apiVersion string) {
 	c.read(func() {
-		apiVersion = c.data.ApiVersion
+		apiVersion = c.profile().ApiVersion
 	})
 	return
 }
 
 func (c *configRepository) AuthorizationEndpoint() (authEndpoint string) {
 	c.read(func() {
-		authEndpoint = c.data.AuthorizationEndpoint
+		authEndpoint = c.profile().AuthorizationEndpoint
 	})
 	return
 }
 
 func (c *configRepository) LoggregatorEndpoint() (logEndpoint string) {
 	c.read(func() {
-		logEndpoint = c.data.LoggregatorEndPoint
+		logEndpoint = c.profile().LoggregatorEndPoint
 	})
 	return
 }
 
 func (c *configRepository) ApiEndpoint() (apiEndpoint string) {
 	c.read(func() {
-		apiEndpoint = c.data.Target
+		apiEndpoint = c.profile().Target
 	})
 	return
 }
 
 func (c *configRepository) AccessToken() (accessToken string) {
 	c.read(func() {
-		accessToken = c.data.AccessToken
+		accessToken = c.profile().AccessToken
 	})
 	return
 }
 
 func (c *configRepository) RefreshToken() (refreshToken string) {
 	c.read(func() {
-		refreshToken = c.data.RefreshToken
+		refreshToken = c.profile().RefreshToken
+	})
+	return
+}
+
+func (c *configRepository) ClientId() (clientId string) {
+	c.read(func() {
+		clientId = c.profile().ClientId
+	})
+	return
+}
+
+func (c *configRepository) ClientSecret() (clientSecret string) {
+	c.read(func() {
+		clientSecret = c.profile().ClientSecret
 	})
 	return
 }
 
 func (c *configRepository) OrganizationFields() (org models.OrganizationFields) {
 	c.read(func() {
-		org = c.data.OrganizationFields
+		org = c.profile().OrganizationFields
 	})
 	return
 }
 
 func (c *configRepository) SpaceFields() (space models.SpaceFields) {
 	c.read(func() {
-		space = c.data.SpaceFields
+		space = c.profile().SpaceFields
 	})
 	return
 }
 
 func (c *configRepository) UserEmail() (email string) {
 	c.read(func() {
-		email = NewTokenInfo(c.data.AccessToken).Email
+		email = NewTokenInfo(c.profile().AccessToken).Email
 	})
 	return
 }
 
 func (c *configRepository) UserGuid() (guid string) {
 	c.read(func() {
-		guid = NewTokenInfo(c.data.AccessToken).UserGuid
+		guid = NewTokenInfo(c.profile().AccessToken).UserGuid
 	})
 	return
 }
 
 func (c *configRepository) Username() (name string) {
 	c.read(func() {
-		name = NewTokenInfo(c.data.AccessToken).Username
+		name = NewTokenInfo(c.profile().AccessToken).Username
 	})
 	return
 }
 
 func (c *configRepository) IsLoggedIn() (loggedIn bool) {
 	c.read(func() {
-		loggedIn = c.data.AccessToken != ""
+		loggedIn = c.profile().AccessToken != ""
 	})
 	return
 }
 
 func (c *configRepository) HasOrganization() (hasOrg bool) {
 	c.read(func() {
-		hasOrg = c.data.OrganizationFields.Guid != "" && c.data.OrganizationFields.Name != ""
+		hasOrg = c.profile().OrganizationFields.Guid != "" && c.profile().OrganizationFields.Name != ""
 	})
 	return
 }
 
 func (c *configRepository) HasSpace() (hasSpace bool) {
 	c.read(func() {
-		hasSpace = c.data.SpaceFields.Guid != "" && c.data.SpaceFields.Name != ""
+		hasSpace = c.profile().SpaceFields.Guid != "" && c.profile().SpaceFields.Name != ""
+	})
+	return
+}
+
+func (c *configRepository) ProfileName() (name string) {
+	c.read(func() {
+		name = c.profileName
+		if name == "" {
+			name = DefaultProfileName
+		}
+	})
+	return
+}
+
+func (c *configRepository) Profiles() (profiles map[string]ProfileData) {
+	c.read(func() {
+		profiles = map[string]ProfileData{DefaultProfileName: c.data.ProfileData}
+		for name, profile := range c.data.Profiles {
+			profiles[name] = *profile
+		}
+	})
+	return
+}
+
+// Aliases are shared by all profiles
+func (c *configRepository) Aliases() (aliases map[string]string) {
+	c.read(func() {
+		aliases = map[string]string{}
+		for name, expansion := range c.data.Aliases {
+			aliases[name] = expansion
+		}
 	})
 	return
 }
@@ -207,57 +324,121 @@ func (c *configRepository) HasSpace() (hasSpace bool) {
 
 func (c *configRepository) ClearSession() {
 	c.write(func() {
-		c.data.AccessToken = ""
-		c.data.RefreshToken = ""
-		c.data.OrganizationFields = models.OrganizationFields{}
-		c.data.SpaceFields = models.SpaceFields{}
+		c.profile().AccessToken = ""
+		c.profile().RefreshToken = ""
+		c.profile().ClientId = ""
+		c.profile().ClientSecret = ""
+		c.profile().OrganizationFields = models.OrganizationFields{}
+		c.profile().SpaceFields = models.SpaceFields{}
 	})
 }
 
 func (c *configRepository) SetApiEndpoint(endpoint string) {
 	c.write(func() {
-		c.data.Target = endpoint
+		c.profile().Target = endpoint
 	})
 }
 
 func (c *configRepository) SetApiVersion(version string) {
 	c.write(func() {
-		c.data.ApiVersion = version
+		c.profile().ApiVersion = version
 	})
 }
 
 func (c *configRepository) SetAuthorizationEndpoint(endpoint string) {
 	c.write(func() {
-		c.data.AuthorizationEndpoint = endpoint
+		c.profile().AuthorizationEndpoint = endpoint
 	})
 }
 
 func (c *configRepository) SetLoggregatorEndpoint(endpoint string) {
 	c.write(func() {
-		c.data.LoggregatorEndPoint = endpoint
+		c.profile().LoggregatorEndPoint = endpoint
 	})
 }
 
 func (c *configRepository) SetAccessToken(token string) {
 	c.write(func() {
-		c.data.AccessToken = token
+		c.profile().AccessToken = token
 	})
 }
 
 func (c *configRepository) SetRefreshToken(token string) {
 	c.write(func() {
-		c.data.RefreshToken = token
+		c.profile().RefreshToken = token
+	})
+}
+
+func (c *configRepository) SetClientCredentials(clientId, clientSecret string) {
+	c.write(func() {
+		c.profile().ClientId = clientId
+		c.profile().ClientSecret = clientSecret
 	})
 }
 
 func (c *configRepository) SetOrganizationFields(org models.OrganizationFields) {
 	c.write(func() {
-		c.data.OrganizationFields = org
+		c.profile().OrganizationFields = org
 	})
 }
 
 func (c *configRepository) SetSpaceFields(space models.SpaceFields) {
 	c.write(func() {
-		c.data.SpaceFields = space
+		c.profile().SpaceFields = space
+	})
+}
+
+// SetCurrentProfile switches to a profile, which must have been created
+// before
+func (c *configRepository) SetCurrentProfile(name string) {
+	c.write(func() {
+		if name == DefaultProfileName {
+			name = ""
+		}
+		if !c.hasProfile(name) {
+			return
+		}
+
+		c.data.CurrentProfile = name
+		c.profileName = name
+	})
+}
+
+func (c *configRepository) CreateProfile(name string) {
+	c.write(func() {
+		c.ensureProfile(name)
+	})
+}
+
+// DeleteProfile removes a profile, switching back to the default profile
+// when it was the current one. The default profile cannot be deleted.
+func (c *configRepository) DeleteProfile(name string) {
+	c.write(func() {
+		if name == "" || name == DefaultProfileName {
+			return
+		}
+
+		delete(c.data.Profiles, name)
+		if c.data.CurrentProfile == name {
+			c.data.CurrentProfile = ""
+		}
+		if c.profileName == name {
+			c.profileName = ""
+		}
+	})
+}
+
+func (c *configRepository) SetAlias(name, expansion string) {
+	c.write(func() {
+		if c.data.Aliases == nil {
+			c.data.Aliases = map[string]string{}
+		}
+		c.data.Aliases[name] = expansion
+	})
+}
+
+func (c *configRepository) DeleteAlias(name string) {
+	c.write(func() {
+		delete(c.data.Aliases, name)
 	})
 }
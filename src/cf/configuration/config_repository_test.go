@@ -5,6 +5,7 @@ import (
 	"fmt"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	"os"
 	testconfig "testhelpers/configuration"
 	"testhelpers/maker"
 	"time"
@@ -46,6 +47,25 @@ var _ = Describe("ConfigurationRepository", func() {
 		time.Sleep(10 * time.Millisecond)
 	})
 
+	It("keeps values written by other processes sharing the config file", func() {
+		withFakeHome(func(configPath string) {
+			onError := func(err error) { Fail(err.Error()) }
+			config := NewRepositoryFromFilepath(configPath, onError)
+			otherConfig := NewRepositoryFromFilepath(configPath, onError)
+
+			config.SetApiEndpoint("http://api.the-endpoint")
+			otherConfig.SetAccessToken("the-refreshed-token")
+			config.SetRefreshToken("the-refresh-token")
+
+			Expect(config.AccessToken()).To(Equal("the-refreshed-token"))
+
+			reloadedConfig := NewRepositoryFromFilepath(configPath, onError)
+			Expect(reloadedConfig.ApiEndpoint()).To(Equal("http://api.the-endpoint"))
+			Expect(reloadedConfig.AccessToken()).To(Equal("the-refreshed-token"))
+			Expect(reloadedConfig.RefreshToken()).To(Equal("the-refresh-token"))
+		})
+	})
+
 	// TODO - test ClearTokens et al
 	It("has acccessor methods for all config fields", func() {
 		config.SetApiEndpoint("http://api.the-endpoint")
@@ -90,4 +110,131 @@ var _ = Describe("ConfigurationRepository", func() {
 		Expect(config.UserGuid()).To(BeEmpty())
 		Expect(config.UserEmail()).To(BeEmpty())
 	})
+
+	Describe("profiles", func() {
+		AfterEach(func() {
+			os.Setenv(CF_PROFILE, "")
+		})
+
+		It("uses the default profile when none is selected", func() {
+			Expect(config.ProfileName()).To(Equal(DefaultProfileName))
+		})
+
+		It("keeps the target of each profile separate", func() {
+			config.SetApiEndpoint("https://api.dev.example.com")
+			config.SetAccessToken("dev-token")
+
+			config.CreateProfile("prod")
+			Expect(config.ProfileName()).To(Equal(DefaultProfileName))
+
+			config.SetCurrentProfile("prod")
+			Expect(config.ProfileName()).To(Equal("prod"))
+			Expect(config.ApiEndpoint()).To(Equal(""))
+			Expect(config.AccessToken()).To(Equal(""))
+
+			config.SetApiEndpoint("https://api.prod.example.com")
+			Expect(config.ApiEndpoint()).To(Equal("https://api.prod.example.com"))
+
+			config.SetCurrentProfile(DefaultProfileName)
+			Expect(config.ApiEndpoint()).To(Equal("https://api.dev.example.com"))
+			Expect(config.AccessToken()).To(Equal("dev-token"))
+
+			profiles := config.Profiles()
+			Expect(len(profiles)).To(Equal(2))
+			Expect(profiles[DefaultProfileName].Target).To(Equal("https://api.dev.example.com"))
+			Expect(profiles["prod"].Target).To(Equal("https://api.prod.example.com"))
+		})
+
+		It("lets CF_PROFILE override the current profile", func() {
+			data := NewData()
+			data.Target = "https://api.dev.example.com"
+			data.CurrentProfile = "staging"
+			data.Profiles["staging"] = &ProfileData{Target: "https://api.staging.example.com"}
+			data.Profiles["prod"] = &ProfileData{Target: "https://api.prod.example.com"}
+			repo.LoadReturns.Data = data
+
+			os.Setenv(CF_PROFILE, "prod")
+			config = NewRepositoryFromPersistor(repo, func(err error) { panic(err) })
+
+			Expect(config.ProfileName()).To(Equal("prod"))
+			Expect(config.ApiEndpoint()).To(Equal("https://api.prod.example.com"))
+
+			config.SetSpaceFields(maker.NewSpaceFields(maker.Overrides{"name": "prod-space"}))
+			Expect(repo.SaveArgs.Data.CurrentProfile).To(Equal("staging"))
+			Expect(repo.SaveArgs.Data.Profiles["prod"].SpaceFields.Name).To(Equal("prod-space"))
+			Expect(repo.SaveArgs.Data.Profiles["staging"].SpaceFields.Name).To(Equal(""))
+		})
+
+		It("reports a CF_PROFILE naming a profile that does not exist", func() {
+			os.Setenv(CF_PROFILE, "prdo")
+
+			var reported error
+			config = NewRepositoryFromPersistor(repo, func(err error) { reported = err })
+
+			Expect(config.ProfileName()).To(Equal(DefaultProfileName))
+			Expect(reported).To(HaveOccurred())
+			Expect(reported.Error()).To(ContainSubstring("Profile prdo from CF_PROFILE does not exist"))
+		})
+
+		It("does not create profiles when switching to one that does not exist", func() {
+			config.SetCurrentProfile("prdo")
+
+			Expect(config.ProfileName()).To(Equal(DefaultProfileName))
+			Expect(len(config.Profiles())).To(Equal(1))
+		})
+
+		It("deletes profiles, switching back to the default one when deleting the current profile", func() {
+			config.CreateProfile("prod")
+			config.CreateProfile("staging")
+			config.SetCurrentProfile("prod")
+
+			config.DeleteProfile("staging")
+			Expect(config.ProfileName()).To(Equal("prod"))
+
+			config.DeleteProfile("prod")
+			Expect(config.ProfileName()).To(Equal(DefaultProfileName))
+			Expect(len(config.Profiles())).To(Equal(1))
+
+			config.DeleteProfile(DefaultProfileName)
+			Expect(len(config.Profiles())).To(Equal(1))
+		})
+		It("does not bring back a profile deleted by another process", func() {
+			withFakeHome(func(configPath string) {
+				onError := func(err error) { Fail(err.Error()) }
+				config := NewRepositoryFromFilepath(configPath, onError)
+				config.CreateProfile("prod")
+				config.SetCurrentProfile("prod")
+
+				otherConfig := NewRepositoryFromFilepath(configPath, onError)
+				otherConfig.DeleteProfile("prod")
+
+				config.SetAccessToken("the-prod-token")
+				Expect(config.AccessToken()).To(BeEmpty())
+
+				reloadedConfig := NewRepositoryFromFilepath(configPath, onError)
+				Expect(reloadedConfig.ProfileName()).To(Equal(DefaultProfileName))
+				Expect(len(reloadedConfig.Profiles())).To(Equal(1))
+				Expect(reloadedConfig.AccessToken()).To(BeEmpty())
+			})
+		})
+	})
+
+	Describe("aliases", func() {
+		It("stores aliases for all profiles", func() {
+			config.SetAlias("lp", "logs --recent")
+			config.SetAlias("prod-push", "push -f manifest-prod.yml")
+
+			config.CreateProfile("prod")
+			config.SetCurrentProfile("prod")
+			Expect(config.Aliases()).To(Equal(map[string]string{
+				"lp":        "logs --recent",
+				"prod-push": "push -f manifest-prod.yml",
+			}))
+
+			config.DeleteAlias("lp")
+			Expect(config.Aliases()).To(Equal(map[string]string{
+				"prod-push": "push -f manifest-prod.yml",
+			}))
+		})
+	})
 })
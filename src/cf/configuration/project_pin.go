@@ -0,0 +1,99 @@
+package configuration
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+const (
+	ProjectPinMismatchFail = "fail"
+	ProjectPinMismatchWarn = "warn"
+)
+
+// ProjectPin is read from a .cf/config file in the working directory or one
+// of its parents, and pins the target that commands in that project expect.
+type ProjectPin struct {
+	Path        string `json:"-"`
+	ApiEndpoint string `json:"api"`
+	Org         string `json:"org"`
+	Space       string `json:"space"`
+	OnMismatch  string `json:"on_mismatch"`
+}
+
+func ProjectPinFilePath(dir string) string {
+	return filepath.Join(dir, ".cf", "config")
+}
+
+// FindProjectPin returns nil when no pin file exists in dir or above it
+func FindProjectPin(dir string) (pin *ProjectPin, err error) {
+	dir, err = filepath.Abs(dir)
+	if err != nil {
+		return
+	}
+
+	for {
+		path := ProjectPinFilePath(dir)
+		if _, statErr := os.Stat(path); statErr == nil {
+			return loadProjectPin(path)
+		}
+
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return
+		}
+		dir = parent
+	}
+}
+
+func loadProjectPin(path string) (pin *ProjectPin, err error) {
+	bytes, err := ioutil.ReadFile(path)
+	if err != nil {
+		return
+	}
+
+	pin = &ProjectPin{Path: path}
+	err = json.Unmarshal(bytes, pin)
+	if err != nil {
+		pin = nil
+		err = fmt.Errorf("Invalid project config file %s\n%s", path, err)
+		return
+	}
+
+	switch pin.OnMismatch {
+	case "":
+		pin.OnMismatch = ProjectPinMismatchFail
+	case ProjectPinMismatchFail, ProjectPinMismatchWarn:
+	default:
+		err = fmt.Errorf("Invalid project config file %s\non_mismatch must be '%s' or '%s'", path, ProjectPinMismatchFail, ProjectPinMismatchWarn)
+		pin = nil
+	}
+	return
+}
+
+func (pin ProjectPin) WarnOnly() bool {
+	return pin.OnMismatch == ProjectPinMismatchWarn
+}
+
+// Mismatches describes every pinned value that differs from the current target
+func (pin ProjectPin) Mismatches(config Reader) (mismatches []string) {
+	if pin.ApiEndpoint != "" && !sameEndpoint(pin.ApiEndpoint, config.ApiEndpoint()) {
+		mismatches = append(mismatches, fmt.Sprintf("API endpoint is %s, project expects %s", config.ApiEndpoint(), pin.ApiEndpoint))
+	}
+
+	if pin.Org != "" && !strings.EqualFold(pin.Org, config.OrganizationFields().Name) {
+		mismatches = append(mismatches, fmt.Sprintf("org is %s, project expects %s", config.OrganizationFields().Name, pin.Org))
+	}
+
+	if pin.Space != "" && !strings.EqualFold(pin.Space, config.SpaceFields().Name) {
+		mismatches = append(mismatches, fmt.Sprintf("space is %s, project expects %s", config.SpaceFields().Name, pin.Space))
+	}
+	return
+}
+
+func sameEndpoint(a, b string) bool {
+	return strings.EqualFold(strings.TrimSuffix(a, "/"), strings.TrimSuffix(b, "/"))
+}
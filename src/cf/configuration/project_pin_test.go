@@ -0,0 +1,76 @@
+package configuration_test
+
+import (
+	. "cf/configuration"
+	"cf/models"
+	"fileutils"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	testconfig "testhelpers/configuration"
+)
+
+func writeProjectPin(dir, contents string) {
+	path := ProjectPinFilePath(dir)
+	err := os.MkdirAll(filepath.Dir(path), 0700)
+	Expect(err).NotTo(HaveOccurred())
+	err = ioutil.WriteFile(path, []byte(contents), 0600)
+	Expect(err).NotTo(HaveOccurred())
+}
+
+var _ = Describe("ProjectPin", func() {
+	It("returns nil when there is no project config file", func() {
+		fileutils.TempDir("project-pin", func(dir string, err error) {
+			Expect(err).NotTo(HaveOccurred())
+
+			pin, err := FindProjectPin(dir)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(pin).To(BeNil())
+		})
+	})
+
+	It("finds the project config file in a parent directory", func() {
+		fileutils.TempDir("project-pin", func(dir string, err error) {
+			Expect(err).NotTo(HaveOccurred())
+			writeProjectPin(dir, `{"api": "https://api.example.com", "org": "my-org", "space": "my-space"}`)
+
+			subDir := filepath.Join(dir, "src", "app")
+			err = os.MkdirAll(subDir, 0700)
+			Expect(err).NotTo(HaveOccurred())
+
+			pin, err := FindProjectPin(subDir)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(pin.Path).To(Equal(ProjectPinFilePath(dir)))
+			Expect(pin.ApiEndpoint).To(Equal("https://api.example.com"))
+			Expect(pin.Org).To(Equal("my-org"))
+			Expect(pin.Space).To(Equal("my-space"))
+			Expect(pin.WarnOnly()).To(BeFalse())
+		})
+	})
+
+	It("returns an error when the file is not valid", func() {
+		fileutils.TempDir("project-pin", func(dir string, err error) {
+			Expect(err).NotTo(HaveOccurred())
+			writeProjectPin(dir, `{"on_mismatch": "explode"}`)
+
+			pin, err := FindProjectPin(dir)
+			Expect(err).To(HaveOccurred())
+			Expect(pin).To(BeNil())
+		})
+	})
+
+	It("reports each pinned value that differs from the target", func() {
+		config := testconfig.NewRepository()
+		config.SetApiEndpoint("https://api.example.com/")
+		config.SetOrganizationFields(models.OrganizationFields{Name: "my-org"})
+		config.SetSpaceFields(models.SpaceFields{Name: "other-space"})
+
+		pin := ProjectPin{ApiEndpoint: "https://API.example.com", Org: "my-org", Space: "my-space"}
+		mismatches := pin.Mismatches(config)
+
+		Expect(len(mismatches)).To(Equal(1))
+		Expect(mismatches[0]).To(ContainSubstring("space is other-space, project expects my-space"))
+	})
+})
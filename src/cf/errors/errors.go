@@ -0,0 +1,219 @@
+package errors
+
+import (
+	"cf/net"
+	"fmt"
+)
+
+// Exit codes used by cf, so that scripts can react to the kind of failure.
+// They are listed in the EXIT CODES section of cf help.
+const (
+	ExitCodeSuccess  = 0
+	ExitCodeGeneral  = 1
+	ExitCodeUsage    = 2
+	ExitCodeAuth     = 3
+	ExitCodeNotFound = 4
+	ExitCodeServer   = 5
+	ExitCodeNetwork  = 6
+	ExitCodeTimeout  = 7
+)
+
+// Error is implemented by all the errors returned by commands
+type Error interface {
+	error
+	ExitCode() int
+}
+
+type GeneralError struct {
+	Message string
+}
+
+type UsageError struct {
+	Message string
+}
+
+type AuthError struct {
+	Message string
+}
+
+type NotFoundError struct {
+	Message string
+}
+
+type ServerError struct {
+	Message    string
+	StatusCode int
+	ErrorCode  string
+}
+
+type NetworkError struct {
+	Message string
+}
+
+// TimeoutError is returned when cf gives up waiting for the platform, which
+// may still complete the operation later
+type TimeoutError struct {
+	Message string
+}
+
+type MissingInputError struct {
+	Message string
+}
+
+// PluginError is returned when a plugin fails, so that cf exits with the
+// exit code of the plugin
+type PluginError struct {
+	Message string
+	Code    int
+}
+
+func New(message string, args ...interface{}) error {
+	return &GeneralError{Message: format(message, args)}
+}
+
+func NewUsageError(message string, args ...interface{}) error {
+	return &UsageError{Message: format(message, args)}
+}
+
+func NewAuthError(message string, args ...interface{}) error {
+	return &AuthError{Message: format(message, args)}
+}
+
+func NewNotFoundError(message string, args ...interface{}) error {
+	return &NotFoundError{Message: format(message, args)}
+}
+
+func NewNetworkError(message string, args ...interface{}) error {
+	return &NetworkError{Message: format(message, args)}
+}
+
+func NewTimeoutError(message string, args ...interface{}) error {
+	return &TimeoutError{Message: format(message, args)}
+}
+
+// NewMissingInputError is returned instead of prompting for input in
+// non-interactive mode. flag tells how to supply the input instead.
+func NewMissingInputError(input, flag string) error {
+	return &MissingInputError{Message: fmt.Sprintf("Cannot prompt for %s in non-interactive mode. Use %s.", input, flag)}
+}
+
+func NewPluginError(code int, message string, args ...interface{}) error {
+	return &PluginError{Message: format(message, args), Code: code}
+}
+
+// FromApiResponse converts an unsuccessful response into the error matching
+// its class, keeping the message of the response
+func FromApiResponse(apiResponse net.ApiResponse) error {
+	return FromApiResponseWithMessage(apiResponse, apiResponse.Message)
+}
+
+// FromApiResponseWithMessage is like FromApiResponse, but reports the given
+// message, which usually adds context to the message of the response
+func FromApiResponseWithMessage(apiResponse net.ApiResponse, message string, args ...interface{}) error {
+	message = format(message, args)
+
+	switch {
+	case apiResponse.IsNotFound():
+		return &NotFoundError{Message: message}
+	case apiResponse.IsNetworkError():
+		return &NetworkError{Message: message}
+	case apiResponse.IsHttpError() && (apiResponse.StatusCode == 401 || apiResponse.StatusCode == 403):
+		return &AuthError{Message: message}
+	case apiResponse.IsHttpError():
+		return &ServerError{Message: message, StatusCode: apiResponse.StatusCode, ErrorCode: apiResponse.ErrorCode}
+	}
+	return &GeneralError{Message: message}
+}
+
+// ExitCode returns the exit code for err, which is 0 for nil and 1 for
+// errors that don't belong to a class
+func ExitCode(err error) int {
+	if err == nil {
+		return ExitCodeSuccess
+	}
+
+	typedErr, ok := err.(Error)
+	if !ok {
+		return ExitCodeGeneral
+	}
+	return typedErr.ExitCode()
+}
+
+func format(message string, args []interface{}) string {
+	if len(args) == 0 {
+		return message
+	}
+	return fmt.Sprintf(message, args...)
+}
+
+func (err *GeneralError) Error() string {
+	return err.Message
+}
+
+func (err *GeneralError) ExitCode() int {
+	return ExitCodeGeneral
+}
+
+func (err *UsageError) Error() string {
+	return err.Message
+}
+
+func (err *UsageError) ExitCode() int {
+	return ExitCodeUsage
+}
+
+func (err *AuthError) Error() string {
+	return err.Message
+}
+
+func (err *AuthError) ExitCode() int {
+	return ExitCodeAuth
+}
+
+func (err *NotFoundError) Error() string {
+	return err.Message
+}
+
+func (err *NotFoundError) ExitCode() int {
+	return ExitCodeNotFound
+}
+
+func (err *ServerError) Error() string {
+	return err.Message
+}
+
+func (err *ServerError) ExitCode() int {
+	return ExitCodeServer
+}
+
+func (err *NetworkError) Error() string {
+	return err.Message
+}
+
+func (err *NetworkError) ExitCode() int {
+	return ExitCodeNetwork
+}
+
+func (err *TimeoutError) Error() string {
+	return err.Message
+}
+
+func (err *TimeoutError) ExitCode() int {
+	return ExitCodeTimeout
+}
+
+func (err *MissingInputError) Error() string {
+	return err.Message
+}
+
+func (err *MissingInputError) ExitCode() int {
+	return ExitCodeUsage
+}
+
+func (err *PluginError) Error() string {
+	return err.Message
+}
+
+func (err *PluginError) ExitCode() int {
+	return err.Code
+}
@@ -0,0 +1,68 @@
+package errors_test
+
+import (
+	. "cf/errors"
+	"cf/net"
+	stderrors "errors"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Errors", func() {
+	It("formats messages only when arguments are given", func() {
+		Expect(New("100% done").Error()).To(Equal("100% done"))
+		Expect(New("App %s not found", "my-app").Error()).To(Equal("App my-app not found"))
+	})
+
+	It("maps each class of error to its exit code", func() {
+		Expect(ExitCode(nil)).To(Equal(ExitCodeSuccess))
+		Expect(ExitCode(stderrors.New("boom"))).To(Equal(ExitCodeGeneral))
+		Expect(ExitCode(New("boom"))).To(Equal(ExitCodeGeneral))
+		Expect(ExitCode(NewUsageError("Incorrect Usage"))).To(Equal(ExitCodeUsage))
+		Expect(ExitCode(NewAuthError("Not logged in."))).To(Equal(ExitCodeAuth))
+		Expect(ExitCode(NewNotFoundError("App not found"))).To(Equal(ExitCodeNotFound))
+		Expect(ExitCode(NewNetworkError("Error performing request"))).To(Equal(ExitCodeNetwork))
+		Expect(ExitCode(NewTimeoutError("Timed out waiting for service"))).To(Equal(ExitCodeTimeout))
+		Expect(ExitCode(NewPluginError(42, "Plugin foo failed"))).To(Equal(42))
+	})
+
+	Describe("FromApiResponse", func() {
+		It("returns not found errors", func() {
+			err := FromApiResponse(net.NewNotFoundApiResponse("App %s not found", "my-app"))
+			Expect(err.Error()).To(Equal("App my-app not found"))
+			Expect(ExitCode(err)).To(Equal(ExitCodeNotFound))
+		})
+
+		It("returns network errors", func() {
+			err := FromApiResponse(net.NewNetworkErrorApiResponse("Error performing request", stderrors.New("connection refused")))
+			Expect(err.Error()).To(Equal("Error performing request: connection refused"))
+			Expect(ExitCode(err)).To(Equal(ExitCodeNetwork))
+		})
+
+		It("returns auth errors for unauthorized and forbidden responses", func() {
+			Expect(ExitCode(FromApiResponse(net.NewApiResponse("Invalid token", "1000", 401)))).To(Equal(ExitCodeAuth))
+			Expect(ExitCode(FromApiResponse(net.NewApiResponse("Not authorized", "10003", 403)))).To(Equal(ExitCodeAuth))
+		})
+
+		It("returns server errors for other http errors", func() {
+			err := FromApiResponse(net.NewApiResponse("Server error", "10001", 500))
+			Expect(ExitCode(err)).To(Equal(ExitCodeServer))
+
+			serverErr := err.(*ServerError)
+			Expect(serverErr.StatusCode).To(Equal(500))
+			Expect(serverErr.ErrorCode).To(Equal("10001"))
+		})
+
+		It("returns general errors for anything else", func() {
+			err := FromApiResponse(net.NewApiResponseWithMessage("Invalid position"))
+			Expect(ExitCode(err)).To(Equal(ExitCodeGeneral))
+		})
+
+		It("uses the given message", func() {
+			apiResponse := net.NewNotFoundApiResponse("not found")
+			err := FromApiResponseWithMessage(apiResponse, "Could not target org.\n%s", apiResponse.Message)
+			Expect(err.Error()).To(Equal("Could not target org.\nnot found"))
+			Expect(ExitCode(err)).To(Equal(ExitCodeNotFound))
+		})
+	})
+})
@@ -7,6 +7,7 @@ const (
 	ORG_EXISTS                  = "30002"
 	SPACE_EXISTS                = "40002"
 	SERVICE_INSTANCE_NAME_TAKEN = "60002"
+	SERVICE_PLAN_NOT_UPDATEABLE = "60016"
 	APP_NOT_STAGED              = "170002"
 	APP_STOPPED                 = "220001"
 	BUILDPACK_EXISTS            = "290001"
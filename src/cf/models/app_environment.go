@@ -0,0 +1,9 @@
+package models
+
+// AppEnvironment holds the variables the platform gives an app, as read
+// from the Cloud Controller
+type AppEnvironment struct {
+	Environment map[string]string
+	System      map[string]interface{}
+	Application map[string]interface{}
+}
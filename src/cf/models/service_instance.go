@@ -1,11 +1,26 @@
 package models
 
+// States of the last operation on a service instance, which brokers that
+// provision asynchronously report as in progress until they are done
+const (
+	ServiceOperationInProgress = "in progress"
+	ServiceOperationSucceeded  = "succeeded"
+	ServiceOperationFailed     = "failed"
+)
+
+type LastOperationFields struct {
+	Type        string
+	State       string
+	Description string
+}
+
 type ServiceInstanceFields struct {
 	Guid             string
 	Name             string
 	SysLogDrainUrl   string
 	ApplicationNames []string
-	Params           map[string]string
+	Params           map[string]interface{} `json:"-"`
+	LastOperation    LastOperationFields
 }
 
 type ServiceInstance struct {
@@ -7,6 +7,7 @@ type ServiceOfferingFields struct {
 	Version          string
 	Description      string
 	DocumentationUrl string
+	Tags             []string
 }
 
 type ServiceOfferings []ServiceOffering
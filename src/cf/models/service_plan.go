@@ -1,8 +1,12 @@
 package models
 
 type ServicePlanFields struct {
-	Guid string
-	Name string
+	Guid        string
+	Name        string
+	Description string
+	Free        bool
+	Public      bool
+	Extra       string
 }
 
 type ServicePlan struct {
@@ -0,0 +1,9 @@
+package models
+
+// ServicePlanVisibilityFields grants an org access to a plan that is not
+// public
+type ServicePlanVisibilityFields struct {
+	Guid             string
+	ServicePlanGuid  string
+	OrganizationGuid string
+}
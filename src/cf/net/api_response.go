@@ -15,6 +15,7 @@ type ApiResponse struct {
 	isError        bool
 	isHttpResponse bool
 	isNotFound     bool
+	isNetworkError bool
 }
 
 func NewApiResponse(message string, errorCode string, statusCode int) (apiResponse ApiResponse) {
@@ -60,6 +61,14 @@ func NewApiResponseWithError(message string, err error) (apiResponse ApiResponse
 	}
 }
 
+func NewNetworkErrorApiResponse(message string, err error) (apiResponse ApiResponse) {
+	return ApiResponse{
+		Message:        fmt.Sprintf("%s: %s", message, err.Error()),
+		isError:        true,
+		isNetworkError: true,
+	}
+}
+
 func NewNotFoundApiResponse(message string, a ...interface{}) (apiResponse ApiResponse) {
 	return ApiResponse{
 		Message:    fmt.Sprintf(message, a...),
@@ -79,6 +88,10 @@ func (apiResponse ApiResponse) IsHttpError() bool {
 	return apiResponse.isError && apiResponse.isHttpResponse
 }
 
+func (apiResponse ApiResponse) IsNetworkError() bool {
+	return apiResponse.isNetworkError
+}
+
 func (apiResponse ApiResponse) IsNotFound() bool {
 	return apiResponse.isNotFound || (apiResponse.isHttpResponse && apiResponse.StatusCode == 404)
 }
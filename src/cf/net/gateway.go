@@ -47,6 +47,7 @@ type errorHandler func(*http.Response) errorResponse
 
 type tokenRefresher interface {
 	RefreshAuthToken() (string, ApiResponse)
+	RefreshAuthTokenIfExpiring(accessToken string) (string, ApiResponse)
 }
 
 type Request struct {
@@ -305,6 +306,16 @@ func (gateway Gateway) waitForJob(jobUrl, accessToken string) (apiResponse ApiRe
 func (gateway Gateway) doRequestHandlingAuth(request *Request) (rawResponse *http.Response, apiResponse ApiResponse) {
 	httpReq := request.HttpReq
 
+	// refresh the auth token ahead of time if it is about to expire
+	if gateway.authenticator != nil && httpReq.Header.Get("Authorization") != "" {
+		var newToken string
+		newToken, apiResponse = gateway.authenticator.RefreshAuthTokenIfExpiring(httpReq.Header.Get("Authorization"))
+		if apiResponse.IsNotSuccessful() {
+			return
+		}
+		httpReq.Header.Set("Authorization", newToken)
+	}
+
 	// perform request
 	rawResponse, apiResponse = gateway.doRequestAndHandlerError(request)
 	if apiResponse.IsSuccessful() || gateway.authenticator == nil {
@@ -336,7 +347,7 @@ func (gateway Gateway) doRequestHandlingAuth(request *Request) (rawResponse *htt
 func (gateway Gateway) doRequestAndHandlerError(request *Request) (rawResponse *http.Response, apiResponse ApiResponse) {
 	rawResponse, err := doRequest(request.HttpReq)
 	if err != nil {
-		apiResponse = NewApiResponseWithError("Error performing request", err)
+		apiResponse = NewNetworkErrorApiResponse("Error performing request", err)
 		return
 	}
 
@@ -1,19 +1,24 @@
 package net
 
 import (
+	"bytes"
 	"cf/terminal"
 	"cf/trace"
 	"crypto/tls"
 	"errors"
 	"fmt"
+	"io"
+	"io/ioutil"
 	"net/http"
 	"net/http/httputil"
 	"regexp"
 	"strings"
+	"time"
 )
 
 const (
-	PRIVATE_DATA_PLACEHOLDER = "[PRIVATE DATA HIDDEN]"
+	PRIVATE_DATA_PLACEHOLDER   = "[PRIVATE DATA HIDDEN]"
+	MULTIPART_DATA_PLACEHOLDER = "[MULTIPART/FORM-DATA CONTENT HIDDEN]"
 )
 
 func newHttpClient() *http.Client {
@@ -51,37 +56,167 @@ func Sanitize(input string) (sanitized string) {
 	sanitized = re.ReplaceAllString(input, "Authorization: "+PRIVATE_DATA_PLACEHOLDER)
 	re = regexp.MustCompile(`password=[^&]*&`)
 	sanitized = re.ReplaceAllString(sanitized, "password="+PRIVATE_DATA_PLACEHOLDER+"&")
+	re = regexp.MustCompile(`passcode=[^&]*`)
+	sanitized = re.ReplaceAllString(sanitized, "passcode="+PRIVATE_DATA_PLACEHOLDER)
 
 	sanitized = sanitizeJson("access_token", sanitized)
 	sanitized = sanitizeJson("refresh_token", sanitized)
 	sanitized = sanitizeJson("token", sanitized)
+	sanitized = sanitizeCredentials(sanitized)
 
 	return
 }
 
+// credentialsPattern finds the credentials of service instances and
+// bindings, which may be any JSON value
+var credentialsPattern = regexp.MustCompile(`"credentials"\s*:\s*`)
+
+func sanitizeCredentials(input string) (sanitized string) {
+	for {
+		location := credentialsPattern.FindStringIndex(input)
+		if location == nil {
+			return sanitized + input
+		}
+
+		valueStart := location[1]
+		valueEnd := valueStart + jsonValueLength(input[valueStart:])
+		sanitized += input[:valueStart] + `"` + PRIVATE_DATA_PLACEHOLDER + `"`
+		input = input[valueEnd:]
+	}
+}
+
+// jsonValueLength is the length of the JSON value input starts with, or of
+// all the input when the value is cut short
+func jsonValueLength(input string) int {
+	depth := 0
+	inString := false
+
+	for i := 0; i < len(input); i++ {
+		char := input[i]
+		switch {
+		case inString && char == '\\':
+			i++
+		case inString && char == '"':
+			inString = false
+			if depth == 0 {
+				return i + 1
+			}
+		case inString:
+		case char == '"':
+			inString = true
+		case depth == 0 && strings.ContainsRune(",}] \t\r\n", rune(char)):
+			return i
+		case char == '{' || char == '[':
+			depth++
+		case char == '}' || char == ']':
+			depth--
+			if depth == 0 {
+				return i + 1
+			}
+		}
+	}
+	return len(input)
+}
+
+func SanitizeHeaders(headers http.Header) (sanitized http.Header) {
+	sanitized = http.Header{}
+	for name, values := range headers {
+		sanitized[name] = values
+	}
+
+	if sanitized.Get("Authorization") != "" {
+		sanitized.Set("Authorization", PRIVATE_DATA_PLACEHOLDER)
+	}
+	return
+}
+
 func doRequest(request *http.Request) (response *http.Response, err error) {
 	httpClient := newHttpClient()
 
 	dumpRequest(request)
+	exchange := newTraceExchange(request)
 
 	response, err = httpClient.Do(request)
+	exchange.Duration = time.Since(exchange.StartedAt)
 	if err != nil {
+		exchange.Error = err.Error()
+		trace.Logger.PrintExchange(exchange)
 		return
 	}
 
 	dumpResponse(response)
+	recordTraceResponse(&exchange, response)
+	trace.Logger.PrintExchange(exchange)
+	return
+}
+
+func newTraceExchange(req *http.Request) (exchange trace.Exchange) {
+	exchange = trace.Exchange{
+		StartedAt:      time.Now(),
+		Method:         req.Method,
+		Url:            req.URL.String(),
+		HttpVersion:    req.Proto,
+		RequestHeaders: SanitizeHeaders(req.Header),
+	}
+
+	if isMultipart(req) {
+		exchange.RequestBody = MULTIPART_DATA_PLACEHOLDER
+		return
+	}
+
+	body, err := drainBody(&req.Body)
+	if err != nil {
+		exchange.Error = fmt.Sprintf("Error reading request body: %s", err)
+		return
+	}
+
+	exchange.RequestBody = Sanitize(string(body))
+	return
+}
+
+func recordTraceResponse(exchange *trace.Exchange, res *http.Response) {
+	exchange.StatusCode = res.StatusCode
+	exchange.Status = http.StatusText(res.StatusCode)
+	exchange.ResponseHeaders = SanitizeHeaders(res.Header)
+
+	originalBody := res.Body
+	body, err := drainBody(&res.Body)
+	if originalBody != nil {
+		originalBody.Close()
+	}
+	if err != nil {
+		exchange.Error = fmt.Sprintf("Error reading response body: %s", err)
+		return
+	}
+
+	exchange.ResponseBody = Sanitize(string(body))
+}
+
+// drainBody reads the whole body and replaces it with an in-memory copy, so
+// that the request or response can still be consumed afterwards
+func drainBody(body *io.ReadCloser) (content []byte, err error) {
+	if *body == nil {
+		return
+	}
+
+	content, err = ioutil.ReadAll(*body)
+	*body = ioutil.NopCloser(bytes.NewReader(content))
 	return
 }
 
+func isMultipart(req *http.Request) bool {
+	return strings.Contains(req.Header.Get("Content-Type"), "multipart/form-data")
+}
+
 func dumpRequest(req *http.Request) {
-	shouldDisplayBody := !strings.Contains(req.Header.Get("Content-Type"), "multipart/form-data")
+	shouldDisplayBody := !isMultipart(req)
 	dumpedRequest, err := httputil.DumpRequest(req, shouldDisplayBody)
 	if err != nil {
 		trace.Logger.Printf("Error dumping request\n%s\n", err)
 	} else {
 		trace.Logger.Printf("\n%s\n%s\n", terminal.HeaderColor("REQUEST:"), Sanitize(string(dumpedRequest)))
 		if !shouldDisplayBody {
-			trace.Logger.Println("[MULTIPART/FORM-DATA CONTENT HIDDEN]")
+			trace.Logger.Println(MULTIPART_DATA_PLACEHOLDER)
 		}
 	}
 }
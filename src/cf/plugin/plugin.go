@@ -0,0 +1,100 @@
+package plugin
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strings"
+)
+
+// executables named like this are run as the command named by the rest of
+// their name, e.g. cf-foo runs as cf foo
+const ExecutablePrefix = "cf-"
+
+// windowsExtensions are the extensions of executables on windows, which are
+// not part of the command name
+var windowsExtensions = []string{".exe", ".bat", ".cmd"}
+
+type Plugin struct {
+	Name string `json:"name"`
+	Path string `json:"path"`
+}
+
+// SearchPath lists the directories searched for plugins, in order: the
+// plugins directory of cf, then the directories on PATH
+func SearchPath(pluginsDir string) (dirs []string) {
+	dirs = append(dirs, pluginsDir)
+	return append(dirs, filepath.SplitList(os.Getenv("PATH"))...)
+}
+
+// Find returns the plugins in dirs sorted by name. When several directories
+// hold a plugin of the same name, the first one wins, like on PATH.
+func Find(dirs []string) (plugins []Plugin) {
+	found := map[string]bool{}
+
+	for _, dir := range dirs {
+		if dir == "" {
+			continue
+		}
+
+		files, err := ioutil.ReadDir(dir)
+		if err != nil {
+			continue
+		}
+
+		for _, file := range files {
+			name, ok := commandName(file)
+			if !ok || found[name] {
+				continue
+			}
+
+			found[name] = true
+			plugins = append(plugins, Plugin{Name: name, Path: filepath.Join(dir, file.Name())})
+		}
+	}
+
+	sort.Sort(pluginsByName(plugins))
+	return
+}
+
+func FindByName(dirs []string, name string) (plugin Plugin, found bool) {
+	for _, plugin = range Find(dirs) {
+		if plugin.Name == name {
+			found = true
+			return
+		}
+	}
+	plugin = Plugin{}
+	return
+}
+
+func commandName(file os.FileInfo) (name string, ok bool) {
+	name = file.Name()
+	if !strings.HasPrefix(name, ExecutablePrefix) || file.IsDir() {
+		return
+	}
+	name = strings.TrimPrefix(name, ExecutablePrefix)
+
+	if runtime.GOOS == "windows" {
+		extension := strings.ToLower(filepath.Ext(name))
+		for _, windowsExtension := range windowsExtensions {
+			if extension == windowsExtension {
+				name = strings.TrimSuffix(name, filepath.Ext(name))
+				ok = name != ""
+				return
+			}
+		}
+		return
+	}
+
+	ok = name != "" && file.Mode()&0111 != 0
+	return
+}
+
+type pluginsByName []Plugin
+
+func (p pluginsByName) Len() int           { return len(p) }
+func (p pluginsByName) Swap(i, j int)      { p[i], p[j] = p[j], p[i] }
+func (p pluginsByName) Less(i, j int) bool { return p[i].Name < p[j].Name }
@@ -0,0 +1,74 @@
+package plugin_test
+
+import (
+	. "cf/plugin"
+	"fileutils"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+)
+
+func writeExecutable(dir, name string) {
+	if runtime.GOOS == "windows" {
+		name = name + ".exe"
+	}
+	err := ioutil.WriteFile(filepath.Join(dir, name), []byte("#!/bin/sh\n"), 0755)
+	Expect(err).NotTo(HaveOccurred())
+}
+
+var _ = Describe("plugins", func() {
+	It("finds cf- executables sorted by name", func() {
+		fileutils.TempDir("plugins", func(dir string, err error) {
+			Expect(err).NotTo(HaveOccurred())
+			writeExecutable(dir, "cf-zeta")
+			writeExecutable(dir, "cf-alpha")
+			writeExecutable(dir, "git-alpha")
+			os.Mkdir(filepath.Join(dir, "cf-dir"), 0755)
+
+			plugins := Find([]string{dir})
+			Expect(len(plugins)).To(Equal(2))
+			Expect(plugins[0].Name).To(Equal("alpha"))
+			Expect(plugins[1].Name).To(Equal("zeta"))
+		})
+	})
+
+	It("skips files that are not executable", func() {
+		if runtime.GOOS == "windows" {
+			return
+		}
+
+		fileutils.TempDir("plugins", func(dir string, err error) {
+			Expect(err).NotTo(HaveOccurred())
+			err = ioutil.WriteFile(filepath.Join(dir, "cf-notes"), []byte("notes"), 0644)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(Find([]string{dir})).To(BeEmpty())
+		})
+	})
+
+	It("prefers the plugins of earlier directories", func() {
+		fileutils.TempDir("plugins", func(firstDir string, err error) {
+			Expect(err).NotTo(HaveOccurred())
+			fileutils.TempDir("plugins", func(secondDir string, err error) {
+				Expect(err).NotTo(HaveOccurred())
+				writeExecutable(firstDir, "cf-foo")
+				writeExecutable(secondDir, "cf-foo")
+
+				plugin, found := FindByName([]string{firstDir, secondDir}, "foo")
+				Expect(found).To(BeTrue())
+				Expect(filepath.Dir(plugin.Path)).To(Equal(firstDir))
+
+				_, found = FindByName([]string{firstDir, secondDir}, "bar")
+				Expect(found).To(BeFalse())
+			})
+		})
+	})
+
+	It("searches the plugins directory before PATH", func() {
+		dirs := SearchPath("/cf/plugins")
+		Expect(dirs[0]).To(Equal("/cf/plugins"))
+	})
+})
@@ -2,6 +2,7 @@ package requirements
 
 import (
 	"cf/api"
+	"cf/errors"
 	"cf/models"
 	"cf/net"
 	"cf/terminal"
@@ -27,16 +28,14 @@ func NewApplicationRequirement(name string, ui terminal.UI, aR api.ApplicationRe
 	return
 }
 
-func (req *applicationApiRequirement) Execute() (success bool) {
+func (req *applicationApiRequirement) Execute() (err error) {
 	var apiResponse net.ApiResponse
 	req.application, apiResponse = req.appRepo.Read(req.name)
 
 	if apiResponse.IsNotSuccessful() {
-		req.ui.Failed(apiResponse.Message)
-		return false
+		err = errors.FromApiResponse(apiResponse)
 	}
-
-	return true
+	return
 }
 
 func (req *applicationApiRequirement) GetApplication() models.Application {
@@ -6,7 +6,6 @@ import (
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	testapi "testhelpers/api"
-	testassert "testhelpers/assert"
 	testterm "testhelpers/terminal"
 )
 
@@ -20,9 +19,9 @@ var _ = Describe("Testing with ginkgo", func() {
 		ui := new(testterm.FakeUI)
 
 		appReq := NewApplicationRequirement("foo", ui, appRepo)
-		success := appReq.Execute()
+		err := appReq.Execute()
 
-		Expect(success).To(BeTrue())
+		Expect(err).NotTo(HaveOccurred())
 		Expect(appRepo.ReadName).To(Equal("foo"))
 		Expect(appReq.GetApplication()).To(Equal(app))
 	})
@@ -33,8 +32,7 @@ var _ = Describe("Testing with ginkgo", func() {
 
 		appReq := NewApplicationRequirement("foo", ui, appRepo)
 
-		testassert.AssertPanic(testterm.FailedWasCalled, func() {
-			appReq.Execute()
-		})
+		err := appReq.Execute()
+		Expect(err).To(HaveOccurred())
 	})
 })
@@ -2,6 +2,7 @@ package requirements
 
 import (
 	"cf/api"
+	"cf/errors"
 	"cf/models"
 	"cf/net"
 	"cf/terminal"
@@ -27,16 +28,14 @@ func NewBuildpackRequirement(name string, ui terminal.UI, bR api.BuildpackReposi
 	return
 }
 
-func (req *buildpackApiRequirement) Execute() (success bool) {
+func (req *buildpackApiRequirement) Execute() (err error) {
 	var apiResponse net.ApiResponse
 	req.buildpack, apiResponse = req.buildpackRepo.FindByName(req.name)
 
 	if apiResponse.IsNotSuccessful() {
-		req.ui.Failed(apiResponse.Message)
-		return false
+		err = errors.FromApiResponse(apiResponse)
 	}
-
-	return true
+	return
 }
 
 func (req *buildpackApiRequirement) GetBuildpack() models.Buildpack {
@@ -6,7 +6,6 @@ import (
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	testapi "testhelpers/api"
-	testassert "testhelpers/assert"
 	testterm "testhelpers/terminal"
 )
 
@@ -20,9 +19,9 @@ var _ = Describe("Testing with ginkgo", func() {
 		ui := new(testterm.FakeUI)
 
 		buildpackReq := NewBuildpackRequirement("foo", ui, buildpackRepo)
-		success := buildpackReq.Execute()
+		err := buildpackReq.Execute()
 
-		Expect(success).To(BeTrue())
+		Expect(err).NotTo(HaveOccurred())
 		Expect(buildpackRepo.FindByNameName).To(Equal("foo"))
 		Expect(buildpackReq.GetBuildpack()).To(Equal(buildpack))
 	})
@@ -33,8 +32,7 @@ var _ = Describe("Testing with ginkgo", func() {
 
 		buildpackReq := NewBuildpackRequirement("foo", ui, buildpackRepo)
 
-		testassert.AssertPanic(testterm.FailedWasCalled, func() {
-			buildpackReq.Execute()
-		})
+		err := buildpackReq.Execute()
+		Expect(err).To(HaveOccurred())
 	})
 })
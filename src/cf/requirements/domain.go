@@ -3,6 +3,7 @@ package requirements
 import (
 	"cf/api"
 	"cf/configuration"
+	"cf/errors"
 	"cf/models"
 	"cf/net"
 	"cf/terminal"
@@ -30,16 +31,14 @@ func NewDomainRequirement(name string, ui terminal.UI, config configuration.Read
 	return
 }
 
-func (req *domainApiRequirement) Execute() bool {
+func (req *domainApiRequirement) Execute() (err error) {
 	var apiResponse net.ApiResponse
 	req.domain, apiResponse = req.domainRepo.FindByNameInOrg(req.name, req.config.OrganizationFields().Guid)
 
 	if apiResponse.IsNotSuccessful() {
-		req.ui.Failed(apiResponse.Message)
-		return false
+		err = errors.FromApiResponse(apiResponse)
 	}
-
-	return true
+	return
 }
 
 func (req *domainApiRequirement) GetDomain() models.DomainFields {
@@ -9,7 +9,6 @@ import (
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	testapi "testhelpers/api"
-	testassert "testhelpers/assert"
 	testconfig "testhelpers/configuration"
 	testterm "testhelpers/terminal"
 )
@@ -28,9 +27,9 @@ var _ = Describe("Testing with ginkgo", func() {
 		domain := models.DomainFields{Name: "example.com", Guid: "domain-guid"}
 		domainRepo := &testapi.FakeDomainRepository{FindByNameInOrgDomain: domain}
 		domainReq := NewDomainRequirement("example.com", ui, config, domainRepo)
-		success := domainReq.Execute()
+		err := domainReq.Execute()
 
-		Expect(success).To(BeTrue())
+		Expect(err).NotTo(HaveOccurred())
 		Expect(domainRepo.FindByNameInOrgName).To(Equal("example.com"))
 		Expect(domainRepo.FindByNameInOrgGuid).To(Equal("the-org-guid"))
 		Expect(domainReq.GetDomain()).To(Equal(domain))
@@ -40,17 +39,15 @@ var _ = Describe("Testing with ginkgo", func() {
 		domainRepo := &testapi.FakeDomainRepository{FindByNameInOrgApiResponse: net.NewNotFoundApiResponse("")}
 		domainReq := NewDomainRequirement("example.com", ui, config, domainRepo)
 
-		testassert.AssertPanic(testterm.FailedWasCalled, func() {
-			domainReq.Execute()
-		})
+		err := domainReq.Execute()
+		Expect(err).To(HaveOccurred())
 	})
 
 	It("fails when an error occurs fetching the domain", func() {
 		domainRepo := &testapi.FakeDomainRepository{FindByNameInOrgApiResponse: net.NewApiResponseWithError("", errors.New(""))}
 		domainReq := NewDomainRequirement("example.com", ui, config, domainRepo)
 
-		testassert.AssertPanic(testterm.FailedWasCalled, func() {
-			domainReq.Execute()
-		})
+		err := domainReq.Execute()
+		Expect(err).To(HaveOccurred())
 	})
 })
@@ -7,7 +7,7 @@ import (
 )
 
 type Requirement interface {
-	Execute() (success bool)
+	Execute() (err error)
 }
 
 type Factory interface {
@@ -28,10 +28,11 @@ type apiRequirementFactory struct {
 	ui          terminal.UI
 	config      configuration.Reader
 	repoLocator api.RepositoryLocator
+	projectPin  *configuration.ProjectPin
 }
 
-func NewFactory(ui terminal.UI, config configuration.Reader, repoLocator api.RepositoryLocator) (factory apiRequirementFactory) {
-	return apiRequirementFactory{ui, config, repoLocator}
+func NewFactory(ui terminal.UI, config configuration.Reader, repoLocator api.RepositoryLocator, projectPin *configuration.ProjectPin) (factory apiRequirementFactory) {
+	return apiRequirementFactory{ui, config, repoLocator, projectPin}
 }
 
 func (f apiRequirementFactory) NewApplicationRequirement(name string) ApplicationRequirement {
@@ -76,6 +77,7 @@ func (f apiRequirementFactory) NewTargetedSpaceRequirement() Requirement {
 	return NewTargetedSpaceRequirement(
 		f.ui,
 		f.config,
+		f.projectPin,
 	)
 }
 
@@ -2,6 +2,7 @@ package requirements
 
 import (
 	"cf/configuration"
+	"cf/errors"
 	"cf/terminal"
 )
 
@@ -14,10 +15,9 @@ func NewLoginRequirement(ui terminal.UI, config configuration.Reader) LoginRequi
 	return LoginRequirement{ui, config}
 }
 
-func (req LoginRequirement) Execute() (success bool) {
+func (req LoginRequirement) Execute() (err error) {
 	if !req.config.IsLoggedIn() {
-		req.ui.Say(terminal.NotLoggedInText())
-		return false
+		err = errors.NewAuthError(terminal.NotLoggedInText())
 	}
-	return true
+	return
 }
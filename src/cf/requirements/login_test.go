@@ -4,7 +4,6 @@ import (
 	. "cf/requirements"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
-	testassert "testhelpers/assert"
 	testconfig "testhelpers/configuration"
 	testterm "testhelpers/terminal"
 )
@@ -16,14 +15,13 @@ var _ = Describe("Testing with ginkgo", func() {
 		config := testconfig.NewRepositoryWithDefaults()
 
 		req := NewLoginRequirement(ui, config)
-		success := req.Execute()
-		Expect(success).To(BeTrue())
+		err := req.Execute()
+		Expect(err).NotTo(HaveOccurred())
 
 		config.SetAccessToken("")
 		req = NewLoginRequirement(ui, config)
-		success = req.Execute()
-		Expect(success).To(BeFalse())
-
-		testassert.SliceContains(ui.Outputs, testassert.Lines{{"Not logged in."}})
+		err = req.Execute()
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("Not logged in."))
 	})
 })
@@ -2,6 +2,7 @@ package requirements
 
 import (
 	"cf/api"
+	"cf/errors"
 	"cf/models"
 	"cf/net"
 	"cf/terminal"
@@ -27,16 +28,14 @@ func NewOrganizationRequirement(name string, ui terminal.UI, sR api.Organization
 	return
 }
 
-func (req *organizationApiRequirement) Execute() (success bool) {
+func (req *organizationApiRequirement) Execute() (err error) {
 	var apiResponse net.ApiResponse
 	req.org, apiResponse = req.orgRepo.FindByName(req.name)
 
 	if apiResponse.IsNotSuccessful() {
-		req.ui.Failed(apiResponse.Message)
-		return false
+		err = errors.FromApiResponse(apiResponse)
 	}
-
-	return true
+	return
 }
 
 func (req *organizationApiRequirement) GetOrganization() models.Organization {
@@ -6,7 +6,6 @@ import (
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	testapi "testhelpers/api"
-	testassert "testhelpers/assert"
 	testterm "testhelpers/terminal"
 )
 
@@ -20,9 +19,9 @@ var _ = Describe("Testing with ginkgo", func() {
 		ui := new(testterm.FakeUI)
 
 		orgReq := NewOrganizationRequirement("my-org-name", ui, orgRepo)
-		success := orgReq.Execute()
+		err := orgReq.Execute()
 
-		Expect(success).To(BeTrue())
+		Expect(err).NotTo(HaveOccurred())
 		Expect(orgRepo.FindByNameName).To(Equal("my-org-name"))
 		Expect(orgReq.GetOrganization()).To(Equal(org))
 	})
@@ -34,8 +33,7 @@ var _ = Describe("Testing with ginkgo", func() {
 
 		orgReq := NewOrganizationRequirement("foo", ui, orgRepo)
 
-		testassert.AssertPanic(testterm.FailedWasCalled, func() {
-			orgReq.Execute()
-		})
+		err := orgReq.Execute()
+		Expect(err).To(HaveOccurred())
 	})
 })
@@ -2,6 +2,7 @@ package requirements
 
 import (
 	"cf/api"
+	"cf/errors"
 	"cf/models"
 	"cf/net"
 	"cf/terminal"
@@ -27,16 +28,14 @@ func NewServiceInstanceRequirement(name string, ui terminal.UI, sR api.ServiceRe
 	return
 }
 
-func (req *serviceInstanceApiRequirement) Execute() (success bool) {
+func (req *serviceInstanceApiRequirement) Execute() (err error) {
 	var apiResponse net.ApiResponse
 	req.serviceInstance, apiResponse = req.serviceRepo.FindInstanceByName(req.name)
 
 	if apiResponse.IsNotSuccessful() {
-		req.ui.Failed(apiResponse.Message)
-		return false
+		err = errors.FromApiResponse(apiResponse)
 	}
-
-	return true
+	return
 }
 
 func (req *serviceInstanceApiRequirement) GetServiceInstance() models.ServiceInstance {
@@ -6,7 +6,6 @@ import (
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	testapi "testhelpers/api"
-	testassert "testhelpers/assert"
 	testterm "testhelpers/terminal"
 )
 
@@ -20,9 +19,9 @@ var _ = Describe("Testing with ginkgo", func() {
 		ui := new(testterm.FakeUI)
 
 		req := NewServiceInstanceRequirement("foo", ui, repo)
-		success := req.Execute()
+		err := req.Execute()
 
-		Expect(success).To(BeTrue())
+		Expect(err).NotTo(HaveOccurred())
 		Expect(repo.FindInstanceByNameName).To(Equal("foo"))
 		Expect(req.GetServiceInstance()).To(Equal(instance))
 	})
@@ -31,8 +30,7 @@ var _ = Describe("Testing with ginkgo", func() {
 		repo := &testapi.FakeServiceRepo{FindInstanceByNameNotFound: true}
 		ui := new(testterm.FakeUI)
 
-		testassert.AssertPanic(testterm.FailedWasCalled, func() {
-			NewServiceInstanceRequirement("foo", ui, repo).Execute()
-		})
+		err := NewServiceInstanceRequirement("foo", ui, repo).Execute()
+		Expect(err).To(HaveOccurred())
 	})
 })
@@ -2,6 +2,7 @@ package requirements
 
 import (
 	"cf/api"
+	"cf/errors"
 	"cf/models"
 	"cf/net"
 	"cf/terminal"
@@ -27,16 +28,14 @@ func NewSpaceRequirement(name string, ui terminal.UI, sR api.SpaceRepository) (r
 	return
 }
 
-func (req *spaceApiRequirement) Execute() (success bool) {
+func (req *spaceApiRequirement) Execute() (err error) {
 	var apiResponse net.ApiResponse
 	req.space, apiResponse = req.spaceRepo.FindByName(req.name)
 
 	if apiResponse.IsNotSuccessful() {
-		req.ui.Failed(apiResponse.Message)
-		return false
+		err = errors.FromApiResponse(apiResponse)
 	}
-
-	return true
+	return
 }
 
 func (req *spaceApiRequirement) GetSpace() models.Space {
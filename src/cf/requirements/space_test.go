@@ -6,7 +6,6 @@ import (
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	testapi "testhelpers/api"
-	testassert "testhelpers/assert"
 	testterm "testhelpers/terminal"
 )
 
@@ -20,9 +19,9 @@ var _ = Describe("Testing with ginkgo", func() {
 		ui := new(testterm.FakeUI)
 
 		spaceReq := NewSpaceRequirement("awesome-sauce-space", ui, spaceRepo)
-		success := spaceReq.Execute()
+		err := spaceReq.Execute()
 
-		Expect(success).To(BeTrue())
+		Expect(err).NotTo(HaveOccurred())
 		Expect(spaceRepo.FindByNameName).To(Equal("awesome-sauce-space"))
 		Expect(spaceReq.GetSpace()).To(Equal(space))
 	})
@@ -32,8 +31,7 @@ var _ = Describe("Testing with ginkgo", func() {
 		spaceRepo := &testapi.FakeSpaceRepository{FindByNameNotFound: true}
 		ui := new(testterm.FakeUI)
 
-		testassert.AssertPanic(testterm.FailedWasCalled, func() {
-			NewSpaceRequirement("foo", ui, spaceRepo).Execute()
-		})
+		err := NewSpaceRequirement("foo", ui, spaceRepo).Execute()
+		Expect(err).To(HaveOccurred())
 	})
 })
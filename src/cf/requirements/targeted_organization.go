@@ -3,9 +3,9 @@ package requirements
 import (
 	"cf"
 	"cf/configuration"
+	"cf/errors"
 	"cf/models"
 	"cf/terminal"
-	"fmt"
 )
 
 type TargetedOrgRequirement interface {
@@ -22,15 +22,12 @@ func NewTargetedOrgRequirement(ui terminal.UI, config configuration.Reader) Targ
 	return targetedOrgApiRequirement{ui, config}
 }
 
-func (req targetedOrgApiRequirement) Execute() (success bool) {
+func (req targetedOrgApiRequirement) Execute() (err error) {
 	if !req.config.HasOrganization() {
-		message := fmt.Sprintf("No org targeted, use '%s' to target an org.",
+		err = errors.New("No org targeted, use '%s' to target an org.",
 			terminal.CommandColor(cf.Name()+" target -o ORG"))
-		req.ui.Failed(message)
-		return false
 	}
-
-	return true
+	return
 }
 
 func (req targetedOrgApiRequirement) GetOrganizationFields() (org models.OrganizationFields) {
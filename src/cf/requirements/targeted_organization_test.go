@@ -5,7 +5,6 @@ import (
 	. "cf/requirements"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
-	testassert "testhelpers/assert"
 	testconfig "testhelpers/configuration"
 	testterm "testhelpers/terminal"
 )
@@ -19,18 +18,13 @@ var _ = Describe("Testing with ginkgo", func() {
 		config := testconfig.NewRepositoryWithDefaults()
 
 		req := NewTargetedOrgRequirement(ui, config)
-		success := req.Execute()
-		Expect(success).To(BeTrue())
+		err := req.Execute()
+		Expect(err).NotTo(HaveOccurred())
 
 		config.SetOrganizationFields(models.OrganizationFields{})
 
-		testassert.AssertPanic(testterm.FailedWasCalled, func() {
-			NewTargetedOrgRequirement(ui, config).Execute()
-		})
-
-		testassert.SliceContains(ui.Outputs, testassert.Lines{
-			{"FAILED"},
-			{"No org targeted"},
-		})
+		err = NewTargetedOrgRequirement(ui, config).Execute()
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("No org targeted"))
 	})
 })
@@ -3,32 +3,54 @@ package requirements
 import (
 	"cf"
 	"cf/configuration"
+	"cf/errors"
 	"cf/terminal"
 	"fmt"
+	"strings"
 )
 
 type TargetedSpaceRequirement struct {
-	ui     terminal.UI
-	config configuration.Reader
+	ui         terminal.UI
+	config     configuration.Reader
+	projectPin *configuration.ProjectPin
 }
 
-func NewTargetedSpaceRequirement(ui terminal.UI, config configuration.Reader) TargetedSpaceRequirement {
-	return TargetedSpaceRequirement{ui, config}
+func NewTargetedSpaceRequirement(ui terminal.UI, config configuration.Reader, projectPin *configuration.ProjectPin) TargetedSpaceRequirement {
+	return TargetedSpaceRequirement{ui, config, projectPin}
 }
 
-func (req TargetedSpaceRequirement) Execute() (success bool) {
+func (req TargetedSpaceRequirement) Execute() (err error) {
 	if !req.config.HasOrganization() {
-		message := fmt.Sprintf("No org and space targeted, use '%s' to target an org and space",
+		err = errors.New("No org and space targeted, use '%s' to target an org and space",
 			terminal.CommandColor(cf.Name()+" target -o ORG -s SPACE"))
-		req.ui.Failed(message)
-		return false
+		return
 	}
 
 	if !req.config.HasSpace() {
-		message := fmt.Sprintf("No space targeted, use '%s' to target a space", terminal.CommandColor("cf target -s"))
-		req.ui.Failed(message)
-		return false
+		err = errors.New("No space targeted, use '%s' to target a space", terminal.CommandColor("cf target -s"))
+		return
 	}
 
-	return true
+	return req.matchesProjectPin()
+}
+
+func (req TargetedSpaceRequirement) matchesProjectPin() (err error) {
+	if req.projectPin == nil {
+		return
+	}
+
+	mismatches := req.projectPin.Mismatches(req.config)
+	if len(mismatches) == 0 {
+		return
+	}
+
+	message := fmt.Sprintf("The current target does not match the one pinned by %s:\n   %s",
+		req.projectPin.Path, strings.Join(mismatches, "\n   "))
+
+	if req.projectPin.WarnOnly() {
+		req.ui.Warn(message)
+		return
+	}
+
+	return errors.New(message)
 }
@@ -1,6 +1,7 @@
 package requirements_test
 
 import (
+	"cf/configuration"
 	"cf/models"
 	. "cf/requirements"
 	. "github.com/onsi/ginkgo"
@@ -21,31 +22,64 @@ var _ = Describe("Testing with ginkgo", func() {
 		space.Guid = "my-space-guid"
 		config := testconfig.NewRepositoryWithDefaults()
 
-		req := NewTargetedSpaceRequirement(ui, config)
-		success := req.Execute()
-		Expect(success).To(BeTrue())
+		req := NewTargetedSpaceRequirement(ui, config, nil)
+		err := req.Execute()
+		Expect(err).NotTo(HaveOccurred())
 
 		config.SetSpaceFields(models.SpaceFields{})
 
-		testassert.AssertPanic(testterm.FailedWasCalled, func() {
-			NewTargetedSpaceRequirement(ui, config).Execute()
-		})
-
-		testassert.SliceContains(ui.Outputs, testassert.Lines{
-			{"FAILED"},
-			{"No space targeted"},
-		})
+		err = NewTargetedSpaceRequirement(ui, config, nil).Execute()
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("No space targeted"))
 
 		ui.ClearOutputs()
 		config.SetOrganizationFields(models.OrganizationFields{})
 
-		testassert.AssertPanic(testterm.FailedWasCalled, func() {
-			NewTargetedSpaceRequirement(ui, config).Execute()
-		})
+		err = NewTargetedSpaceRequirement(ui, config, nil).Execute()
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("No org and space targeted"))
+	})
+
+	It("TestSpaceRequirementWithMatchingProjectPin", func() {
+		ui := new(testterm.FakeUI)
+		config := testconfig.NewRepositoryWithDefaults()
+		pin := &configuration.ProjectPin{Org: "my-org", Space: "my-space"}
+
+		err := NewTargetedSpaceRequirement(ui, config, pin).Execute()
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("TestSpaceRequirementFailsWhenProjectPinDoesNotMatch", func() {
+		ui := new(testterm.FakeUI)
+		config := testconfig.NewRepositoryWithDefaults()
+		pin := &configuration.ProjectPin{
+			Path:       "/my/project/.cf/config",
+			Space:      "production",
+			OnMismatch: configuration.ProjectPinMismatchFail,
+		}
+
+		err := NewTargetedSpaceRequirement(ui, config, pin).Execute()
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("does not match"))
+		Expect(err.Error()).To(ContainSubstring("/my/project/.cf/config"))
+		Expect(err.Error()).To(ContainSubstring("space is my-space, project expects production"))
+	})
+
+	It("TestSpaceRequirementWarnsWhenProjectPinDoesNotMatch", func() {
+		ui := new(testterm.FakeUI)
+		config := testconfig.NewRepositoryWithDefaults()
+		pin := &configuration.ProjectPin{
+			Path:       "/my/project/.cf/config",
+			Space:      "production",
+			OnMismatch: configuration.ProjectPinMismatchWarn,
+		}
+
+		err := NewTargetedSpaceRequirement(ui, config, pin).Execute()
+		Expect(err).NotTo(HaveOccurred())
 
 		testassert.SliceContains(ui.Outputs, testassert.Lines{
-			{"FAILED"},
-			{"No org and space targeted"},
+			{"does not match", "/my/project/.cf/config"},
+			{"space is my-space, project expects production"},
 		})
 	})
 })
@@ -2,6 +2,7 @@ package requirements
 
 import (
 	"cf/api"
+	"cf/errors"
 	"cf/models"
 	"cf/net"
 	"cf/terminal"
@@ -27,16 +28,14 @@ func NewUserRequirement(username string, ui terminal.UI, userRepo api.UserReposi
 	return
 }
 
-func (req *userApiRequirement) Execute() (success bool) {
+func (req *userApiRequirement) Execute() (err error) {
 	var apiResponse net.ApiResponse
 	req.user, apiResponse = req.userRepo.FindByUsername(req.username)
 
 	if apiResponse.IsNotSuccessful() {
-		req.ui.Failed(apiResponse.Message)
-		return false
+		err = errors.FromApiResponse(apiResponse)
 	}
-
-	return true
+	return
 }
 
 func (req *userApiRequirement) GetUser() models.UserFields {
@@ -6,7 +6,6 @@ import (
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	testapi "testhelpers/api"
-	testassert "testhelpers/assert"
 	testterm "testhelpers/terminal"
 )
 
@@ -20,9 +19,9 @@ var _ = Describe("Testing with ginkgo", func() {
 		ui := new(testterm.FakeUI)
 
 		userReq := NewUserRequirement("foo", ui, userRepo)
-		success := userReq.Execute()
+		err := userReq.Execute()
 
-		Expect(success).To(BeTrue())
+		Expect(err).NotTo(HaveOccurred())
 		Expect(userRepo.FindByUsernameUsername).To(Equal("foo"))
 		Expect(userReq.GetUser()).To(Equal(user))
 	})
@@ -31,13 +30,8 @@ var _ = Describe("Testing with ginkgo", func() {
 		userRepo := &testapi.FakeUserRepository{FindByUsernameNotFound: true}
 		ui := new(testterm.FakeUI)
 
-		testassert.AssertPanic(testterm.FailedWasCalled, func() {
-			NewUserRequirement("foo", ui, userRepo).Execute()
-		})
-
-		testassert.SliceContains(ui.Outputs, testassert.Lines{
-			{"FAILED"},
-			{"User not found"},
-		})
+		err := NewUserRequirement("foo", ui, userRepo).Execute()
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("User not found"))
 	})
 })
@@ -2,6 +2,7 @@ package requirements
 
 import (
 	"cf/api"
+	"cf/errors"
 	"cf/terminal"
 )
 
@@ -14,13 +15,11 @@ func NewValidAccessTokenRequirement(ui terminal.UI, appRepo api.ApplicationRepos
 	return ValidAccessTokenRequirement{ui, appRepo}
 }
 
-func (req ValidAccessTokenRequirement) Execute() (success bool) {
+func (req ValidAccessTokenRequirement) Execute() (err error) {
 	_, apiResponse := req.appRepo.Read("checking_for_valid_access_token")
 
 	if apiResponse.IsNotSuccessful() && apiResponse.StatusCode == 401 {
-		req.ui.Say(terminal.NotLoggedInText())
-		return false
+		err = errors.NewAuthError(terminal.NotLoggedInText())
 	}
-
-	return true
+	return
 }
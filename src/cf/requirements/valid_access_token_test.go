@@ -5,7 +5,6 @@ import (
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	testapi "testhelpers/api"
-	testassert "testhelpers/assert"
 	testterm "testhelpers/terminal"
 )
 
@@ -17,14 +16,14 @@ var _ = Describe("Testing with ginkgo", func() {
 		}
 
 		req := NewValidAccessTokenRequirement(ui, appRepo)
-		success := req.Execute()
-		Expect(success).To(BeFalse())
-		testassert.SliceContains(ui.Outputs, testassert.Lines{{"Not logged in."}})
+		err := req.Execute()
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("Not logged in."))
 
 		appRepo.ReadAuthErr = false
 
 		req = NewValidAccessTokenRequirement(ui, appRepo)
-		success = req.Execute()
-		Expect(success).To(BeTrue())
+		err = req.Execute()
+		Expect(err).NotTo(HaveOccurred())
 	})
 })
@@ -0,0 +1,59 @@
+package terminal
+
+import (
+	"os"
+	"os/signal"
+	"time"
+)
+
+// interrupts holds a Ctrl-C caught while CatchInterrupts is on, until a
+// Wait picks it up
+var interrupts = make(chan bool, 1)
+
+// CatchInterrupts makes Ctrl-C stop the command waiting in Wait instead of
+// ending cf, until stop is called. cf shell uses it to return to the prompt.
+func CatchInterrupts() (stop func()) {
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, os.Interrupt)
+
+	done := make(chan bool)
+	go func() {
+		for {
+			select {
+			case <-signals:
+				select {
+				case interrupts <- true:
+				default:
+				}
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	return func() {
+		signal.Stop(signals)
+		close(done)
+		ClearInterrupts()
+	}
+}
+
+// ClearInterrupts forgets a Ctrl-C that no Wait picked up, such as one typed
+// while a command was busy with a request
+func ClearInterrupts() {
+	select {
+	case <-interrupts:
+	default:
+	}
+}
+
+// waitForInterrupt sleeps for duration, returning early when Ctrl-C was
+// caught meanwhile
+func waitForInterrupt(duration time.Duration) (interrupted bool) {
+	select {
+	case <-interrupts:
+		return true
+	case <-time.After(duration):
+		return false
+	}
+}
@@ -0,0 +1,325 @@
+package terminal
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// lines kept in the history of a LineReader
+const MaxHistory = 500
+
+// Completer returns the candidates for the last word of line, which is
+// empty when line ends in a space
+type Completer func(line string) (candidates []string)
+
+// LineReader reads the lines typed at a prompt. On terminals that support it
+// the line can be edited, the arrow keys recall earlier lines and Tab
+// completes the word before the cursor.
+type LineReader struct {
+	in       *bufio.Reader
+	out      io.Writer
+	file     *os.File
+	complete Completer
+	history  []string
+}
+
+// lineEdit is the state of the line being typed
+type lineEdit struct {
+	prompt       string
+	buffer       []rune
+	cursor       int
+	historyIndex int
+	typed        []rune
+}
+
+// NewLineReader reads from in, echoing to out. Like NewUI, readers other
+// than files are treated like a terminal.
+func NewLineReader(in io.Reader, out io.Writer, complete Completer) (reader *LineReader) {
+	reader = &LineReader{in: bufio.NewReader(in), out: out, complete: complete}
+	if file, ok := in.(*os.File); ok {
+		reader.file = file
+	}
+	return
+}
+
+func (r *LineReader) History() []string {
+	return append([]string{}, r.history...)
+}
+
+func (r *LineReader) SetHistory(history []string) {
+	r.history = nil
+	for _, line := range history {
+		r.AddHistory(line)
+	}
+}
+
+// AddHistory adds line to the history, unless it is blank or repeats the
+// last line
+func (r *LineReader) AddHistory(line string) {
+	if strings.TrimSpace(line) == "" {
+		return
+	}
+	if len(r.history) > 0 && r.history[len(r.history)-1] == line {
+		return
+	}
+
+	r.history = append(r.history, line)
+	if len(r.history) > MaxHistory {
+		r.history = r.history[len(r.history)-MaxHistory:]
+	}
+}
+
+// ReadLine returns the next line without its line ending. It returns io.EOF
+// at the end of the input or when Ctrl-D is typed on an empty line, and an
+// empty line when Ctrl-C is typed.
+func (r *LineReader) ReadLine(prompt string) (line string, err error) {
+	if r.file == nil {
+		return r.readEdited(prompt)
+	}
+
+	if !isTerminal(r.file) {
+		return r.readPlain()
+	}
+
+	restore, rawErr := makeRaw(r.file)
+	if rawErr != nil {
+		fmt.Fprint(r.out, prompt)
+		return r.readPlain()
+	}
+	defer restore()
+
+	return r.readEdited(prompt)
+}
+
+func (r *LineReader) readPlain() (line string, err error) {
+	line, err = r.in.ReadString('\n')
+	if err == io.EOF && line != "" {
+		err = nil
+	}
+	line = strings.TrimRight(line, "\r\n")
+	return
+}
+
+func (r *LineReader) readEdited(prompt string) (line string, err error) {
+	edit := &lineEdit{prompt: prompt, historyIndex: len(r.history)}
+	fmt.Fprint(r.out, prompt)
+
+	for {
+		char, _, readErr := r.in.ReadRune()
+		if readErr != nil {
+			if len(edit.buffer) > 0 {
+				fmt.Fprintln(r.out)
+				line = string(edit.buffer)
+				return
+			}
+			err = readErr
+			return
+		}
+
+		switch char {
+		case '\r', '\n':
+			fmt.Fprint(r.out, "\r\n")
+			line = string(edit.buffer)
+			return
+		case 3: // Ctrl-C
+			fmt.Fprint(r.out, "^C\r\n")
+			return
+		case 4: // Ctrl-D
+			if len(edit.buffer) == 0 {
+				fmt.Fprint(r.out, "\r\n")
+				err = io.EOF
+				return
+			}
+			edit.delete()
+		case 1: // Ctrl-A
+			edit.cursor = 0
+		case 5: // Ctrl-E
+			edit.cursor = len(edit.buffer)
+		case 2: // Ctrl-B
+			edit.moveLeft()
+		case 6: // Ctrl-F
+			edit.moveRight()
+		case 11: // Ctrl-K
+			edit.buffer = edit.buffer[:edit.cursor]
+		case 21: // Ctrl-U
+			edit.buffer = edit.buffer[edit.cursor:]
+			edit.cursor = 0
+		case 23: // Ctrl-W
+			edit.deleteWord()
+		case 8, 127: // Backspace
+			edit.backspace()
+		case 16: // Ctrl-P
+			edit.recall(r.history, -1)
+		case 14: // Ctrl-N
+			edit.recall(r.history, 1)
+		case '\t':
+			r.completeWord(edit)
+		case 27:
+			r.readEscape(edit)
+		default:
+			if unicode.IsPrint(char) {
+				edit.insert([]rune{char})
+			}
+		}
+
+		r.redraw(edit)
+	}
+}
+
+// readEscape handles the escape sequences of the arrow, home, end and
+// delete keys
+func (r *LineReader) readEscape(edit *lineEdit) {
+	next, _, err := r.in.ReadRune()
+	if err != nil || (next != '[' && next != 'O') {
+		return
+	}
+
+	key, _, err := r.in.ReadRune()
+	if err != nil {
+		return
+	}
+
+	switch key {
+	case 'A':
+		edit.recall(r.history, -1)
+	case 'B':
+		edit.recall(r.history, 1)
+	case 'C':
+		edit.moveRight()
+	case 'D':
+		edit.moveLeft()
+	case 'H':
+		edit.cursor = 0
+	case 'F':
+		edit.cursor = len(edit.buffer)
+	case '3':
+		if tilde, _, err := r.in.ReadRune(); err == nil && tilde == '~' {
+			edit.delete()
+		}
+	}
+}
+
+// completeWord inserts what the candidates for the word before the cursor
+// have in common, and lists them when that adds nothing
+func (r *LineReader) completeWord(edit *lineEdit) {
+	if r.complete == nil {
+		return
+	}
+
+	before := string(edit.buffer[:edit.cursor])
+	wordStart := strings.LastIndexAny(before, " \t") + 1
+	word := before[wordStart:]
+
+	candidates := []string{}
+	for _, candidate := range r.complete(before) {
+		if strings.HasPrefix(candidate, word) {
+			candidates = append(candidates, candidate)
+		}
+	}
+
+	switch len(candidates) {
+	case 0:
+		return
+	case 1:
+		edit.insert([]rune(candidates[0][len(word):] + " "))
+		return
+	}
+
+	prefix := commonPrefix(candidates)
+	if len(prefix) > len(word) {
+		edit.insert([]rune(prefix[len(word):]))
+		return
+	}
+
+	sort.Strings(candidates)
+	fmt.Fprint(r.out, "\r\n"+strings.Join(candidates, "  ")+"\r\n")
+}
+
+func (r *LineReader) redraw(edit *lineEdit) {
+	fmt.Fprint(r.out, "\r"+edit.prompt+string(edit.buffer)+"\x1b[K")
+	if back := len(edit.buffer) - edit.cursor; back > 0 {
+		fmt.Fprintf(r.out, "\x1b[%dD", back)
+	}
+}
+
+func (edit *lineEdit) insert(chars []rune) {
+	buffer := append([]rune{}, edit.buffer[:edit.cursor]...)
+	buffer = append(buffer, chars...)
+	edit.buffer = append(buffer, edit.buffer[edit.cursor:]...)
+	edit.cursor += len(chars)
+}
+
+func (edit *lineEdit) backspace() {
+	if edit.cursor == 0 {
+		return
+	}
+	edit.buffer = append(edit.buffer[:edit.cursor-1], edit.buffer[edit.cursor:]...)
+	edit.cursor--
+}
+
+func (edit *lineEdit) delete() {
+	if edit.cursor == len(edit.buffer) {
+		return
+	}
+	edit.buffer = append(edit.buffer[:edit.cursor], edit.buffer[edit.cursor+1:]...)
+}
+
+func (edit *lineEdit) deleteWord() {
+	start := edit.cursor
+	for start > 0 && edit.buffer[start-1] == ' ' {
+		start--
+	}
+	for start > 0 && edit.buffer[start-1] != ' ' {
+		start--
+	}
+	edit.buffer = append(edit.buffer[:start], edit.buffer[edit.cursor:]...)
+	edit.cursor = start
+}
+
+func (edit *lineEdit) moveLeft() {
+	if edit.cursor > 0 {
+		edit.cursor--
+	}
+}
+
+func (edit *lineEdit) moveRight() {
+	if edit.cursor < len(edit.buffer) {
+		edit.cursor++
+	}
+}
+
+// recall replaces the line by an earlier or later line of history, keeping
+// what was typed to return to after the last one
+func (edit *lineEdit) recall(history []string, step int) {
+	index := edit.historyIndex + step
+	if index < 0 || index > len(history) {
+		return
+	}
+
+	if edit.historyIndex == len(history) {
+		edit.typed = edit.buffer
+	}
+
+	edit.historyIndex = index
+	if index == len(history) {
+		edit.buffer = edit.typed
+	} else {
+		edit.buffer = []rune(history[index])
+	}
+	edit.cursor = len(edit.buffer)
+}
+
+func commonPrefix(words []string) (prefix string) {
+	prefix = words[0]
+	for _, word := range words[1:] {
+		for !strings.HasPrefix(word, prefix) {
+			prefix = prefix[:len(prefix)-1]
+		}
+	}
+	return
+}
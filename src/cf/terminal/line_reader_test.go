@@ -0,0 +1,70 @@
+package terminal_test
+
+import (
+	"bytes"
+	. "cf/terminal"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"io"
+	"strings"
+)
+
+func readLines(input string, history []string, complete Completer) (lines []string, err error) {
+	reader := NewLineReader(strings.NewReader(input), new(bytes.Buffer), complete)
+	reader.SetHistory(history)
+
+	for {
+		var line string
+		line, err = reader.ReadLine("cf> ")
+		if err != nil {
+			return
+		}
+		lines = append(lines, line)
+	}
+}
+
+var _ = Describe("reading lines", func() {
+	It("reads lines until the end of the input", func() {
+		lines, err := readLines("apps\nspaces", nil, nil)
+		Expect(err).To(Equal(io.EOF))
+		Expect(lines).To(Equal([]string{"apps", "spaces"}))
+	})
+
+	It("edits the line with the arrow keys and backspace", func() {
+		lines, _ := readLines("helo\x1b[D\x1b[Dl\x1b[C\x1b[Cx\x7f\r", nil, nil)
+		Expect(lines).To(Equal([]string{"hello"}))
+	})
+
+	It("recalls earlier lines", func() {
+		lines, _ := readLines("\x1b[A\x1b[A\r\x1b[A\x1b[A\x1b[Bx\r", []string{"apps", "spaces"}, nil)
+		Expect(lines).To(Equal([]string{"apps", "spacesx"}))
+	})
+
+	It("clears the line on Ctrl-C and stops on Ctrl-D", func() {
+		lines, err := readLines("apps\x03spaces\r\x04apps\r", nil, nil)
+		Expect(err).To(Equal(io.EOF))
+		Expect(lines).To(Equal([]string{"", "spaces"}))
+	})
+
+	It("completes the word before the cursor", func() {
+		complete := func(line string) []string {
+			return []string{"app", "apps", "create-space", "create-service"}
+		}
+
+		lines, _ := readLines("ap\t\rcreate-sp\t\rcreate-s\t\t\r", nil, complete)
+		Expect(lines).To(Equal([]string{"app", "create-space ", "create-s"}))
+	})
+
+	It("keeps a limited history without repeated lines", func() {
+		reader := NewLineReader(strings.NewReader(""), new(bytes.Buffer), nil)
+		reader.AddHistory("apps")
+		reader.AddHistory("apps")
+		reader.AddHistory(" ")
+		Expect(reader.History()).To(Equal([]string{"apps"}))
+
+		for i := 0; i < MaxHistory+10; i++ {
+			reader.AddHistory(strings.Repeat("x", i+1))
+		}
+		Expect(len(reader.History())).To(Equal(MaxHistory))
+	})
+})
@@ -0,0 +1,36 @@
+// +build darwin freebsd linux netbsd openbsd
+
+package terminal
+
+import (
+	"os"
+	"os/exec"
+	"strings"
+)
+
+// makeRaw turns off line buffering, echo and signals on the terminal, so
+// that LineReader sees each key as it is typed
+func makeRaw(file *os.File) (restore func(), err error) {
+	state, err := stty(file, "-g")
+	if err != nil {
+		return
+	}
+
+	_, err = stty(file, "-icanon", "-echo", "-isig", "min", "1")
+	if err != nil {
+		return
+	}
+
+	restore = func() {
+		stty(file, strings.TrimSpace(state))
+	}
+	return
+}
+
+func stty(file *os.File, args ...string) (output string, err error) {
+	cmd := exec.Command("stty", args...)
+	cmd.Stdin = file
+	outputBytes, err := cmd.Output()
+	output = string(outputBytes)
+	return
+}
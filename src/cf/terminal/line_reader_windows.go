@@ -0,0 +1,14 @@
+// +build windows
+
+package terminal
+
+import (
+	"errors"
+	"os"
+)
+
+// makeRaw is not supported, the console of Windows edits the line itself
+func makeRaw(file *os.File) (restore func(), err error) {
+	err = errors.New("raw mode is not supported on Windows")
+	return
+}
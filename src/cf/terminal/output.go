@@ -0,0 +1,140 @@
+package terminal
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/codegangsta/cli"
+	"glob"
+	"os"
+	"strconv"
+	"strings"
+)
+
+const (
+	OutputText     = "text"
+	OutputJSON     = "json"
+	OutputYAML     = "yaml"
+	OutputTemplate = "template"
+)
+
+// OutputOptions control how commands print the data they fetch. In the
+// structured formats the models behind each table are printed instead of the
+// table, and progress messages go to stderr so that stdout stays parseable.
+// Tables are filtered and sorted before printing in every format.
+// NonInteractive makes prompts fail instead of waiting for input.
+type OutputOptions struct {
+	Format         string
+	Template       string
+	SortBy         string
+	Filters        []TableFilter
+	Columns        []string
+	NoHeaders      bool
+	Width          int
+	NonInteractive bool
+}
+
+type TableFilter struct {
+	Column  string
+	Pattern glob.Glob
+}
+
+func NewOutputOptions(c *cli.Context) (options OutputOptions, err error) {
+	options.Format = strings.ToLower(c.GlobalString("output"))
+
+	switch options.Format {
+	case "":
+		options.Format = OutputText
+	case OutputText, OutputJSON, OutputYAML:
+	default:
+		err = errors.New(fmt.Sprintf("Unknown output format %s, expected one of text, json or yaml", options.Format))
+		return
+	}
+
+	options.Template = c.String("format")
+	if options.Template != "" {
+		if options.Format != OutputText {
+			err = errors.New("--format cannot be combined with --output " + options.Format)
+			return
+		}
+		options.Format = OutputTemplate
+	}
+
+	options.Width = TerminalWidth()
+	options.NonInteractive = c.GlobalBool("non-interactive") || nonInteractiveFromEnv()
+	options.SortBy = strings.TrimSpace(c.String("sort-by"))
+	options.NoHeaders = c.Bool("no-headers")
+
+	for _, column := range strings.Split(c.String("columns"), ",") {
+		column = strings.TrimSpace(column)
+		if column != "" {
+			options.Columns = append(options.Columns, column)
+		}
+	}
+
+	for _, filter := range c.StringSlice("filter") {
+		var tableFilter TableFilter
+		tableFilter, err = newTableFilter(filter)
+		if err != nil {
+			return
+		}
+		options.Filters = append(options.Filters, tableFilter)
+	}
+	return
+}
+
+func nonInteractiveFromEnv() bool {
+	nonInteractive, _ := strconv.ParseBool(os.Getenv("CF_NON_INTERACTIVE"))
+	return nonInteractive
+}
+
+func newTableFilter(filter string) (tableFilter TableFilter, err error) {
+	parts := strings.SplitN(filter, "=", 2)
+	if len(parts) != 2 || strings.TrimSpace(parts[0]) == "" {
+		err = errors.New(fmt.Sprintf("Invalid filter %s, expected COLUMN=PATTERN", filter))
+		return
+	}
+
+	tableFilter.Column = strings.TrimSpace(parts[0])
+	tableFilter.Pattern, err = glob.CompileGlob(parts[1])
+	if err != nil {
+		err = errors.New(fmt.Sprintf("Invalid filter %s: %s", filter, err.Error()))
+	}
+	return
+}
+
+func (options OutputOptions) IsStructured() bool {
+	return options.Format == OutputJSON || options.Format == OutputYAML || options.Format == OutputTemplate
+}
+
+// FormatValue renders value as JSON, YAML or with the template of the
+// options. JSON and YAML both use the json tags of the value, so the two
+// formats share a single schema. Commands print dedicated output structs
+// rather than models, keeping that schema stable and internal fields out.
+func FormatValue(options OutputOptions, value interface{}) (output string, err error) {
+	if options.Format == OutputTemplate {
+		return formatTemplate(options.Template, value)
+	}
+
+	jsonBytes, err := json.MarshalIndent(value, "", "  ")
+	if err != nil {
+		return
+	}
+
+	if options.Format != OutputYAML {
+		output = string(jsonBytes)
+		return
+	}
+
+	var generic interface{}
+	decoder := json.NewDecoder(bytes.NewReader(jsonBytes))
+	decoder.UseNumber()
+	err = decoder.Decode(&generic)
+	if err != nil {
+		return
+	}
+
+	output = encodeYAML(generic)
+	return
+}
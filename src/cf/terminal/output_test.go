@@ -0,0 +1,125 @@
+package terminal_test
+
+import (
+	. "cf/terminal"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"os"
+	testassert "testhelpers/assert"
+)
+
+type outputTestModel struct {
+	Name      string
+	Instances int
+	Enabled   bool
+	Urls      []string
+	Env       map[string]string
+	Parent    *outputTestModel
+}
+
+var _ = Describe("structured output", func() {
+	var model outputTestModel
+
+	BeforeEach(func() {
+		model = outputTestModel{
+			Name:      "my-app",
+			Instances: 2,
+			Enabled:   true,
+			Urls:      []string{"my-app.example.com", "yes"},
+			Env:       map[string]string{"PORT": "8080", "GREETING": "hello: world"},
+		}
+	})
+
+	It("formats values as indented JSON using the model field names", func() {
+		output, err := FormatValue(OutputOptions{Format: OutputJSON}, []outputTestModel{model})
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(output).To(ContainSubstring(`"Name": "my-app"`))
+		Expect(output).To(ContainSubstring(`"Instances": 2`))
+		Expect(output).To(ContainSubstring(`"Parent": null`))
+	})
+
+	It("formats values as YAML with the same keys as JSON", func() {
+		output, err := FormatValue(OutputOptions{Format: OutputYAML}, []outputTestModel{model})
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(output).To(Equal(`- Enabled: true
+  Env:
+    GREETING: "hello: world"
+    PORT: "8080"
+  Instances: 2
+  Name: my-app
+  Parent: null
+  Urls:
+    - my-app.example.com
+    - "yes"`))
+	})
+
+	It("formats empty lists as empty collections", func() {
+		output, err := FormatValue(OutputOptions{Format: OutputYAML}, []outputTestModel{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(output).To(Equal("[]"))
+
+		output, err = FormatValue(OutputOptions{Format: OutputJSON}, []outputTestModel{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(output).To(Equal("[]"))
+	})
+
+	It("executes templates once for each element of a list", func() {
+		other := model
+		other.Name = "other-app"
+
+		output, err := FormatValue(OutputOptions{Format: OutputTemplate, Template: "{{.Name}} {{.Instances}}"}, []outputTestModel{model, other})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(output).To(Equal("my-app 2\nother-app 2"))
+	})
+
+	It("provides byteSize, join and color helpers to templates", func() {
+		os.Setenv("CF_COLOR", "false")
+
+		template := `{{byteSize .Instances "M"}} {{byteSize 1024}} {{join .Urls ", "}} {{color "red" .Name}}`
+		output, err := FormatValue(OutputOptions{Format: OutputTemplate, Template: template}, model)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(output).To(Equal("2M 1K my-app.example.com, yes my-app"))
+	})
+
+	It("returns an error for invalid templates", func() {
+		_, err := FormatValue(OutputOptions{Format: OutputTemplate, Template: "{{.Name"}, model)
+		Expect(err).To(HaveOccurred())
+
+		_, err = FormatValue(OutputOptions{Format: OutputTemplate, Template: `{{color "plaid" .Name}}`}, model)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("prints the table models instead of the rows", func() {
+		ui := NewUI(os.Stdin)
+		ui.SetOutputOptions(OutputOptions{Format: OutputJSON})
+
+		output := captureOutput(func() {
+			table := ui.Table([]string{"name", "instances"})
+			table.Add(model, model.Name, "2")
+			table.Print()
+		})
+
+		testassert.SliceContains(output, testassert.Lines{
+			{"["},
+			{`"Name": "my-app"`},
+			{"]"},
+		})
+		for _, line := range output {
+			Expect(line).NotTo(ContainSubstring("instances"))
+		}
+	})
+
+	It("keeps messages out of stdout in structured output", func() {
+		ui := NewUI(os.Stdin)
+		ui.SetOutputOptions(OutputOptions{Format: OutputYAML})
+
+		output := captureOutput(func() {
+			ui.Say("Getting apps...")
+			ui.Ok()
+		})
+
+		Expect(output).To(Equal([]string{""}))
+	})
+})
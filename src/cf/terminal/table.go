@@ -1,12 +1,19 @@
 package terminal
 
 import (
+	"errors"
 	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
 	"strings"
 )
 
+// Table collects rows together with the models they were built from, so that
+// the models can be printed instead when a structured output format is used.
 type Table interface {
-	Print(rows [][]string)
+	Add(model interface{}, row ...string)
+	Print() (err error)
 }
 
 type PrintableTable struct {
@@ -14,44 +21,201 @@ type PrintableTable struct {
 	header        []string
 	headerPrinted bool
 	maxSizes      []int
+	widths        []int
+	rows          [][]string
+	models        []interface{}
 }
 
 func NewTable(ui UI, header []string) Table {
 	return &PrintableTable{
-		ui:       ui,
-		header:   header,
-		maxSizes: make([]int, len(header)),
+		ui:     ui,
+		header: header,
+		models: []interface{}{},
 	}
 }
 
-func (t *PrintableTable) Print(rows [][]string) {
-	for _, row := range append(rows, t.header) {
+func (t *PrintableTable) Add(model interface{}, row ...string) {
+	t.rows = append(t.rows, row)
+	t.models = append(t.models, model)
+}
+
+// Print prints the rows added since the last call, filtered and sorted as
+// requested by the output options. Structured output is printed as a whole,
+// so commands using it should call Print only once.
+func (t *PrintableTable) Print() (err error) {
+	options := t.ui.OutputOptions()
+
+	rows, models, err := t.selectRows(options)
+	if err != nil {
+		return
+	}
+
+	if options.IsStructured() {
+		return t.ui.PrintValue(models)
+	}
+
+	t.rows = nil
+	t.models = []interface{}{}
+
+	columns, err := t.selectColumns(options)
+	if err != nil {
+		return
+	}
+
+	if len(rows) == 0 {
+		return
+	}
+
+	header := projectRow(t.header, columns)
+	rows = projectRows(rows, columns)
+
+	if t.maxSizes == nil {
+		t.maxSizes = make([]int, len(header))
+	}
+
+	for _, row := range append(rows, header) {
 		t.calculateMaxSize(row)
 	}
+	t.widths = fitColumns(t.maxSizes, options.Width)
 
-	if t.headerPrinted == false {
-		t.printHeader()
+	if t.headerPrinted == false && !options.NoHeaders {
+		t.printHeader(header)
 		t.headerPrinted = true
 	}
 
 	for _, line := range rows {
 		t.printRow(line)
 	}
+	return
+}
+
+func (t *PrintableTable) selectRows(options OutputOptions) (rows [][]string, models []interface{}, err error) {
+	indexes := []int{}
+
+	for index, row := range t.rows {
+		var matches bool
+		matches, err = t.matchesFilters(row, options.Filters)
+		if err != nil {
+			return
+		}
+		if matches {
+			indexes = append(indexes, index)
+		}
+	}
+
+	if options.SortBy != "" {
+		var column int
+		column, err = t.columnIndex(options.SortBy)
+		if err != nil {
+			return
+		}
+
+		sort.Stable(rowsByColumn{rows: t.rows, indexes: indexes, column: column})
+	}
+
+	models = []interface{}{}
+	for _, index := range indexes {
+		rows = append(rows, t.rows[index])
+		models = append(models, t.models[index])
+	}
+	return
+}
+
+func (t *PrintableTable) matchesFilters(row []string, filters []TableFilter) (matches bool, err error) {
+	for _, filter := range filters {
+		var column int
+		column, err = t.columnIndex(filter.Column)
+		if err != nil {
+			return
+		}
+
+		if !filter.Pattern.Match(decolorize(cell(row, column))) {
+			return
+		}
+	}
+
+	matches = true
+	return
+}
+
+func (t *PrintableTable) selectColumns(options OutputOptions) (columns []int, err error) {
+	if len(options.Columns) == 0 {
+		for index := range t.header {
+			columns = append(columns, index)
+		}
+		return
+	}
+
+	for _, name := range options.Columns {
+		var column int
+		column, err = t.columnIndex(name)
+		if err != nil {
+			return
+		}
+		columns = append(columns, column)
+	}
+	return
+}
+
+// columns are matched ignoring case and surrounding space, and with dashes or
+// underscores standing in for spaces, so that "requested-state" can be used
+func (t *PrintableTable) columnIndex(name string) (index int, err error) {
+	for index, header := range t.header {
+		if normalizeColumnName(header) == normalizeColumnName(name) {
+			return index, nil
+		}
+	}
+
+	names := []string{}
+	for _, header := range t.header {
+		if strings.TrimSpace(header) != "" {
+			names = append(names, strings.TrimSpace(header))
+		}
+	}
+
+	err = errors.New(fmt.Sprintf("Unknown column %s, expected one of: %s", name, strings.Join(names, ", ")))
+	return
+}
+
+func normalizeColumnName(name string) string {
+	name = strings.ToLower(strings.TrimSpace(name))
+	return strings.NewReplacer("-", " ", "_", " ").Replace(name)
+}
+
+func projectRows(rows [][]string, columns []int) (projected [][]string) {
+	for _, row := range rows {
+		projected = append(projected, projectRow(row, columns))
+	}
+	return
+}
+
+func projectRow(row []string, columns []int) (projected []string) {
+	for _, column := range columns {
+		projected = append(projected, cell(row, column))
+	}
+	return
+}
+
+func cell(row []string, column int) string {
+	if column < len(row) {
+		return row[column]
+	}
+	return ""
 }
 
 func (t *PrintableTable) calculateMaxSize(row []string) {
 	for index, value := range row {
-		cellLength := len(decolorize(value))
+		cellLength := displayWidth(decolorize(value))
 		if t.maxSizes[index] < cellLength {
 			t.maxSizes[index] = cellLength
 		}
 	}
 }
 
-func (t *PrintableTable) printHeader() {
+func (t *PrintableTable) printHeader(header []string) {
 	output := ""
-	for col, value := range t.header {
-		output = output + t.cellValue(col, HeaderColor(value))
+	for col, value := range header {
+		output = output + t.cellValue(col, HeaderColor(truncate(value, t.widths[col])))
 	}
 	t.ui.Say(output)
 }
@@ -59,6 +223,7 @@ func (t *PrintableTable) printHeader() {
 func (t *PrintableTable) printRow(row []string) {
 	output := ""
 	for col, value := range row {
+		value = truncate(value, t.widths[col])
 		if col == 0 {
 			value = TableContentHeaderColor(value)
 		}
@@ -70,8 +235,124 @@ func (t *PrintableTable) printRow(row []string) {
 
 func (t *PrintableTable) cellValue(col int, value string) string {
 	padding := ""
-	if col < len(t.header)-1 {
-		padding = strings.Repeat(" ", t.maxSizes[col]-len(decolorize(value)))
+	if col < len(t.widths)-1 {
+		padding = strings.Repeat(" ", t.widths[col]-displayWidth(decolorize(value)))
+	}
+	return fmt.Sprintf("%s%s%s", value, padding, columnSeparator)
+}
+
+const (
+	columnSeparator = "   "
+	minColumnWidth  = 8
+	ellipsis        = "…"
+)
+
+// fitColumns narrows the widest columns one character at a time until a row
+// fits in the terminal. A width of 0 means stdout isn't a terminal, in which
+// case rows are never shortened so that they can be parsed by other tools.
+func fitColumns(sizes []int, width int) []int {
+	widths := make([]int, len(sizes))
+	copy(widths, sizes)
+
+	if width <= 0 {
+		return widths
+	}
+
+	for rowWidth(widths) > width {
+		widest := 0
+		for col := range widths {
+			if widths[col] > widths[widest] {
+				widest = col
+			}
+		}
+
+		if widths[widest] <= minColumnWidth {
+			break
+		}
+		widths[widest]--
 	}
-	return fmt.Sprintf("%s%s   ", value, padding)
+
+	return widths
+}
+
+func rowWidth(widths []int) (total int) {
+	for _, width := range widths {
+		total += width + len(columnSeparator)
+	}
+	return
+}
+
+// truncate shortens cells wider than their column, ending them with an
+// ellipsis. Colors are dropped from shortened cells, since the escape codes
+// can't be cut in half.
+func truncate(value string, width int) string {
+	plain := decolorize(value)
+	if displayWidth(plain) <= width {
+		return value
+	}
+
+	truncated := ""
+	truncatedWidth := 0
+	for _, r := range plain {
+		if truncatedWidth+runeWidth(r) > width-displayWidth(ellipsis) {
+			break
+		}
+		truncated += string(r)
+		truncatedWidth += runeWidth(r)
+	}
+
+	return truncated + ellipsis
+}
+
+var sizePattern = regexp.MustCompile(`(?i)^(\d+(\.\d+)?)\s*([KMGT]?)B?$`)
+
+var sizeUnits = map[string]float64{
+	"":  1,
+	"K": 1 << 10,
+	"M": 1 << 20,
+	"G": 1 << 30,
+	"T": 1 << 40,
+}
+
+// numbers and byte sizes such as "512M" or "1.5G" are compared by value, so
+// that sorting by memory puts 512M before 1G
+func sortValue(value string) (number float64, ok bool) {
+	parts := sizePattern.FindStringSubmatch(strings.TrimSpace(value))
+	if parts == nil {
+		return
+	}
+
+	number, err := strconv.ParseFloat(parts[1], 64)
+	if err != nil {
+		return
+	}
+
+	return number * sizeUnits[strings.ToUpper(parts[3])], true
+}
+
+type rowsByColumn struct {
+	rows    [][]string
+	indexes []int
+	column  int
+}
+
+func (s rowsByColumn) Len() int {
+	return len(s.indexes)
+}
+
+func (s rowsByColumn) Swap(i, j int) {
+	s.indexes[i], s.indexes[j] = s.indexes[j], s.indexes[i]
+}
+
+func (s rowsByColumn) Less(i, j int) bool {
+	a := decolorize(cell(s.rows[s.indexes[i]], s.column))
+	b := decolorize(cell(s.rows[s.indexes[j]], s.column))
+
+	aNumber, aOk := sortValue(a)
+	bNumber, bOk := sortValue(b)
+	if aOk && bOk {
+		return aNumber < bNumber
+	}
+
+	return strings.ToLower(a) < strings.ToLower(b)
 }
@@ -0,0 +1,129 @@
+package terminal_test
+
+import (
+	. "cf/terminal"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"glob"
+	testassert "testhelpers/assert"
+	testterm "testhelpers/terminal"
+)
+
+func printTestTable(ui *testterm.FakeUI) error {
+	table := NewTable(ui, []string{"name", "requested state", "memory"})
+	table.Add("app-b", "app-b", "started", "1G")
+	table.Add("app-a", "app-a", "stopped", "512M")
+	table.Add("app-c", "app-c", "started", "1.5G")
+	return table.Print()
+}
+
+func filterFor(column, pattern string) TableFilter {
+	compiled, err := glob.CompileGlob(pattern)
+	Expect(err).NotTo(HaveOccurred())
+	return TableFilter{Column: column, Pattern: compiled}
+}
+
+var _ = Describe("tables", func() {
+	var ui *testterm.FakeUI
+
+	BeforeEach(func() {
+		ui = &testterm.FakeUI{}
+	})
+
+	It("prints rows in the order they were added", func() {
+		printTestTable(ui)
+
+		Expect(len(ui.Outputs)).To(Equal(4))
+		testassert.SliceContains(ui.Outputs, testassert.Lines{
+			{"name", "requested state", "memory"},
+			{"app-b", "started", "1G"},
+			{"app-a", "stopped", "512M"},
+			{"app-c", "started", "1.5G"},
+		})
+	})
+
+	It("sorts sizes by value", func() {
+		ui.SetOutputOptions(OutputOptions{Format: OutputText, SortBy: "memory"})
+		printTestTable(ui)
+
+		testassert.SliceContains(ui.Outputs, testassert.Lines{
+			{"app-a"},
+			{"app-b"},
+			{"app-c"},
+		})
+	})
+
+	It("matches column names ignoring case, dashes and underscores", func() {
+		ui.SetOutputOptions(OutputOptions{Format: OutputText, SortBy: "Requested-State", Columns: []string{"name", "requested_state"}})
+		printTestTable(ui)
+
+		Expect(ui.Outputs[0]).NotTo(ContainSubstring("memory"))
+		testassert.SliceContains(ui.Outputs, testassert.Lines{
+			{"app-b", "started"},
+			{"app-c", "started"},
+			{"app-a", "stopped"},
+		})
+		for _, line := range ui.Outputs {
+			Expect(line).NotTo(ContainSubstring("G"))
+		}
+	})
+
+	It("filters rows with globs and omits the header", func() {
+		ui.SetOutputOptions(OutputOptions{
+			Format:    OutputText,
+			Filters:   []TableFilter{filterFor("requested state", "start*")},
+			NoHeaders: true,
+		})
+		printTestTable(ui)
+
+		Expect(len(ui.Outputs)).To(Equal(2))
+		testassert.SliceContains(ui.Outputs, testassert.Lines{
+			{"app-b"},
+			{"app-c"},
+		})
+	})
+
+	It("filters and sorts the models in structured output", func() {
+		ui.SetOutputOptions(OutputOptions{
+			Format:  OutputJSON,
+			SortBy:  "name",
+			Filters: []TableFilter{filterFor("requested state", "started")},
+		})
+		printTestTable(ui)
+
+		Expect(ui.Outputs).To(Equal([]string{"[", `  "app-b",`, `  "app-c"`, "]"}))
+	})
+
+	It("pads columns by display width", func() {
+		table := NewTable(ui, []string{"name", "state"})
+		table.Add(nil, "日本語", "started")
+		table.Add(nil, "app", "stopped")
+		table.Print()
+
+		Expect(ui.Outputs).To(Equal([]string{
+			"name     state   ",
+			"日本語   started   ",
+			"app      stopped   ",
+		}))
+	})
+
+	It("shrinks the widest column to fit the terminal", func() {
+		ui.SetOutputOptions(OutputOptions{Format: OutputText, Width: 30})
+		table := NewTable(ui, []string{"name", "urls"})
+		table.Add(nil, "my-app", "my-app.example.com, my-app.other-domain.com")
+		table.Print()
+
+		Expect(ui.Outputs).To(Equal([]string{
+			"name     urls   ",
+			"my-app   my-app.example.co…   ",
+		}))
+	})
+
+	It("fails for unknown columns", func() {
+		ui.SetOutputOptions(OutputOptions{Format: OutputText, Columns: []string{"instances"}})
+
+		err := printTestTable(ui)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("Unknown column instances"))
+	})
+})
@@ -0,0 +1,116 @@
+package terminal
+
+import (
+	"bytes"
+	"cf/formatters"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"text/template"
+)
+
+var templateFuncs = template.FuncMap{
+	"byteSize": templateByteSize,
+	"join":     templateJoin,
+	"color":    templateColor,
+}
+
+var templateColors = map[string]Color{
+	"red":     red,
+	"green":   green,
+	"yellow":  yellow,
+	"magenta": magenta,
+	"cyan":    cyan,
+	"grey":    grey,
+	"white":   white,
+}
+
+var byteSizeUnits = map[string]uint64{
+	"B": formatters.BYTE,
+	"K": formatters.KILOBYTE,
+	"M": formatters.MEGABYTE,
+	"G": formatters.GIGABYTE,
+	"T": formatters.TERABYTE,
+}
+
+// formatTemplate executes the template once per element when value is a
+// slice, printing one line for each, and once otherwise.
+func formatTemplate(text string, value interface{}) (output string, err error) {
+	tmpl, err := template.New("format").Funcs(templateFuncs).Parse(text)
+	if err != nil {
+		return
+	}
+
+	items := []interface{}{value}
+	values := reflect.ValueOf(value)
+	if values.Kind() == reflect.Slice {
+		items = []interface{}{}
+		for i := 0; i < values.Len(); i++ {
+			items = append(items, values.Index(i).Interface())
+		}
+	}
+
+	lines := []string{}
+	for _, item := range items {
+		buffer := new(bytes.Buffer)
+		err = tmpl.Execute(buffer, item)
+		if err != nil {
+			return
+		}
+		lines = append(lines, buffer.String())
+	}
+
+	output = strings.Join(lines, "\n")
+	return
+}
+
+// byteSize formats a size in bytes, or in the given unit (B, K, M, G or T)
+// for fields such as Memory that the models keep in megabytes
+func templateByteSize(value interface{}, unit ...string) (string, error) {
+	var size uint64
+
+	reflected := reflect.ValueOf(value)
+	switch reflected.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if reflected.Int() < 0 {
+			return "", errors.New(fmt.Sprintf("byteSize: negative size %d", reflected.Int()))
+		}
+		size = uint64(reflected.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		size = reflected.Uint()
+	default:
+		return "", errors.New(fmt.Sprintf("byteSize: %v is not a number", value))
+	}
+
+	if len(unit) > 0 {
+		multiplier, ok := byteSizeUnits[strings.ToUpper(unit[0])]
+		if !ok {
+			return "", errors.New(fmt.Sprintf("byteSize: unknown unit %s", unit[0]))
+		}
+		size = size * multiplier
+	}
+
+	return formatters.ByteSize(size), nil
+}
+
+func templateJoin(values interface{}, separator string) (string, error) {
+	reflected := reflect.ValueOf(values)
+	if reflected.Kind() != reflect.Slice && reflected.Kind() != reflect.Array {
+		return "", errors.New(fmt.Sprintf("join: %v is not a list", values))
+	}
+
+	items := []string{}
+	for i := 0; i < reflected.Len(); i++ {
+		items = append(items, fmt.Sprint(reflected.Index(i).Interface()))
+	}
+	return strings.Join(items, separator), nil
+}
+
+func templateColor(name string, value interface{}) (string, error) {
+	color, ok := templateColors[strings.ToLower(name)]
+	if !ok {
+		return "", errors.New(fmt.Sprintf("color: unknown color %s", name))
+	}
+	return Colorize(fmt.Sprint(value), color, false), nil
+}
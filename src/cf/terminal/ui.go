@@ -4,6 +4,7 @@ import (
 	"cf"
 	"cf/configuration"
 	"cf/trace"
+	"errors"
 	"fmt"
 	"github.com/codegangsta/cli"
 	"io"
@@ -25,23 +26,74 @@ type UI interface {
 	Ask(prompt string, args ...interface{}) (answer string)
 	AskForPassword(prompt string, args ...interface{}) (answer string)
 	Confirm(message string, args ...interface{}) bool
+	IsInteractive() bool
 	Ok()
 	Failed(message string, args ...interface{})
 	FailWithUsage(ctxt *cli.Context, cmdName string)
 	ConfigFailure(err error)
 	ShowConfiguration(configuration.Reader)
 	LoadingIndication()
-	Wait(duration time.Duration)
+	Wait(duration time.Duration) (interrupted bool)
 	DisplayTable(table [][]string)
 	Table(headers []string) Table
+	SetOutputOptions(options OutputOptions)
+	OutputOptions() OutputOptions
+	PrintValue(value interface{}) (err error)
 }
 
 type terminalUI struct {
-	stdin io.Reader
+	stdin           io.Reader
+	stdinIsTerminal bool
+	outputOptions   OutputOptions
+	loading         *loadingIndication
+}
+
+var loadingFrames = []string{"|", "/", "-", "\\"}
+
+// loadingIndication is what LoadingIndication last drew, a spinner frame on
+// terminals and dots otherwise, which the next message replaces
+type loadingIndication struct {
+	frame int
+	drawn string
 }
 
 func NewUI(r io.Reader) UI {
-	return terminalUI{stdin: r}
+	// readers other than files, such as the ones used in tests, are treated
+	// like a terminal
+	stdinIsTerminal := true
+	if file, ok := r.(*os.File); ok {
+		stdinIsTerminal = isTerminal(file)
+	}
+	return &terminalUI{stdin: r, stdinIsTerminal: stdinIsTerminal, loading: new(loadingIndication)}
+}
+
+func (c *terminalUI) SetOutputOptions(options OutputOptions) {
+	c.outputOptions = options
+}
+
+func (c terminalUI) OutputOptions() OutputOptions {
+	return c.outputOptions
+}
+
+// messages are kept out of stdout when it carries structured output
+func (c terminalUI) messageWriter() io.Writer {
+	if c.outputOptions.IsStructured() {
+		return os.Stderr
+	}
+	return os.Stdout
+}
+
+func (c terminalUI) PrintValue(value interface{}) (err error) {
+	output, err := FormatValue(c.outputOptions, value)
+	if err != nil {
+		err = errors.New("Error formatting output\n" + err.Error())
+		return
+	}
+
+	if output != "" {
+		fmt.Println(output)
+	}
+	return
 }
 
 func (c terminalUI) PrintPaginator(rows []string, err error) {
@@ -56,7 +108,8 @@ func (c terminalUI) PrintPaginator(rows []string, err error) {
 }
 
 func (c terminalUI) Say(message string, args ...interface{}) {
-	fmt.Printf(message+"\n", args...)
+	c.endLoadingIndication()
+	fmt.Fprintf(c.messageWriter(), message+"\n", args...)
 	return
 }
 
@@ -66,6 +119,12 @@ func (c terminalUI) Warn(message string, args ...interface{}) {
 	return
 }
 
+// IsInteractive tells if prompts can be answered. Commands check it before
+// prompting, so that they can name the flag that supplies the answer instead.
+func (c terminalUI) IsInteractive() bool {
+	return c.stdinIsTerminal && !c.outputOptions.NonInteractive
+}
+
 func (c terminalUI) Confirm(message string, args ...interface{}) bool {
 	response := c.Ask(message, args...)
 	switch strings.ToLower(response) {
@@ -76,8 +135,13 @@ func (c terminalUI) Confirm(message string, args ...interface{}) bool {
 }
 
 func (c terminalUI) Ask(prompt string, args ...interface{}) (answer string) {
-	fmt.Println("")
-	fmt.Printf(prompt+" ", args...)
+	if !c.IsInteractive() {
+		return
+	}
+
+	c.endLoadingIndication()
+	fmt.Fprintln(c.messageWriter(), "")
+	fmt.Fprintf(c.messageWriter(), prompt+" ", args...)
 	fmt.Fscanln(c.stdin, &answer)
 	return
 }
@@ -93,7 +157,6 @@ func (c terminalUI) Failed(message string, args ...interface{}) {
 
 	trace.Logger.Print("FAILED")
 	trace.Logger.Print(message)
-	os.Exit(1)
 }
 
 func (c terminalUI) FailWithUsage(ctxt *cli.Context, cmdName string) {
@@ -101,7 +164,6 @@ func (c terminalUI) FailWithUsage(ctxt *cli.Context, cmdName string) {
 	c.Say("Incorrect Usage.\n")
 	cli.ShowCommandHelp(ctxt, cmdName)
 	c.Say("")
-	os.Exit(1)
 }
 
 func (c terminalUI) ConfigFailure(err error) {
@@ -109,6 +171,10 @@ func (c terminalUI) ConfigFailure(err error) {
 }
 
 func (ui terminalUI) ShowConfiguration(config configuration.Reader) {
+	if config.ProfileName() != configuration.DefaultProfileName {
+		ui.Say("Profile:      %s", EntityNameColor(config.ProfileName()))
+	}
+
 	ui.Say("API endpoint: %s (API version: %s)",
 		EntityNameColor(config.ApiEndpoint()),
 		EntityNameColor(config.ApiVersion()))
@@ -141,14 +207,40 @@ func (ui terminalUI) ShowConfiguration(config configuration.Reader) {
 }
 
 func (c terminalUI) LoadingIndication() {
-	fmt.Print(".")
+	writer := c.messageWriter()
+	if file, ok := writer.(*os.File); !ok || !isTerminal(file) {
+		fmt.Fprint(writer, ".")
+		c.loading.drawn = "."
+		return
+	}
+
+	if c.loading.drawn != "" {
+		fmt.Fprint(writer, "\b")
+	}
+	c.loading.drawn = loadingFrames[c.loading.frame%len(loadingFrames)]
+	c.loading.frame++
+	fmt.Fprint(writer, c.loading.drawn)
+}
+
+func (c terminalUI) endLoadingIndication() {
+	switch c.loading.drawn {
+	case "":
+		return
+	case ".":
+		fmt.Fprintln(c.messageWriter(), "")
+	default:
+		fmt.Fprint(c.messageWriter(), "\b \b")
+	}
+	*c.loading = loadingIndication{}
 }
 
-func (c terminalUI) Wait(duration time.Duration) {
-	time.Sleep(duration)
+// Wait sleeps between polls of the platform, telling whether Ctrl-C
+// interrupted it, see CatchInterrupts
+func (c terminalUI) Wait(duration time.Duration) (interrupted bool) {
+	return waitForInterrupt(duration)
 }
 
-func (ui terminalUI) Table(headers []string) Table {
+func (ui *terminalUI) Table(headers []string) Table {
 	return NewTable(ui, headers)
 }
 
@@ -159,7 +251,7 @@ func (ui terminalUI) DisplayTable(table [][]string) {
 
 	for _, line := range table {
 		for index, value := range line {
-			cellLength := len(decolorize(value))
+			cellLength := displayWidth(decolorize(value))
 			if maxSizes[index] < cellLength {
 				maxSizes[index] = cellLength
 			}
@@ -168,7 +260,7 @@ func (ui terminalUI) DisplayTable(table [][]string) {
 
 	for row, line := range table {
 		for col, value := range line {
-			padding := strings.Repeat(" ", maxSizes[col]-len(decolorize(value)))
+			padding := strings.Repeat(" ", maxSizes[col]-displayWidth(decolorize(value)))
 			value = tableColoringFunc(value, row, col)
 			fmt.Printf("%s%s   ", value, padding)
 		}
@@ -63,6 +63,20 @@ var _ = Describe("UI", func() {
 		})
 	})
 
+	It("ends the loading indication before the next message", func() {
+		simulateStdin("", func(reader io.Reader) {
+			output := captureOutput(func() {
+				ui := NewUI(reader)
+				ui.Say("Waiting")
+				ui.LoadingIndication()
+				ui.LoadingIndication()
+				ui.Say("Done")
+			})
+
+			Expect(output).To(Equal([]string{"Waiting", "..", "Done", ""}))
+		})
+	})
+
 	It("TestConfirmYes", func() {
 		simulateStdin("y\n", func(reader io.Reader) {
 			out := captureOutput(func() {
@@ -83,6 +97,29 @@ var _ = Describe("UI", func() {
 		})
 	})
 
+	It("does not prompt in non-interactive mode", func() {
+		simulateStdin("y\n", func(reader io.Reader) {
+			out := captureOutput(func() {
+				ui := NewUI(reader)
+				Expect(ui.IsInteractive()).To(BeTrue())
+
+				ui.SetOutputOptions(OutputOptions{NonInteractive: true})
+				Expect(ui.IsInteractive()).To(BeFalse())
+				Expect(ui.Confirm("Hello %s", "World?")).To(BeFalse())
+			})
+
+			testassert.SliceDoesNotContain(out, testassert.Lines{{"Hello World?"}})
+		})
+	})
+
+	It("is not interactive when stdin is not a terminal", func() {
+		file, err := os.Open(os.DevNull)
+		Expect(err).NotTo(HaveOccurred())
+		defer file.Close()
+
+		Expect(NewUI(file).IsInteractive()).To(BeFalse())
+	})
+
 	It("TestShowConfigurationWhenNoOrgAndSpaceTargeted", func() {
 		config := testconfig.NewRepository()
 		output := captureOutput(func() {
@@ -27,11 +27,15 @@ var sttyArgvEOn []string = []string{"stty", "echo"}
 var ws syscall.WaitStatus = 0
 
 func (ui terminalUI) AskForPassword(prompt string, args ...interface{}) (passwd string) {
+	if !ui.IsInteractive() {
+		return
+	}
+
 	sig := make(chan os.Signal, 10)
 
 	// Display the prompt.
-	fmt.Println("")
-	fmt.Printf(prompt+" ", args...)
+	fmt.Fprintln(ui.messageWriter(), "")
+	fmt.Fprintf(ui.messageWriter(), prompt+" ", args...)
 
 	// File descriptors for stdin, stdout, and stderr.
 	fd := []uintptr{os.Stdin.Fd(), os.Stdout.Fd(), os.Stderr.Fd()}
@@ -53,7 +57,7 @@ func (ui terminalUI) AskForPassword(prompt string, args ...interface{}) (passwd
 	passwd = readPassword(pid)
 
 	// Carraige return after the user input.
-	fmt.Println("")
+	fmt.Fprintln(ui.messageWriter(), "")
 
 	return
 }
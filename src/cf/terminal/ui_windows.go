@@ -12,6 +12,10 @@ import (
 const ENABLE_ECHO_INPUT = 0x0004
 
 func (ui terminalUI) AskForPassword(prompt string, args ...interface{}) (passwd string) {
+	if !ui.IsInteractive() {
+		return
+	}
+
 	hStdin := syscall.Handle(os.Stdin.Fd())
 	var originalMode uint32
 
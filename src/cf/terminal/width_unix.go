@@ -0,0 +1,42 @@
+// +build darwin freebsd linux netbsd openbsd
+
+package terminal
+
+import (
+	"os"
+	"syscall"
+	"unsafe"
+)
+
+type winsize struct {
+	rows    uint16
+	cols    uint16
+	xpixels uint16
+	ypixels uint16
+}
+
+// TerminalWidth returns the number of columns of the terminal on stdout, or 0
+// when stdout is redirected
+func TerminalWidth() int {
+	size, ok := windowSize(os.Stdout)
+	if !ok {
+		return 0
+	}
+	return int(size.cols)
+}
+
+func isTerminal(file *os.File) bool {
+	_, ok := windowSize(file)
+	return ok
+}
+
+func windowSize(file *os.File) (size winsize, ok bool) {
+	_, _, errno := syscall.Syscall(
+		syscall.SYS_IOCTL,
+		file.Fd(),
+		uintptr(syscall.TIOCGWINSZ),
+		uintptr(unsafe.Pointer(&size)),
+	)
+	ok = errno == 0
+	return
+}
@@ -0,0 +1,18 @@
+// +build windows
+
+package terminal
+
+import (
+	"os"
+	"syscall"
+)
+
+// TerminalWidth returns 0, so tables are printed at full width on Windows
+func TerminalWidth() int {
+	return 0
+}
+
+func isTerminal(file *os.File) bool {
+	var mode uint32
+	return syscall.GetConsoleMode(syscall.Handle(file.Fd()), &mode) == nil
+}
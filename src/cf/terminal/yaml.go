@@ -0,0 +1,133 @@
+package terminal
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+var plainYAMLScalar = regexp.MustCompile(`^[A-Za-z_/][A-Za-z0-9_ ./@+-]*$`)
+
+var reservedYAMLScalars = map[string]bool{
+	"true": true, "false": true, "yes": true, "no": true, "on": true, "off": true,
+	"y": true, "n": true, "null": true, "~": true,
+}
+
+// encodeYAML writes values decoded from JSON (maps, slices, strings,
+// json.Number, bools and nil) as block-style YAML with sorted keys.
+func encodeYAML(value interface{}) string {
+	buffer := new(bytes.Buffer)
+
+	if isYAMLScalar(value) {
+		buffer.WriteString(yamlScalar(value))
+	} else {
+		writeYAML(buffer, value, 0)
+	}
+
+	return strings.TrimSuffix(buffer.String(), "\n")
+}
+
+func writeYAML(buffer *bytes.Buffer, value interface{}, indent int) {
+	prefix := strings.Repeat(" ", indent)
+
+	switch value := value.(type) {
+	case map[string]interface{}:
+		if len(value) == 0 {
+			buffer.WriteString(prefix + emptyYAMLCollection(value) + "\n")
+			return
+		}
+
+		keys := []string{}
+		for key := range value {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		for _, key := range keys {
+			buffer.WriteString(prefix + yamlString(key) + ":")
+			writeYAMLChild(buffer, value[key], indent+2)
+		}
+	case []interface{}:
+		if len(value) == 0 {
+			buffer.WriteString(prefix + emptyYAMLCollection(value) + "\n")
+			return
+		}
+
+		for _, item := range value {
+			buffer.WriteString(prefix + "-")
+			if isYAMLScalar(item) || isEmptyYAMLCollection(item) {
+				writeYAMLChild(buffer, item, indent+2)
+				continue
+			}
+
+			// the first line of a nested collection goes after the dash
+			child := new(bytes.Buffer)
+			writeYAML(child, item, indent+2)
+			buffer.WriteString(" " + strings.TrimPrefix(child.String(), prefix+"  "))
+		}
+	}
+}
+
+func writeYAMLChild(buffer *bytes.Buffer, value interface{}, indent int) {
+	switch {
+	case isYAMLScalar(value):
+		buffer.WriteString(" " + yamlScalar(value) + "\n")
+	case isEmptyYAMLCollection(value):
+		buffer.WriteString(" " + emptyYAMLCollection(value) + "\n")
+	default:
+		buffer.WriteString("\n")
+		writeYAML(buffer, value, indent)
+	}
+}
+
+func emptyYAMLCollection(value interface{}) string {
+	if _, ok := value.([]interface{}); ok {
+		return "[]"
+	}
+	return "{}"
+}
+
+func isYAMLScalar(value interface{}) bool {
+	switch value.(type) {
+	case map[string]interface{}, []interface{}:
+		return false
+	}
+	return true
+}
+
+func isEmptyYAMLCollection(value interface{}) bool {
+	switch value := value.(type) {
+	case map[string]interface{}:
+		return len(value) == 0
+	case []interface{}:
+		return len(value) == 0
+	}
+	return false
+}
+
+func yamlScalar(value interface{}) string {
+	switch value := value.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return strconv.FormatBool(value)
+	case json.Number:
+		return value.String()
+	case string:
+		return yamlString(value)
+	}
+	return yamlString(fmt.Sprintf("%v", value))
+}
+
+func yamlString(value string) string {
+	if plainYAMLScalar.MatchString(value) &&
+		!strings.HasSuffix(value, " ") &&
+		!reservedYAMLScalars[strings.ToLower(value)] {
+		return value
+	}
+	return strconv.Quote(value)
+}
@@ -0,0 +1,23 @@
+package trace
+
+import (
+	"net/http"
+	"time"
+)
+
+// Exchange is a single HTTP request and its response, with any private data
+// already sanitized by the caller.
+type Exchange struct {
+	StartedAt       time.Time
+	Duration        time.Duration
+	Method          string
+	Url             string
+	HttpVersion     string
+	RequestHeaders  http.Header
+	RequestBody     string
+	StatusCode      int
+	Status          string
+	ResponseHeaders http.Header
+	ResponseBody    string
+	Error           string
+}
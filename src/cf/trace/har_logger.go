@@ -0,0 +1,239 @@
+package trace
+
+import (
+	"cf"
+	"encoding/json"
+	"fileutils"
+	"net/http"
+	"net/url"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+type harNameValue struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+type harPostData struct {
+	MimeType string `json:"mimeType"`
+	Text     string `json:"text"`
+}
+
+type harRequest struct {
+	Method      string         `json:"method"`
+	Url         string         `json:"url"`
+	HttpVersion string         `json:"httpVersion"`
+	Cookies     []harNameValue `json:"cookies"`
+	Headers     []harNameValue `json:"headers"`
+	QueryString []harNameValue `json:"queryString"`
+	PostData    *harPostData   `json:"postData,omitempty"`
+	HeadersSize int            `json:"headersSize"`
+	BodySize    int            `json:"bodySize"`
+}
+
+type harContent struct {
+	Size     int    `json:"size"`
+	MimeType string `json:"mimeType"`
+	Text     string `json:"text"`
+}
+
+type harResponse struct {
+	Status      int            `json:"status"`
+	StatusText  string         `json:"statusText"`
+	HttpVersion string         `json:"httpVersion"`
+	Cookies     []harNameValue `json:"cookies"`
+	Headers     []harNameValue `json:"headers"`
+	Content     harContent     `json:"content"`
+	RedirectUrl string         `json:"redirectURL"`
+	HeadersSize int            `json:"headersSize"`
+	BodySize    int            `json:"bodySize"`
+}
+
+type harTimings struct {
+	Send    int64 `json:"send"`
+	Wait    int64 `json:"wait"`
+	Receive int64 `json:"receive"`
+}
+
+type harEntry struct {
+	StartedDateTime string      `json:"startedDateTime"`
+	Time            int64       `json:"time"`
+	Request         harRequest  `json:"request"`
+	Response        harResponse `json:"response"`
+	Cache           struct{}    `json:"cache"`
+	Timings         harTimings  `json:"timings"`
+	Comment         string      `json:"comment,omitempty"`
+}
+
+type harCreator struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+}
+
+type harLog struct {
+	Version string     `json:"version"`
+	Creator harCreator `json:"creator"`
+	Entries []harEntry `json:"entries"`
+}
+
+type harDocument struct {
+	Log harLog `json:"log"`
+}
+
+// harLogger writes each exchange of the current invocation as it happens,
+// so the file is a complete HAR document even when the process exits
+// without any teardown. Adding an entry only rewrites the end of the file,
+// which closes the list of entries and the document.
+type harLogger struct {
+	path    string
+	file    *os.File
+	entries int
+	mutex   sync.Mutex
+}
+
+const harDocumentEnd = "\n    ]\n  }\n}\n"
+
+func newHarLogger(path string) Printer {
+	return &harLogger{path: path}
+}
+
+func (*harLogger) Print(v ...interface{})                 {}
+func (*harLogger) Printf(format string, v ...interface{}) {}
+func (*harLogger) Println(v ...interface{})               {}
+
+func (logger *harLogger) PrintExchange(exchange Exchange) {
+	logger.mutex.Lock()
+	defer logger.mutex.Unlock()
+
+	entry, err := json.MarshalIndent(newHarEntry(exchange), "      ", "  ")
+	if err != nil {
+		return
+	}
+
+	if logger.file == nil {
+		err = logger.create()
+		if err != nil {
+			return
+		}
+	}
+
+	_, err = logger.file.Seek(-int64(len(harDocumentEnd)), os.SEEK_END)
+	if err != nil {
+		return
+	}
+
+	separator := ",\n      "
+	if logger.entries == 0 {
+		separator = "\n      "
+	}
+	logger.file.WriteString(separator + string(entry) + harDocumentEnd)
+	logger.entries++
+}
+
+// create writes a document without entries, which is kept open for the
+// rest of the invocation
+func (logger *harLogger) create() (err error) {
+	document := harDocument{
+		Log: harLog{
+			Version: "1.2",
+			Creator: harCreator{Name: "cf", Version: cf.Version},
+			Entries: []harEntry{},
+		},
+	}
+
+	bytes, err := json.MarshalIndent(document, "", "  ")
+	if err != nil {
+		return
+	}
+	head := strings.TrimSuffix(string(bytes), "]\n  }\n}")
+
+	file, err := fileutils.CreateFile(logger.path)
+	if err != nil {
+		return
+	}
+
+	_, err = file.WriteString(head + harDocumentEnd)
+	if err != nil {
+		file.Close()
+		return
+	}
+
+	logger.file = file
+	return
+}
+
+func newHarEntry(exchange Exchange) (entry harEntry) {
+	elapsed := int64(exchange.Duration / time.Millisecond)
+
+	entry.StartedDateTime = exchange.StartedAt.Format(time.RFC3339Nano)
+	entry.Time = elapsed
+	entry.Timings = harTimings{Send: 0, Wait: elapsed, Receive: 0}
+	entry.Comment = exchange.Error
+
+	entry.Request = harRequest{
+		Method:      exchange.Method,
+		Url:         exchange.Url,
+		HttpVersion: exchange.HttpVersion,
+		Cookies:     []harNameValue{},
+		Headers:     harHeaders(exchange.RequestHeaders),
+		QueryString: harQueryString(exchange.Url),
+		HeadersSize: -1,
+		BodySize:    len(exchange.RequestBody),
+	}
+	if exchange.RequestBody != "" {
+		entry.Request.PostData = &harPostData{
+			MimeType: exchange.RequestHeaders.Get("Content-Type"),
+			Text:     exchange.RequestBody,
+		}
+	}
+
+	entry.Response = harResponse{
+		Status:      exchange.StatusCode,
+		StatusText:  exchange.Status,
+		HttpVersion: exchange.HttpVersion,
+		Cookies:     []harNameValue{},
+		Headers:     harHeaders(exchange.ResponseHeaders),
+		Content: harContent{
+			Size:     len(exchange.ResponseBody),
+			MimeType: exchange.ResponseHeaders.Get("Content-Type"),
+			Text:     exchange.ResponseBody,
+		},
+		RedirectUrl: exchange.ResponseHeaders.Get("Location"),
+		HeadersSize: -1,
+		BodySize:    len(exchange.ResponseBody),
+	}
+
+	return
+}
+
+func harHeaders(headers http.Header) (pairs []harNameValue) {
+	pairs = []harNameValue{}
+
+	names := []string{}
+	for name, _ := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		for _, value := range headers[name] {
+			pairs = append(pairs, harNameValue{Name: name, Value: value})
+		}
+	}
+	return
+}
+
+func harQueryString(rawUrl string) (pairs []harNameValue) {
+	pairs = []harNameValue{}
+
+	parsedUrl, err := url.Parse(rawUrl)
+	if err != nil {
+		return
+	}
+
+	return harHeaders(http.Header(parsedUrl.Query()))
+}
@@ -0,0 +1,62 @@
+package trace
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+)
+
+type jsonRecord struct {
+	StartedAt       time.Time   `json:"started_at"`
+	DurationMs      int64       `json:"duration_ms"`
+	Method          string      `json:"method"`
+	Url             string      `json:"url"`
+	RequestHeaders  http.Header `json:"request_headers"`
+	RequestBody     string      `json:"request_body"`
+	Status          int         `json:"status"`
+	ResponseHeaders http.Header `json:"response_headers"`
+	ResponseBody    string      `json:"response_body"`
+	Error           string      `json:"error,omitempty"`
+}
+
+// jsonLogger writes one JSON record per line for every HTTP exchange.
+// Free-form messages are dropped so that the output stays machine readable.
+type jsonLogger struct {
+	out   io.Writer
+	mutex sync.Mutex
+}
+
+func newJsonLogger(out io.Writer) Printer {
+	return &jsonLogger{out: out}
+}
+
+func (*jsonLogger) Print(v ...interface{})                 {}
+func (*jsonLogger) Printf(format string, v ...interface{}) {}
+func (*jsonLogger) Println(v ...interface{})               {}
+
+func (logger *jsonLogger) PrintExchange(exchange Exchange) {
+	record := jsonRecord{
+		StartedAt:       exchange.StartedAt,
+		DurationMs:      int64(exchange.Duration / time.Millisecond),
+		Method:          exchange.Method,
+		Url:             exchange.Url,
+		RequestHeaders:  exchange.RequestHeaders,
+		RequestBody:     exchange.RequestBody,
+		Status:          exchange.StatusCode,
+		ResponseHeaders: exchange.ResponseHeaders,
+		ResponseBody:    exchange.ResponseBody,
+		Error:           exchange.Error,
+	}
+
+	bytes, err := json.Marshal(record)
+	if err != nil {
+		return
+	}
+
+	logger.mutex.Lock()
+	defer logger.mutex.Unlock()
+
+	logger.out.Write(append(bytes, '\n'))
+}
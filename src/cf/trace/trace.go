@@ -7,12 +7,20 @@ import (
 	"os"
 )
 
-const CF_TRACE = "CF_TRACE"
+const (
+	CF_TRACE        = "CF_TRACE"
+	CF_TRACE_FORMAT = "CF_TRACE_FORMAT"
+
+	FORMAT_TEXT = "text"
+	FORMAT_JSON = "json"
+	FORMAT_HAR  = "har"
+)
 
 type Printer interface {
 	Print(v ...interface{})
 	Printf(format string, v ...interface{})
 	Println(v ...interface{})
+	PrintExchange(exchange Exchange)
 }
 
 type nullLogger struct{}
@@ -20,6 +28,15 @@ type nullLogger struct{}
 func (*nullLogger) Print(v ...interface{})                 {}
 func (*nullLogger) Printf(format string, v ...interface{}) {}
 func (*nullLogger) Println(v ...interface{})               {}
+func (*nullLogger) PrintExchange(exchange Exchange)        {}
+
+type textLogger struct {
+	*log.Logger
+}
+
+// the text format dumps requests and responses as they happen, so a
+// completed exchange has nothing left to add
+func (*textLogger) PrintExchange(exchange Exchange) {}
 
 var stdOut io.Writer = os.Stdout
 var Logger Printer
@@ -42,21 +59,40 @@ func SetStdout(s io.Writer) {
 
 func NewLogger() Printer {
 	cf_trace := os.Getenv(CF_TRACE)
+	format := os.Getenv(CF_TRACE_FORMAT)
+
 	switch cf_trace {
 	case "", "false":
 		return new(nullLogger)
 	case "true":
-		return newStdoutLogger()
+		return newStdoutLoggerWithFormat(format)
 	default:
-		return newFileLogger(cf_trace)
+		return newFileLogger(cf_trace, format)
 	}
 }
 
 func newStdoutLogger() Printer {
-	return log.New(stdOut, "", 0)
+	return &textLogger{log.New(stdOut, "", 0)}
+}
+
+func newStdoutLoggerWithFormat(format string) Printer {
+	switch format {
+	case FORMAT_JSON:
+		return newJsonLogger(stdOut)
+	case FORMAT_HAR:
+		logger := newStdoutLogger()
+		logger.Printf("CF_TRACE ERROR: %s=%s requires %s to be a file path, falling back to text output", CF_TRACE_FORMAT, format, CF_TRACE)
+		return logger
+	}
+
+	return newStdoutLogger()
 }
 
-func newFileLogger(path string) Printer {
+func newFileLogger(path, format string) Printer {
+	if format == FORMAT_HAR {
+		return newHarLogger(path)
+	}
+
 	file, err := fileutils.OpenFile(path)
 	if err != nil {
 		logger := newStdoutLogger()
@@ -64,5 +100,9 @@ func newFileLogger(path string) Printer {
 		return logger
 	}
 
-	return log.New(file, "", 0)
+	if format == FORMAT_JSON {
+		return newJsonLogger(file)
+	}
+
+	return &textLogger{log.New(file, "", 0)}
 }
@@ -3,15 +3,38 @@ package trace_test
 import (
 	"bytes"
 	"cf/trace"
+	"encoding/json"
 	"fileutils"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	"io/ioutil"
+	"net/http"
 	"os"
 	"runtime"
+	"strings"
+	"time"
 )
 
+func testExchange() trace.Exchange {
+	return trace.Exchange{
+		StartedAt:       time.Date(2014, time.March, 1, 12, 0, 0, 0, time.UTC),
+		Duration:        150 * time.Millisecond,
+		Method:          "GET",
+		Url:             "https://api.example.com/v2/apps?q=name:foo",
+		HttpVersion:     "HTTP/1.1",
+		RequestHeaders:  http.Header{"Accept": {"application/json"}},
+		StatusCode:      200,
+		Status:          "OK",
+		ResponseHeaders: http.Header{"Content-Type": {"application/json"}},
+		ResponseBody:    `{"total_results":0}`,
+	}
+}
+
 var _ = Describe("Testing with ginkgo", func() {
+	AfterEach(func() {
+		os.Setenv(trace.CF_TRACE_FORMAT, "")
+	})
+
 	It("TestTraceSetToFalse", func() {
 		stdOut := bytes.NewBuffer([]byte{})
 		trace.SetStdout(stdOut)
@@ -87,4 +110,111 @@ var _ = Describe("Testing with ginkgo", func() {
 			})
 		}
 	})
+
+	It("TestTraceFormatJsonWritesOneRecordPerExchange", func() {
+		stdOut := bytes.NewBuffer([]byte{})
+		trace.SetStdout(stdOut)
+
+		os.Setenv(trace.CF_TRACE, "true")
+		os.Setenv(trace.CF_TRACE_FORMAT, "json")
+
+		logger := trace.NewLogger()
+		logger.Print("free-form text is not part of the JSON output")
+		logger.PrintExchange(testExchange())
+		logger.PrintExchange(testExchange())
+
+		lines := strings.Split(strings.TrimSpace(stdOut.String()), "\n")
+		Expect(len(lines)).To(Equal(2))
+
+		record := map[string]interface{}{}
+		err := json.Unmarshal([]byte(lines[0]), &record)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(record["method"]).To(Equal("GET"))
+		Expect(record["url"]).To(Equal("https://api.example.com/v2/apps?q=name:foo"))
+		Expect(record["status"]).To(Equal(float64(200)))
+		Expect(record["duration_ms"]).To(Equal(float64(150)))
+		Expect(record["response_body"]).To(Equal(`{"total_results":0}`))
+	})
+
+	It("TestTraceFormatHarWritesACompleteDocument", func() {
+		fileutils.TempFile("trace_test", func(file *os.File, err error) {
+			Expect(err).NotTo(HaveOccurred())
+
+			os.Setenv(trace.CF_TRACE, file.Name())
+			os.Setenv(trace.CF_TRACE_FORMAT, "har")
+
+			logger := trace.NewLogger()
+			logger.PrintExchange(testExchange())
+			logger.PrintExchange(testExchange())
+
+			result, err := ioutil.ReadFile(file.Name())
+			Expect(err).NotTo(HaveOccurred())
+
+			document := struct {
+				Log struct {
+					Version string
+					Entries []struct {
+						Time    int64
+						Request struct {
+							Method      string
+							QueryString []map[string]string
+						}
+						Response struct {
+							Status int
+						}
+					}
+				}
+			}{}
+			err = json.Unmarshal(result, &document)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(document.Log.Version).To(Equal("1.2"))
+			Expect(len(document.Log.Entries)).To(Equal(2))
+			Expect(document.Log.Entries[0].Time).To(Equal(int64(150)))
+			Expect(document.Log.Entries[0].Request.Method).To(Equal("GET"))
+			Expect(document.Log.Entries[0].Request.QueryString[0]["value"]).To(Equal("name:foo"))
+			Expect(document.Log.Entries[0].Response.Status).To(Equal(200))
+		})
+	})
+
+	It("keeps the HAR document complete after each exchange", func() {
+		fileutils.TempFile("trace_test", func(file *os.File, err error) {
+			Expect(err).NotTo(HaveOccurred())
+
+			os.Setenv(trace.CF_TRACE, file.Name())
+			os.Setenv(trace.CF_TRACE_FORMAT, "har")
+
+			logger := trace.NewLogger()
+			for i := 1; i <= 3; i++ {
+				logger.PrintExchange(testExchange())
+
+				result, err := ioutil.ReadFile(file.Name())
+				Expect(err).NotTo(HaveOccurred())
+
+				document := struct {
+					Log struct {
+						Entries []interface{}
+					}
+				}{}
+				err = json.Unmarshal(result, &document)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(len(document.Log.Entries)).To(Equal(i))
+			}
+		})
+	})
+
+	It("TestTraceFormatHarFallsBackToTextOnStdout", func() {
+		stdOut := bytes.NewBuffer([]byte{})
+		trace.SetStdout(stdOut)
+
+		os.Setenv(trace.CF_TRACE, "true")
+		os.Setenv(trace.CF_TRACE_FORMAT, "har")
+
+		logger := trace.NewLogger()
+		logger.Print("hello world")
+
+		result, _ := ioutil.ReadAll(stdOut)
+		Expect(string(result)).To(ContainSubstring("requires CF_TRACE to be a file path"))
+		Expect(string(result)).To(ContainSubstring("hello world"))
+	})
 })
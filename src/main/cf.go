@@ -6,6 +6,7 @@ import (
 	"cf/app"
 	"cf/commands"
 	"cf/configuration"
+	"cf/errors"
 	"cf/manifest"
 	"cf/net"
 	"cf/requirements"
@@ -21,6 +22,7 @@ import (
 type cliDependencies struct {
 	termUI         terminal.UI
 	configRepo     configuration.Repository
+	projectPin     *configuration.ProjectPin
 	manifestRepo   manifest.ManifestRepository
 	apiRepoLocator api.RepositoryLocator
 }
@@ -40,10 +42,16 @@ func setupDependencies() (deps *cliDependencies) {
 
 	deps.configRepo = configuration.NewRepositoryFromFilepath(configuration.DefaultFilePath(), func(err error) {
 		if err != nil {
-			deps.termUI.Failed(fmt.Sprintf("Config error: %s", err))
+			failWithConfigError(deps.termUI, err)
 		}
 	})
 
+	projectPin, err := configuration.DefaultProjectPin()
+	if err != nil {
+		failWithConfigError(deps.termUI, err)
+	}
+	deps.projectPin = projectPin
+
 	deps.apiRepoLocator = api.NewRepositoryLocator(deps.configRepo, map[string]net.Gateway{
 		"auth":             net.NewUAAGateway(),
 		"cloud-controller": net.NewCloudControllerGateway(),
@@ -53,26 +61,60 @@ func setupDependencies() (deps *cliDependencies) {
 	return
 }
 
+// a config that cannot be read cannot be saved either, so there is nothing
+// for teardownDependencies to do
+func failWithConfigError(ui terminal.UI, err error) {
+	ui.Failed(fmt.Sprintf("Config error: %s", err))
+	os.Exit(errors.ExitCodeGeneral)
+}
+
 func teardownDependencies(deps *cliDependencies) {
 	deps.configRepo.Close()
 }
 
+// exitCodeRunner remembers the error of the command it ran, so main can
+// exit with the code matching its class.
+type exitCodeRunner struct {
+	runner commands.Runner
+	err    error
+}
+
+func (r *exitCodeRunner) RunCmdByName(cmdName string, c *cli.Context) (err error) {
+	err = r.runner.RunCmdByName(cmdName, c)
+	r.err = err
+	return
+}
+
 func main() {
 	defer handlePanics()
+	os.Exit(run())
+}
 
+func run() (exitCode int) {
 	deps := setupDependencies()
 	defer teardownDependencies(deps)
 
 	cmdFactory := commands.NewFactory(deps.termUI, deps.configRepo, deps.manifestRepo, deps.apiRepoLocator)
-	reqFactory := requirements.NewFactory(deps.termUI, deps.configRepo, deps.apiRepoLocator)
-	cmdRunner := commands.NewRunner(cmdFactory, reqFactory)
+	reqFactory := requirements.NewFactory(deps.termUI, deps.configRepo, deps.apiRepoLocator, deps.projectPin)
+	cmdRunner := &exitCodeRunner{runner: commands.NewRunner(deps.termUI, cmdFactory, reqFactory)}
+
+	theApp, err := app.NewApp(cmdRunner)
+	if err != nil {
+		return errors.ExitCodeGeneral
+	}
 
-	app, err := app.NewApp(cmdRunner)
+	args, err := app.ExpandAliases(theApp, os.Args, deps.configRepo.Aliases())
 	if err != nil {
-		return
+		deps.termUI.Failed(err.Error())
+		return errors.ExitCode(err)
 	}
 
-	app.Run(os.Args)
+	err = theApp.Run(args)
+	if err != nil {
+		return errors.ExitCodeUsage
+	}
+
+	return errors.ExitCode(cmdRunner.err)
 }
 
 func init() {
@@ -92,13 +134,29 @@ GLOBAL OPTIONS:
    {{range .Flags}}{{.}}
    {{end}}
 ENVIRONMENT VARIABLES:
+   CF_CLIENT_ID=my-ci-client - UAA client used to log in again when the token expires
+   CF_CLIENT_SECRET=secret - secret of the UAA client named by CF_CLIENT_ID
    CF_COLOR=false - will not colorize output
    CF_HOME=path/to/config/ override default config directory
+   CF_NON_INTERACTIVE=true - fail instead of prompting for input
+   CF_PROFILE=name - use the named profile for this invocation
    CF_STAGING_TIMEOUT=15 max wait time for buildpack staging, in minutes
    CF_STARTUP_TIMEOUT=5 max wait time for app instance startup, in minutes
    CF_TRACE=true - print API request diagnostics to stdout
    CF_TRACE=path/to/trace.log - append API request diagnostics to a log file
+   CF_TRACE_FORMAT=json - write API request diagnostics as one JSON record per request
+   CF_TRACE_FORMAT=har - write API request diagnostics as a HAR file (CF_TRACE must be a path)
    HTTP_PROXY=http://proxy.example.com:8080 - enable HTTP proxying for API requests
+
+EXIT CODES:
+   0 - success
+   1 - general failure
+   2 - incorrect usage
+   3 - not logged in or not authorized
+   4 - resource not found
+   5 - error returned by the server
+   6 - network error reaching the server
+   7 - timed out waiting for the platform
 `
 
 	cli.CommandHelpTemplate = `NAME:
@@ -22,6 +22,10 @@ type FakeApplicationRepository struct {
 	UpdateErr       bool
 
 	DeletedAppGuid string
+
+	ReadEnvAppGuid     string
+	ReadEnvResult      models.AppEnvironment
+	ReadEnvApiResponse net.ApiResponse
 }
 
 func (repo *FakeApplicationRepository) Read(name string) (app models.Application, apiResponse net.ApiResponse) {
@@ -99,3 +103,10 @@ func (repo *FakeApplicationRepository) Delete(appGuid string) (apiResponse net.A
 	repo.DeletedAppGuid = appGuid
 	return
 }
+
+func (repo *FakeApplicationRepository) ReadEnv(appGuid string) (env models.AppEnvironment, apiResponse net.ApiResponse) {
+	repo.ReadEnvAppGuid = appGuid
+	env = repo.ReadEnvResult
+	apiResponse = repo.ReadEnvApiResponse
+	return
+}
@@ -10,9 +10,18 @@ type FakeAuthenticationRepository struct {
 	Email    string
 	Password string
 
+	ClientId     string
+	ClientSecret string
+
+	Passcode           string
+	PasscodeURL        string
+	PasscodeURLMissing bool
+
 	AuthError    bool
 	AccessToken  string
 	RefreshToken string
+
+	RefreshTokenIfExpiringCalled bool
 }
 
 func (auth *FakeAuthenticationRepository) Authenticate(email string, password string) (apiResponse net.ApiResponse) {
@@ -34,6 +43,60 @@ func (auth *FakeAuthenticationRepository) Authenticate(email string, password st
 	return
 }
 
+func (auth *FakeAuthenticationRepository) AuthenticateClient(clientId string, clientSecret string) (apiResponse net.ApiResponse) {
+	auth.ClientId = clientId
+	auth.ClientSecret = clientSecret
+
+	if auth.AuthError {
+		apiResponse = net.NewApiResponseWithMessage("Error authenticating.")
+		return
+	}
+
+	if auth.AccessToken == "" {
+		auth.AccessToken = "BEARER some_access_token"
+	}
+
+	auth.Config.SetAccessToken(auth.AccessToken)
+	auth.Config.SetRefreshToken("")
+	auth.Config.SetClientCredentials(clientId, clientSecret)
+
+	return
+}
+
+func (auth *FakeAuthenticationRepository) AuthenticateWithPasscode(passcode string) (apiResponse net.ApiResponse) {
+	auth.Passcode = passcode
+
+	if auth.AuthError {
+		apiResponse = net.NewApiResponseWithMessage("Error authenticating.")
+		return
+	}
+
+	if auth.AccessToken == "" {
+		auth.AccessToken = "BEARER some_access_token"
+	}
+
+	auth.Config.SetAccessToken(auth.AccessToken)
+	auth.Config.SetRefreshToken(auth.RefreshToken)
+
+	return
+}
+
+func (auth *FakeAuthenticationRepository) GetPasscodeURL() (passcodeURL string, apiResponse net.ApiResponse) {
+	if auth.PasscodeURLMissing {
+		apiResponse = net.NewApiResponseWithMessage("The authentication server does not support one-time passcodes.")
+		return
+	}
+
+	passcodeURL = auth.PasscodeURL
+	return
+}
+
 func (auth *FakeAuthenticationRepository) RefreshAuthToken() (updatedToken string, apiResponse net.ApiResponse) {
 	return
 }
+
+func (auth *FakeAuthenticationRepository) RefreshAuthTokenIfExpiring(accessToken string) (updatedToken string, apiResponse net.ApiResponse) {
+	auth.RefreshTokenIfExpiringCalled = true
+	updatedToken = accessToken
+	return
+}
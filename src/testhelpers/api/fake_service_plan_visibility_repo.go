@@ -0,0 +1,31 @@
+package api
+
+import (
+	"cf/models"
+	"cf/net"
+)
+
+type FakeServicePlanVisibilityRepo struct {
+	Visibilities []models.ServicePlanVisibilityFields
+
+	CreatedVisibilities []models.ServicePlanVisibilityFields
+	DeletedGuids        []string
+}
+
+func (repo *FakeServicePlanVisibilityRepo) List() (visibilities []models.ServicePlanVisibilityFields, apiResponse net.ApiResponse) {
+	visibilities = repo.Visibilities
+	return
+}
+
+func (repo *FakeServicePlanVisibilityRepo) Create(planGuid, orgGuid string) (apiResponse net.ApiResponse) {
+	repo.CreatedVisibilities = append(repo.CreatedVisibilities, models.ServicePlanVisibilityFields{
+		ServicePlanGuid:  planGuid,
+		OrganizationGuid: orgGuid,
+	})
+	return
+}
+
+func (repo *FakeServicePlanVisibilityRepo) Delete(guid string) (apiResponse net.ApiResponse) {
+	repo.DeletedGuids = append(repo.DeletedGuids, guid)
+	return
+}
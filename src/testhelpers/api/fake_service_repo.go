@@ -20,11 +20,23 @@ type FakeServiceRepo struct {
 
 	FindInstanceByNameMap generic.Map
 
+	// each lookup takes the next of these as the last operation of the
+	// instance found, so that tests can follow asynchronous provisioning
+	FindInstanceByNameLastOperations []models.LastOperationFields
+	FindInstanceByNameCount          int
+
 	DeleteServiceServiceInstance models.ServiceInstance
 
 	RenameServiceServiceInstance models.ServiceInstance
 	RenameServiceNewName         string
 
+	UpdateServicePlanServiceInstance models.ServiceInstance
+	UpdateServicePlanPlanGuid        string
+	UpdateServicePlanApiResponse     net.ApiResponse
+
+	ServiceOfferingsByBrokerGuid map[string]models.ServiceOfferings
+	PublicServicePlans           map[string]bool
+
 	PurgedServiceOffering           models.ServiceOffering
 	PurgeServiceOfferingCalled      bool
 	PurgeServiceOfferingApiResponse net.ApiResponse
@@ -66,6 +78,7 @@ func (repo *FakeServiceRepo) CreateServiceInstance(name, planGuid string) (ident
 
 func (repo *FakeServiceRepo) FindInstanceByName(name string) (instance models.ServiceInstance, apiResponse net.ApiResponse) {
 	repo.FindInstanceByNameName = name
+	repo.FindInstanceByNameCount++
 
 	if repo.FindInstanceByNameMap != nil && repo.FindInstanceByNameMap.Has(name) {
 		instance = repo.FindInstanceByNameMap.Get(name).(models.ServiceInstance)
@@ -73,6 +86,11 @@ func (repo *FakeServiceRepo) FindInstanceByName(name string) (instance models.Se
 		instance = repo.FindInstanceByNameServiceInstance
 	}
 
+	if len(repo.FindInstanceByNameLastOperations) > 0 {
+		instance.LastOperation = repo.FindInstanceByNameLastOperations[0]
+		repo.FindInstanceByNameLastOperations = repo.FindInstanceByNameLastOperations[1:]
+	}
+
 	if repo.FindInstanceByNameErr {
 		apiResponse = net.NewApiResponseWithMessage("Error finding instance")
 	}
@@ -94,3 +112,23 @@ func (repo *FakeServiceRepo) RenameService(instance models.ServiceInstance, newN
 	repo.RenameServiceNewName = newName
 	return
 }
+
+func (repo *FakeServiceRepo) UpdateServicePlan(instance models.ServiceInstance, planGuid string) (apiResponse net.ApiResponse) {
+	repo.UpdateServicePlanServiceInstance = instance
+	repo.UpdateServicePlanPlanGuid = planGuid
+	apiResponse = repo.UpdateServicePlanApiResponse
+	return
+}
+
+func (repo *FakeServiceRepo) GetServiceOfferingsForBroker(brokerGuid string) (offerings models.ServiceOfferings, apiResponse net.ApiResponse) {
+	offerings = repo.ServiceOfferingsByBrokerGuid[brokerGuid]
+	return
+}
+
+func (repo *FakeServiceRepo) SetServicePlanPublic(planGuid string, public bool) (apiResponse net.ApiResponse) {
+	if repo.PublicServicePlans == nil {
+		repo.PublicServicePlans = map[string]bool{}
+	}
+	repo.PublicServicePlans[planGuid] = public
+	return
+}
@@ -8,12 +8,15 @@ import (
 type FakeUserProvidedServiceInstanceRepo struct {
 	CreateName     string
 	CreateDrainUrl string
-	CreateParams   map[string]string
+	CreateParams   map[string]interface{}
 
 	UpdateServiceInstance models.ServiceInstanceFields
+
+	GetCredentialsGuid        string
+	GetCredentialsCredentials map[string]interface{}
 }
 
-func (repo *FakeUserProvidedServiceInstanceRepo) Create(name, drainUrl string, params map[string]string) (apiResponse net.ApiResponse) {
+func (repo *FakeUserProvidedServiceInstanceRepo) Create(name, drainUrl string, params map[string]interface{}) (apiResponse net.ApiResponse) {
 	repo.CreateName = name
 	repo.CreateDrainUrl = drainUrl
 	repo.CreateParams = params
@@ -24,3 +27,9 @@ func (repo *FakeUserProvidedServiceInstanceRepo) Update(serviceInstance models.S
 	repo.UpdateServiceInstance = serviceInstance
 	return
 }
+
+func (repo *FakeUserProvidedServiceInstanceRepo) GetCredentials(guid string) (credentials map[string]interface{}, apiResponse net.ApiResponse) {
+	repo.GetCredentialsGuid = guid
+	credentials = repo.GetCredentialsCredentials
+	return
+}
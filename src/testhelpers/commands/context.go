@@ -8,6 +8,7 @@ import (
 	"github.com/codegangsta/cli"
 	"strings"
 	testreq "testhelpers/requirements"
+	testterm "testhelpers/terminal"
 )
 
 func NewContext(cmdName string, args []string) *cli.Context {
@@ -42,7 +43,7 @@ func NewContext(cmdName string, args []string) *cli.Context {
 func findCommand(cmdName string) (cmd cli.Command) {
 	cmdFactory := commands.ConcreteFactory{}
 	reqFactory := &testreq.FakeReqFactory{}
-	cmdRunner := commands.NewRunner(cmdFactory, reqFactory)
+	cmdRunner := commands.NewRunner(&testterm.FakeUI{}, cmdFactory, reqFactory)
 	myApp, _ := app.NewApp(cmdRunner)
 
 	for _, cmd := range myApp.Commands {
@@ -8,6 +8,7 @@ type FakeAppDisplayer struct {
 	AppToDisplay models.Application
 }
 
-func (displayer *FakeAppDisplayer) ShowApp(app models.Application) {
+func (displayer *FakeAppDisplayer) ShowApp(app models.Application) (err error) {
 	displayer.AppToDisplay = app
+	return
 }
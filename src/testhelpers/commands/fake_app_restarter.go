@@ -8,7 +8,7 @@ type FakeAppRestarter struct {
 	AppToRestart models.Application
 }
 
-func (restarter *FakeAppRestarter) ApplicationRestart(appToRestart models.Application) {
+func (restarter *FakeAppRestarter) ApplicationRestart(appToRestart models.Application) (err error) {
 	restarter.AppToRestart = appToRestart
 	return
 }
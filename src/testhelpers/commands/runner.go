@@ -2,22 +2,16 @@ package commands
 
 import (
 	"cf/commands"
+	"cf/errors"
+	"cf/terminal"
 	"github.com/codegangsta/cli"
 	testreq "testhelpers/requirements"
-	testterm "testhelpers/terminal"
 )
 
 var CommandDidPassRequirements bool
 
-func RunCommand(cmd commands.Command, ctxt *cli.Context, reqFactory *testreq.FakeReqFactory) {
-	defer func() {
-		errMsg := recover()
-
-		if errMsg != nil && errMsg != testterm.FailedWasCalled {
-			panic(errMsg)
-		}
-	}()
-
+// RunCommand runs cmd like the runner of cf does, reporting failures to ui
+func RunCommand(ui terminal.UI, cmd commands.Command, ctxt *cli.Context, reqFactory *testreq.FakeReqFactory) (err error) {
 	CommandDidPassRequirements = false
 
 	reqs, err := cmd.GetRequirements(reqFactory, ctxt)
@@ -26,14 +20,17 @@ func RunCommand(cmd commands.Command, ctxt *cli.Context, reqFactory *testreq.Fak
 	}
 
 	for _, req := range reqs {
-		success := req.Execute()
-		if !success {
+		err = req.Execute()
+		if err != nil {
+			ui.Failed(err.Error())
 			return
 		}
 	}
 
 	CommandDidPassRequirements = true
-	cmd.Run(ctxt)
-
+	err = cmd.Run(ctxt)
+	if _, usageShown := err.(*errors.UsageError); err != nil && !usageShown {
+		ui.Failed(err.Error())
+	}
 	return
 }
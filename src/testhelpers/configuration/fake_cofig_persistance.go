@@ -40,3 +40,9 @@ func (fp *FakePersistor) Save(data *configuration.Data) (err error) {
 	err = fp.SaveReturns.Err
 	return
 }
+
+func (fp *FakePersistor) Update(current *configuration.Data, mutate func(*configuration.Data)) (data *configuration.Data, err error) {
+	data = current
+	err = fp.Save(data)
+	return
+}
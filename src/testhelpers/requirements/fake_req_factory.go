@@ -1,6 +1,7 @@
 package requirements
 
 import (
+	"cf/errors"
 	"cf/models"
 	"cf/requirements"
 )
@@ -94,8 +95,11 @@ type FakeRequirement struct {
 	success bool
 }
 
-func (r FakeRequirement) Execute() (success bool) {
-	return r.success
+func (r FakeRequirement) Execute() (err error) {
+	if !r.success {
+		err = errors.New("Requirement failed")
+	}
+	return
 }
 
 func (r FakeRequirement) GetApplication() models.Application {
@@ -9,8 +9,6 @@ import (
 	"time"
 )
 
-const FailedWasCalled = "FailedWasCalled"
-
 type FakeUI struct {
 	Outputs                    []string
 	Prompts                    []string
@@ -19,6 +17,9 @@ type FakeUI struct {
 	FailedWithUsage            bool
 	FailedWithUsageCommandName string
 	ShowConfigurationCalled    bool
+	WaitInterrupted            bool
+
+	outputOptions term.OutputOptions
 }
 
 func (ui *FakeUI) PrintPaginator(rows []string, err error) {
@@ -50,6 +51,10 @@ func (ui *FakeUI) Ask(prompt string, args ...interface{}) (answer string) {
 	return
 }
 
+func (ui *FakeUI) IsInteractive() bool {
+	return !ui.outputOptions.NonInteractive
+}
+
 func (ui *FakeUI) Confirm(prompt string, args ...interface{}) bool {
 	response := ui.Ask(prompt, args...)
 	switch strings.ToLower(response) {
@@ -73,7 +78,6 @@ func (ui *FakeUI) Ok() {
 func (ui *FakeUI) Failed(message string, args ...interface{}) {
 	ui.Say("FAILED")
 	ui.Say(message, args...)
-	panic(FailedWasCalled)
 	return
 }
 
@@ -102,8 +106,9 @@ func (ui *FakeUI) ShowConfiguration(config configuration.Reader) {
 func (ui FakeUI) LoadingIndication() {
 }
 
-func (c FakeUI) Wait(duration time.Duration) {
+func (c FakeUI) Wait(duration time.Duration) (interrupted bool) {
 	time.Sleep(duration)
+	return c.WaitInterrupted
 }
 
 func (ui *FakeUI) DisplayTable(table [][]string) {
@@ -120,3 +125,23 @@ func (ui *FakeUI) DisplayTable(table [][]string) {
 func (ui *FakeUI) Table(headers []string) term.Table {
 	return term.NewTable(ui, headers)
 }
+
+func (ui *FakeUI) SetOutputOptions(options term.OutputOptions) {
+	ui.outputOptions = options
+}
+
+func (ui *FakeUI) OutputOptions() term.OutputOptions {
+	return ui.outputOptions
+}
+
+func (ui *FakeUI) PrintValue(value interface{}) (err error) {
+	output, err := term.FormatValue(ui.outputOptions, value)
+	if err != nil {
+		return
+	}
+
+	if output != "" {
+		ui.Say("%s", output)
+	}
+	return
+}